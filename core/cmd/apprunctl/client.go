@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"apprun/modules/config"
+)
+
+// apiClient calls the config API, over the network or in-process (--offline)
+type apiClient struct {
+	baseURL string
+	http    *http.Client
+	scope   string
+	actor   string
+}
+
+// envelope is the response.Response wrapper returned by every JSON endpoint
+type envelope struct {
+	Success bool            `json:"success"`
+	Code    int             `json:"code"`
+	Data    json.RawMessage `json:"data"`
+	Error   *struct {
+		Code    string          `json:"code"`
+		Message string          `json:"message"`
+		Details json.RawMessage `json:"details"`
+	} `json:"error"`
+}
+
+// apiError is a non-2xx API response
+type apiError struct {
+	Status  int
+	Code    string
+	Message string
+	Details json.RawMessage
+}
+
+func (e *apiError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("request failed with status %d", e.Status)
+	}
+	return e.Message
+}
+
+// exitCode maps an error to the process exit code
+func exitCode(err error) int {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		return ExitFailure
+	}
+	switch apiErr.Status {
+	case http.StatusNotFound:
+		return ExitNotFound
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ExitRejected
+	case http.StatusConflict:
+		return ExitConflict
+	case http.StatusForbidden:
+		return ExitForbidden
+	default:
+		return ExitFailure
+	}
+}
+
+// newClient connects to the HTTP API, or to the config store with --offline
+// The returned function releases the offline database connection
+func newClient(ctx context.Context, opts *options) (*apiClient, func(), error) {
+	client := &apiClient{
+		baseURL: strings.TrimRight(opts.server, "/"),
+		http:    &http.Client{},
+		scope:   opts.scope,
+		actor:   opts.actor,
+	}
+	if !opts.offline {
+		return client, func() {}, nil
+	}
+
+	transport, closeDB, err := newOfflineTransport(ctx, opts.configDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	client.baseURL = offlineBaseURL
+	client.http = &http.Client{Transport: transport}
+	return client, closeDB, nil
+}
+
+// request sends a request to path (relative to the API base URL) with the scope query parameter
+func (c *apiClient) request(ctx context.Context, method, path string, query url.Values, body io.Reader, header http.Header) (*http.Response, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	if c.scope != "" {
+		query.Set("scope", c.scope)
+	}
+
+	target := c.baseURL + path
+	if encoded := query.Encode(); encoded != "" {
+		target += "?" + encoded
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if c.actor != "" {
+		req.Header.Set(config.ActorHeader, c.actor)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", c.baseURL, err)
+	}
+	return resp, nil
+}
+
+// call sends a request and decodes the data of the response envelope into out
+// Non-2xx responses are returned as *apiError
+func (c *apiClient) call(ctx context.Context, method, path string, query url.Values, body interface{}, header http.Header, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		switch b := body.(type) {
+		case []byte:
+			reader = bytes.NewReader(b)
+		default:
+			data, err := json.Marshal(body)
+			if err != nil {
+				return err
+			}
+			reader = bytes.NewReader(data)
+		}
+	}
+
+	resp, err := c.request(ctx, method, path, query, reader, header)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		if resp.StatusCode >= 300 {
+			return &apiError{Status: resp.StatusCode}
+		}
+		return fmt.Errorf("invalid response from %s: %w", path, err)
+	}
+
+	if resp.StatusCode >= 300 || !env.Success {
+		apiErr := &apiError{Status: resp.StatusCode}
+		if env.Error != nil {
+			apiErr.Code = env.Error.Code
+			apiErr.Message = env.Error.Message
+			apiErr.Details = env.Error.Details
+		}
+		return apiErr
+	}
+
+	if out == nil || len(env.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(env.Data, out)
+}
+
+// raw sends a GET request and returns the body of a non-envelope response (e.g. export)
+func (c *apiClient) raw(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	resp, err := c.request(ctx, http.MethodGet, path, query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		apiErr := &apiError{Status: resp.StatusCode}
+		var env envelope
+		if json.Unmarshal(data, &env) == nil && env.Error != nil {
+			apiErr.Code = env.Error.Code
+			apiErr.Message = env.Error.Message
+		}
+		return nil, apiErr
+	}
+	return data, nil
+}
+
+// ifMatchHeader returns the If-Match header for an expected revision (< 0 = unconditional)
+func ifMatchHeader(revision int) http.Header {
+	if revision < 0 {
+		return nil
+	}
+	return http.Header{"If-Match": []string{strconv.Quote(strconv.Itoa(revision))}}
+}
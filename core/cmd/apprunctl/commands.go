@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"apprun/modules/config"
+)
+
+// command runs one config command and returns the exit code on success
+type command struct {
+	usage string // arguments shown in usage errors
+	args  int    // number of positional arguments
+	local bool   // works on the config stores directly; run receives no API client
+	run   func(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error)
+}
+
+var commands = map[string]command{
+	"get":     {usage: "get KEY", args: 1, run: cmdGet},
+	"set":     {usage: "set KEY VALUE", args: 2, run: cmdSet},
+	"list":    {usage: "list", args: 0, run: cmdList},
+	"delete":  {usage: "delete KEY", args: 1, run: cmdDelete},
+	"allowed": {usage: "allowed", args: 0, run: cmdAllowed},
+	"explain": {usage: "explain KEY", args: 1, run: cmdExplain},
+	"diff":    {usage: "diff FILE", args: 1, run: cmdDiff},
+	"export":  {usage: "export", args: 0, run: cmdExport},
+	"migrate": {usage: "migrate SOURCE TARGET (database or file)", args: 2, local: true, run: cmdMigrate},
+}
+
+// lookupCommand returns the command called name after checking its number of arguments
+func lookupCommand(name string, args []string) (command, error) {
+	cmd, ok := commands[name]
+	if !ok {
+		return command{}, &usageError{msg: fmt.Sprintf("unknown command %q, run apprunctl without arguments for usage", name)}
+	}
+	if len(args) != cmd.args {
+		return command{}, &usageError{msg: "usage: apprunctl config " + cmd.usage}
+	}
+	return cmd, nil
+}
+
+func cmdGet(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	var resp config.GetConfigResponse
+	if err := c.call(ctx, http.MethodGet, "/config", url.Values{"key": {args[0]}}, nil, nil, &resp); err != nil {
+		return ExitFailure, err
+	}
+
+	return ExitOK, render(w, opts.output, resp, func(t *table) {
+		t.row("KEY", "VALUE", "SOURCE", "SCOPE", "REVISION", "DYNAMIC")
+		source := resp.Source
+		if resp.FromScope != "" {
+			source += "@" + resp.FromScope
+		}
+		if resp.SourceFile != "" {
+			source += " (" + resp.SourceFile + ")"
+		}
+		t.row(resp.Key, formatValue(resp.Value), source, resp.Scope, strconv.Itoa(resp.Revision), strconv.FormatBool(resp.IsDynamic))
+	})
+}
+
+func cmdSet(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	req := config.UpdateConfigRequest{Key: args[0], Value: args[1]}
+
+	var data json.RawMessage
+	if err := c.call(ctx, http.MethodPut, "/config", nil, req, ifMatchHeader(opts.ifMatch), &data); err != nil {
+		return ExitFailure, err
+	}
+
+	// Keys tagged approval:"required" are not written, the server returns a pending change request
+	var change config.ChangeRequest
+	if err := json.Unmarshal(data, &change); err == nil && change.Status != "" {
+		return ExitOK, render(w, opts.output, change, func(t *table) {
+			t.row("CHANGE", "KEY", "VALUE", "STATUS", "EXPIRES")
+			t.row(strconv.Itoa(change.ID), change.Key, formatValue(change.Value), change.Status+" (needs approval)", change.ExpiresAt.Format(time.RFC3339))
+		})
+	}
+
+	var resp config.UpdateConfigResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return ExitFailure, err
+	}
+
+	return ExitOK, render(w, opts.output, resp, func(t *table) {
+		t.row("KEY", "VALUE")
+		t.row(resp.Key, formatValue(resp.Value))
+	})
+}
+
+func cmdList(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	var resp config.ListConfigsResponse
+	if err := c.call(ctx, http.MethodGet, "/config/list", nil, nil, nil, &resp); err != nil {
+		return ExitFailure, err
+	}
+
+	return ExitOK, render(w, opts.output, resp, func(t *table) {
+		t.row("KEY", "VALUE")
+		for _, key := range sortedKeys(resp.Configs) {
+			t.row(key, formatValue(resp.Configs[key]))
+		}
+	})
+}
+
+func cmdDelete(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	var resp struct {
+		Key string `json:"key"`
+	}
+	if err := c.call(ctx, http.MethodDelete, "/config", url.Values{"key": {args[0]}}, nil, ifMatchHeader(opts.ifMatch), &resp); err != nil {
+		return ExitFailure, err
+	}
+
+	return ExitOK, render(w, opts.output, resp, func(t *table) {
+		t.row("DELETED")
+		t.row(resp.Key)
+	})
+}
+
+func cmdAllowed(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	var resp struct {
+		AllowedKeys []string `json:"allowed_keys"`
+		Count       int      `json:"count"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/config/allowed", nil, nil, nil, &resp); err != nil {
+		return ExitFailure, err
+	}
+	sort.Strings(resp.AllowedKeys)
+
+	return ExitOK, render(w, opts.output, resp, func(t *table) {
+		t.row("KEY")
+		for _, key := range resp.AllowedKeys {
+			t.row(key)
+		}
+	})
+}
+
+func cmdExplain(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	var resp config.ExplainConfigResponse
+	if err := c.call(ctx, http.MethodGet, "/config/explain", url.Values{"key": {args[0]}}, nil, nil, &resp); err != nil {
+		return ExitFailure, err
+	}
+
+	return ExitOK, render(w, opts.output, resp, func(t *table) {
+		t.row("LAYER", "NAME", "SOURCE", "VALUE", "EFFECTIVE", "NOTE")
+		for _, layer := range resp.Layers {
+			value, effective := "-", ""
+			if layer.Set {
+				value = formatValue(layer.Value)
+			}
+			if layer.Effective {
+				effective = "*"
+			}
+			note := ""
+			if layer.RawValue != nil {
+				note = "raw: " + formatValue(layer.RawValue)
+			}
+			if layer.Ignored {
+				note = "ignored: " + layer.IgnoredReason
+			}
+			t.row(strconv.Itoa(layer.Layer), layer.Name, layer.Source, value, effective, note)
+		}
+	})
+}
+
+// cmdDiff compares a document with the effective config (import dry run)
+// Exits with ExitRejected when a key is invalid and ExitChanged when a key would be updated
+func cmdDiff(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if args[0] == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return ExitFailure, fmt.Errorf("failed to read %s: %w", args[0], err)
+	}
+
+	var resp config.ImportConfigResponse
+	header := http.Header{"Content-Type": {"application/x-yaml"}}
+	if err := c.call(ctx, http.MethodPost, "/config/import", url.Values{"dry_run": {"true"}}, data, header, &resp); err != nil {
+		return ExitFailure, err
+	}
+
+	code := ExitOK
+	for _, change := range resp.Changes {
+		switch change.Action {
+		case config.ImportActionInvalid:
+			code = ExitRejected
+		case config.ImportActionUpdate:
+			if code == ExitOK {
+				code = ExitChanged
+			}
+		}
+	}
+
+	return code, render(w, opts.output, resp, func(t *table) {
+		t.row("ACTION", "KEY", "CURRENT", "NEW", "REASON")
+		for _, change := range resp.Changes {
+			if change.Action == config.ImportActionUnchanged {
+				continue
+			}
+			t.row(change.Action, change.Key, formatValue(change.OldValue), formatValue(change.NewValue), change.Reason)
+		}
+	})
+}
+
+// cmdExport prints the exported document as returned by the API
+func cmdExport(ctx context.Context, c *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	format := opts.format
+	if format == "" {
+		format = config.ExportFormatYAML
+		if opts.output == OutputJSON {
+			format = config.ExportFormatJSON
+		}
+	}
+
+	data, err := c.raw(ctx, "/config/export", url.Values{"format": {format}})
+	if err != nil {
+		return ExitFailure, err
+	}
+
+	_, err = w.Write(data)
+	return ExitOK, err
+}
+
+// cmdMigrate copies the dynamic config of every scope from one config store to the other
+func cmdMigrate(ctx context.Context, _ *apiClient, opts *options, args []string, w io.Writer) (int, error) {
+	for _, provider := range args {
+		if provider != config.StoreProviderDatabase && provider != config.StoreProviderFile {
+			return ExitUsage, &usageError{msg: fmt.Sprintf("unknown config store %q (database or file)", provider)}
+		}
+	}
+	if args[0] == args[1] {
+		return ExitUsage, &usageError{msg: "source and target config stores must differ"}
+	}
+
+	offline, err := loadOfflineEnv(ctx, opts.configDir)
+	if err != nil {
+		return ExitFailure, err
+	}
+	from, closeFrom, err := offline.openStore(ctx, args[0])
+	if err != nil {
+		return ExitFailure, err
+	}
+	defer closeFrom()
+	to, closeTo, err := offline.openStore(ctx, args[1])
+	if err != nil {
+		return ExitFailure, err
+	}
+	defer closeTo()
+
+	resp, err := config.MigrateConfigs(config.WithActor(ctx, opts.actor), from, to)
+	if err != nil {
+		return ExitFailure, err
+	}
+
+	return ExitOK, render(w, opts.output, resp, func(t *table) {
+		t.row("SCOPES", "WRITTEN", "DELETED")
+		t.row(strconv.Itoa(resp.Scopes), strconv.Itoa(resp.Written), strconv.Itoa(resp.Deleted))
+	})
+}
@@ -0,0 +1,201 @@
+// Command apprunctl manages the apprun config center from the command line.
+//
+//	apprunctl config <command> [flags] [args]
+//
+// Commands:
+//
+//	get KEY          effective value, source and revision of a key
+//	set KEY VALUE    update a dynamic key (db:true); VALUE is parsed as the field type
+//	list             dynamic values stored in the database for the scope
+//	delete KEY       remove a dynamic value, falling back to the file or default value
+//	allowed          keys that can be changed at runtime
+//	explain KEY      value contributed by every config layer
+//	diff FILE        compare a YAML or JSON document with the effective config
+//	export           effective config as a YAML or JSON document (secrets redacted)
+//	migrate SRC DST  copy dynamic config between config stores (database, file)
+//
+// By default commands call the HTTP API (--server, or APPRUN_SERVER). With
+// --offline they open the config store configured in CONFIG_DIR and env vars
+// (the database or the file of config_store), the same way the server does, and
+// run the API in-process. migrate always works on the stores directly.
+//
+// Exit codes: 0 success, 1 failure, 2 usage error, 3 key not found,
+// 4 change rejected (invalid value or db:false key), 5 revision conflict,
+// 6 diff found changes, 7 forbidden (the key requires approval).
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"apprun/pkg/env"
+)
+
+// Exit codes
+const (
+	ExitOK        = 0
+	ExitFailure   = 1
+	ExitUsage     = 2
+	ExitNotFound  = 3
+	ExitRejected  = 4
+	ExitConflict  = 5
+	ExitChanged   = 6
+	ExitForbidden = 7
+)
+
+// Output formats
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+const usage = `Usage: apprunctl config <command> [flags] [args]
+
+Commands:
+  get KEY          Show the effective value, source and revision of a key
+  set KEY VALUE    Update a dynamic key (db:true)
+  list             List dynamic values stored for the scope
+  delete KEY       Delete a dynamic value
+  allowed          List keys that can be changed at runtime
+  explain KEY      Show the value contributed by every config layer
+  diff FILE        Compare a YAML or JSON document with the effective config
+  export           Print the effective config (secrets redacted)
+  migrate SRC DST  Copy dynamic config between config stores (database, file)
+
+Flags:
+`
+
+// options are the flags shared by every command
+type options struct {
+	server    string
+	offline   bool
+	configDir string
+	output    string
+	scope     string
+	actor     string
+	ifMatch   int
+	format    string
+	timeout   time.Duration
+}
+
+// usageError is reported with exit code ExitUsage
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes a command and returns the process exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	fs, opts := newFlagSet(stderr)
+
+	if len(args) == 0 || args[0] != "config" {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+		return ExitUsage
+	}
+
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+	if len(positional) == 0 {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+		return ExitUsage
+	}
+
+	switch opts.output {
+	case OutputTable, OutputJSON, OutputYAML:
+	default:
+		fmt.Fprintf(stderr, "error: unsupported output format %q (table, json or yaml)\n", opts.output)
+		return ExitUsage
+	}
+
+	cmd, err := lookupCommand(positional[0], positional[1:])
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return ExitUsage
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+
+	var client *apiClient
+	if !cmd.local {
+		var closeClient func()
+		client, closeClient, err = newClient(ctx, opts)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+			return ExitFailure
+		}
+		defer closeClient()
+	}
+
+	code, err := cmd.run(ctx, client, opts, positional[1:], stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		var usageErr *usageError
+		if errors.As(err, &usageErr) {
+			return ExitUsage
+		}
+		return exitCode(err)
+	}
+	return code
+}
+
+// newFlagSet defines the flags accepted by every command
+func newFlagSet(stderr io.Writer) (*flag.FlagSet, *options) {
+	opts := &options{}
+	fs := flag.NewFlagSet("apprunctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&opts.server, "server", env.Get("APPRUN_SERVER", "http://localhost:8080/api"), "API base URL (env APPRUN_SERVER)")
+	fs.BoolVar(&opts.offline, "offline", false, "Open the config store directly instead of the HTTP API")
+	fs.StringVar(&opts.configDir, "config-dir", env.Get("CONFIG_DIR", "./config"), "Config directory used with --offline and migrate (env CONFIG_DIR)")
+	fs.StringVar(&opts.output, "o", OutputTable, "Output format: table, json or yaml")
+	fs.StringVar(&opts.scope, "scope", "", "Scope: global (default), project:<id> or project:<id>/user:<id>")
+	fs.StringVar(&opts.actor, "actor", env.Get("APPRUN_ACTOR", env.Get("USER", "apprunctl")), "Operator recorded in config history (env APPRUN_ACTOR)")
+	fs.IntVar(&opts.ifMatch, "if-match", -1, "set/delete: expected revision (0 = not stored yet)")
+	fs.StringVar(&opts.format, "format", "", "export: document format, yaml or json (default from -o)")
+	fs.DurationVar(&opts.timeout, "timeout", 30*time.Second, "Request timeout")
+	return fs, opts
+}
+
+// parseInterspersed parses flags that appear before, between or after positional arguments
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		// Everything after "--" is positional, e.g. set KEY -- -1
+		if consumed := len(args) - fs.NArg(); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, fs.Args()...), nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"apprun/modules/config"
+	"apprun/pkg/response"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAPI 启动返回固定响应的配置 API，记录最后一个请求
+func newTestAPI(t *testing.T) (*httptest.Server, *http.Request) {
+	t.Helper()
+
+	last := &http.Request{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/config", func(w http.ResponseWriter, r *http.Request) {
+		*last = *r.Clone(r.Context())
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("key") == "missing":
+			response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, "config not found")
+		case r.Method == http.MethodGet:
+			response.SuccessWithRequest(w, r, config.GetConfigResponse{
+				Key: r.URL.Query().Get("key"), Value: 8080, Source: "database", Scope: "global", Revision: 3, IsDynamic: true,
+			})
+		case r.Method == http.MethodPut && r.Header.Get("If-Match") == `"1"`:
+			response.ErrorWithDetailsAndRequest(w, r, http.StatusConflict, response.ErrCodeConflict, "modified concurrently", nil)
+		case r.Method == http.MethodPut:
+			var req config.UpdateConfigRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.Value == "bad" {
+				response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to update config: invalid value")
+				return
+			}
+			if req.Key == "poc.database" {
+				response.ErrorWithRequest(w, r, http.StatusForbidden, response.ErrCodeForbidden, "config key 'poc.database' requires approval")
+				return
+			}
+			response.SuccessWithRequest(w, r, config.UpdateConfigResponse{Key: req.Key, Value: req.Value})
+		}
+	})
+	mux.HandleFunc("/api/config/import", func(w http.ResponseWriter, r *http.Request) {
+		*last = *r.Clone(r.Context())
+		body, _ := io.ReadAll(r.Body)
+		changes := []config.ImportChange{{Key: "app.name", Action: config.ImportActionUnchanged}}
+		if bytes.Contains(body, []byte("changed")) {
+			changes = append(changes, config.ImportChange{Key: "logger.level", Action: config.ImportActionUpdate, OldValue: "info", NewValue: "debug"})
+		}
+		if bytes.Contains(body, []byte("invalid")) {
+			changes = append(changes, config.ImportChange{Key: "poc.api_key", Action: config.ImportActionInvalid, Reason: "too short"})
+		}
+		response.SuccessWithRequest(w, r, config.ImportConfigResponse{DryRun: true, Changes: changes})
+	})
+	mux.HandleFunc("/api/config/export", func(w http.ResponseWriter, r *http.Request) {
+		*last = *r.Clone(r.Context())
+		_, _ = w.Write([]byte("format: " + r.URL.Query().Get("format") + "\n"))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, last
+}
+
+// runCLI 运行命令，返回退出码、标准输出与标准错误
+func runCLI(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+// TestRun_Get 测试表格、JSON、YAML 输出及 scope、actor 的传递
+func TestRun_Get(t *testing.T) {
+	server, last := newTestAPI(t)
+	api := server.URL + "/api"
+
+	code, out, _ := runCLI("config", "get", "server.port", "--server", api, "--scope", "project:alpha", "--actor", "ops")
+	assert.Equal(t, ExitOK, code)
+	assert.Contains(t, out, "KEY")
+	assert.Contains(t, out, "server.port  8080")
+	assert.Equal(t, "project:alpha", last.URL.Query().Get("scope"))
+	assert.Equal(t, "ops", last.Header.Get(config.ActorHeader))
+
+	code, out, _ = runCLI("config", "-o", "json", "--server", api, "get", "server.port")
+	assert.Equal(t, ExitOK, code)
+	var resp config.GetConfigResponse
+	require.NoError(t, json.Unmarshal([]byte(out), &resp))
+	assert.Equal(t, 3, resp.Revision)
+
+	code, out, _ = runCLI("config", "get", "server.port", "-o", "yaml", "--server", api)
+	assert.Equal(t, ExitOK, code)
+	assert.Contains(t, out, "is_dynamic: true")
+}
+
+// TestRun_ExitCodes 测试供脚本使用的退出码
+func TestRun_ExitCodes(t *testing.T) {
+	server, last := newTestAPI(t)
+	api := server.URL + "/api"
+
+	code, _, stderr := runCLI("config", "get", "missing", "--server", api)
+	assert.Equal(t, ExitNotFound, code)
+	assert.Contains(t, stderr, "config not found")
+
+	code, _, _ = runCLI("config", "set", "app.name", "bad", "--server", api)
+	assert.Equal(t, ExitRejected, code)
+
+	code, _, _ = runCLI("config", "set", "app.name", "new", "--if-match", "1", "--server", api)
+	assert.Equal(t, ExitConflict, code)
+	assert.Equal(t, `"1"`, last.Header.Get("If-Match"))
+
+	code, _, stderr = runCLI("config", "set", "poc.database", "postgres://db/other", "--server", api)
+	assert.Equal(t, ExitForbidden, code)
+	assert.Contains(t, stderr, "requires approval")
+
+	code, out, _ := runCLI("config", "set", "--server", api, "app.name", "--", "-1")
+	assert.Equal(t, ExitOK, code)
+	assert.Contains(t, out, "-1")
+
+	code, _, _ = runCLI("config", "get", "app.name", "--server", "http://127.0.0.1:1/api")
+	assert.Equal(t, ExitFailure, code)
+
+	for _, args := range [][]string{
+		{},
+		{"config"},
+		{"config", "unknown"},
+		{"config", "get"},
+		{"config", "list", "-o", "xml"},
+	} {
+		code, _, _ = runCLI(args...)
+		assert.Equal(t, ExitUsage, code, "args: %v", args)
+	}
+}
+
+// TestRun_Diff 测试 diff：无变更 0、有变更 6、存在无效键 4
+func TestRun_Diff(t *testing.T) {
+	server, last := newTestAPI(t)
+	api := server.URL + "/api"
+	dir := t.TempDir()
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	code, out, _ := runCLI("config", "diff", write("same.yaml", "app:\n  name: apprun\n"), "--server", api)
+	assert.Equal(t, ExitOK, code)
+	assert.NotContains(t, out, "app.name")
+	assert.Equal(t, "true", last.URL.Query().Get("dry_run"))
+
+	code, out, _ = runCLI("config", "diff", write("changed.yaml", "# changed\n"), "--server", api)
+	assert.Equal(t, ExitChanged, code)
+	assert.Contains(t, out, "logger.level")
+
+	code, _, _ = runCLI("config", "diff", write("invalid.yaml", "# changed invalid\n"), "--server", api)
+	assert.Equal(t, ExitRejected, code)
+
+	code, _, _ = runCLI("config", "diff", filepath.Join(dir, "none.yaml"), "--server", api)
+	assert.Equal(t, ExitFailure, code)
+}
+
+// TestRun_Export 测试导出格式随 -o 或 --format 选择
+func TestRun_Export(t *testing.T) {
+	server, _ := newTestAPI(t)
+	api := server.URL + "/api"
+
+	_, out, _ := runCLI("config", "export", "--server", api)
+	assert.Equal(t, "format: yaml\n", out)
+
+	_, out, _ = runCLI("config", "export", "-o", "json", "--server", api)
+	assert.Equal(t, "format: json\n", out)
+
+	_, out, _ = runCLI("config", "export", "--format", "json", "--server", api)
+	assert.Equal(t, "format: json\n", out)
+}
+
+// TestParseInterspersed 测试参数与 flag 混排
+func TestParseInterspersed(t *testing.T) {
+	fs, opts := newFlagSet(io.Discard)
+	positional, err := parseInterspersed(fs, []string{"set", "-o", "json", "app.name", "--scope", "project:a", "--", "-v"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"set", "app.name", "-v"}, positional)
+	assert.Equal(t, "json", opts.output)
+	assert.Equal(t, "project:a", opts.scope)
+
+	fs, _ = newFlagSet(io.Discard)
+	_, err = parseInterspersed(fs, []string{"get", "-h"})
+	assert.ErrorIs(t, err, flag.ErrHelp)
+}
+
+// TestRun_OfflineFileStore 测试 --offline 使用 file 存储，无需数据库
+func TestRun_OfflineFileStore(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "default.yaml"), []byte(`
+app:
+  name: "test-app"
+  version: "1.0.0"
+config_store:
+  provider: file
+poc:
+  enabled: false
+  database: "http://localhost:5432/poc"
+  api_key: "test-api-key-12345"
+`), 0644))
+	storePath := filepath.Join(dir, "data", "config-store.json")
+	t.Setenv("CONFIG_STORE_FILE", storePath)
+
+	code, _, stderr := runCLI("config", "set", "app.name", "offline-app", "--offline", "--config-dir", dir, "--actor", "ops")
+	require.Equal(t, ExitOK, code, stderr)
+
+	code, out, _ := runCLI("config", "get", "app.name", "--offline", "--config-dir", dir, "-o", "json")
+	require.Equal(t, ExitOK, code)
+	var resp config.GetConfigResponse
+	require.NoError(t, json.Unmarshal([]byte(out), &resp))
+	assert.Equal(t, "offline-app", resp.Value)
+	assert.Equal(t, 1, resp.Revision)
+
+	_, err := os.Stat(storePath)
+	require.NoError(t, err)
+
+	// approval:"required" 的配置项只创建变更申请
+	code, out, stderr = runCLI("config", "set", "poc.database", "http://localhost:5432/other", "--offline", "--config-dir", dir, "--actor", "ops")
+	require.Equal(t, ExitOK, code, stderr)
+	assert.Contains(t, out, "pending (needs approval)")
+
+	for _, args := range [][]string{
+		{"config", "migrate", "file", "file"},
+		{"config", "migrate", "file", "bolt"},
+	} {
+		code, _, _ = runCLI(args...)
+		assert.Equal(t, ExitUsage, code, "args: %v", args)
+	}
+}
+
+// TestHandlerTransport 测试 --offline 的进程内传输返回处理器写入的状态码、响应头与响应体
+func TestHandlerTransport(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/created", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/api/config/changes/1")
+		w.WriteHeader(http.StatusAccepted)
+		w.WriteHeader(http.StatusInternalServerError) // 重复设置被忽略
+		_, _ = w.Write([]byte(`{"id":1}`))
+	})
+	mux.HandleFunc("/implicit", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	client := &http.Client{Transport: handlerTransport{handler: mux}}
+
+	resp, err := client.Get("http://offline/created")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, "/api/config/changes/1", resp.Header.Get("Location"))
+	assert.Equal(t, `{"id":1}`, string(body))
+
+	resp, err = client.Get("http://offline/implicit")
+	require.NoError(t, err)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok", string(body))
+}
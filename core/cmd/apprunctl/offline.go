@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"apprun/modules/config"
+	"apprun/pkg/database"
+	"apprun/pkg/logger"
+
+	"github.com/go-chi/chi/v5"
+	_ "github.com/lib/pq"
+)
+
+// offlineBaseURL is the base URL of the in-process API used with --offline
+const offlineBaseURL = "http://offline/api"
+
+// handlerTransport serves requests with an in-process handler instead of the network
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := &bufferedResponse{header: make(http.Header)}
+	t.handler.ServeHTTP(w, req)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", w.status, http.StatusText(w.status)),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(bytes.NewReader(w.body.Bytes())),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}, nil
+}
+
+// bufferedResponse is an http.ResponseWriter that keeps the whole response in memory
+// Streaming is not supported (no http.Flusher), so long-lived endpoints such as
+// /config/watch are not available offline
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponse) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponse) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedResponse) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+// offlineEnv is the config the server would start with: bootstrap (registered
+// modules and master key) and infrastructure config from configDir and env vars
+type offlineEnv struct {
+	bootstrap *config.Bootstrap
+	infra     *config.InfraConfig
+}
+
+// loadOfflineEnv loads the server's config the same way the server does
+func loadOfflineEnv(ctx context.Context, configDir string) (*offlineEnv, error) {
+	// Registered modules must match the server so every module key is known
+	registry := config.NewRegistry()
+	if err := registry.Register("logger", &logger.Config{}); err != nil {
+		return nil, fmt.Errorf("failed to register logger config: %w", err)
+	}
+
+	secretCipher, err := config.NewSecretCipherFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", config.MasterKeyEnv, err)
+	}
+	if secretCipher == nil {
+		fmt.Fprintf(os.Stderr, "warning: %s not set, secret config values are written unencrypted\n", config.MasterKeyEnv)
+	}
+
+	bootstrap := config.NewBootstrapWithRegistry(configDir, registry).WithSecretCipher(secretCipher)
+	infraCfg, err := bootstrap.LoadInfraConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid infrastructure config: %w", err)
+	}
+	return &offlineEnv{bootstrap: bootstrap, infra: infraCfg}, nil
+}
+
+// openStore opens the dynamic config store called provider (database or file)
+// The database schema belongs to the server and is not migrated here.
+// The returned function releases the database connection
+func (e *offlineEnv) openStore(ctx context.Context, provider string) (config.ConfigProvider, func(), error) {
+	storeCfg := *e.infra.Store
+	storeCfg.Provider = provider
+
+	var dbClient database.Client
+	closeDB := func() {}
+	if provider == config.StoreProviderDatabase {
+		client, err := database.Open(ctx, e.infra.Database)
+		if err != nil {
+			return nil, nil, err
+		}
+		dbClient, closeDB = client, func() { client.Close() }
+	}
+
+	store, err := config.OpenProvider(&storeCfg, dbClient)
+	if err != nil {
+		closeDB()
+		return nil, nil, err
+	}
+	return store, closeDB, nil
+}
+
+// newOfflineTransport opens the config store the server uses (config_store.provider)
+// and serves the config API in-process
+func newOfflineTransport(ctx context.Context, configDir string) (http.RoundTripper, func(), error) {
+	offline, err := loadOfflineEnv(ctx, configDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, closeStore, err := offline.openStore(ctx, offline.infra.Store.Provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configService, err := offline.bootstrap.CreateServiceWithProvider(ctx, store)
+	if err != nil {
+		closeStore()
+		return nil, nil, fmt.Errorf("failed to create config service: %w", err)
+	}
+
+	router := chi.NewRouter()
+	router.Route("/api", func(r chi.Router) {
+		config.NewHandler(configService).RegisterRoutes(r)
+	})
+
+	return handlerTransport{handler: router}, closeStore, nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// table writes tab-aligned rows
+type table struct {
+	tw *tabwriter.Writer
+}
+
+func (t *table) row(columns ...string) {
+	fmt.Fprintln(t.tw, strings.Join(columns, "\t"))
+}
+
+// render prints v as JSON or YAML using its JSON field names, or as a table built by rows
+func render(w io.Writer, output string, v interface{}, rows func(t *table)) error {
+	switch output {
+	case OutputJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+
+	case OutputYAML:
+		// Round trip through JSON so YAML keys match the API field names
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		var doc interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+
+	default:
+		t := &table{tw: tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)}
+		rows(t)
+		return t.tw.Flush()
+	}
+}
+
+// formatValue renders a typed JSON value for a table cell
+func formatValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		return value
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(data)
+	}
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,73 @@
+// Command reencrypt rewrites secret config values (secret:"true") stored in the
+// database with the current master key.
+//
+// Use it after enabling encryption on an existing database, or to rotate the
+// master key:
+//
+//	CONFIG_MASTER_KEY=<new key> CONFIG_MASTER_KEY_PREVIOUS=<old key> reencrypt
+//
+// Once it succeeds the old key can be removed from CONFIG_MASTER_KEY_PREVIOUS.
+// History entries written with the old key are then shown masked and can no
+// longer be used for rollback.
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"apprun/modules/config"
+	"apprun/pkg/database"
+	"apprun/pkg/env"
+	"apprun/pkg/logger"
+
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	// Same infrastructure config sources as the server (default.yaml -> env)
+	configDir := env.Get("CONFIG_DIR", "./config")
+	if err := env.LoadConfigToEnv(configDir); err != nil {
+		log.Printf("⚠️  Warning: Failed to load config file: %v", err)
+	}
+
+	secretCipher, err := config.NewSecretCipherFromEnv()
+	if err != nil {
+		log.Fatalf("❌ Invalid %s: %v", config.MasterKeyEnv, err)
+	}
+	if secretCipher == nil {
+		log.Fatalf("❌ %s is required to encrypt secret config values", config.MasterKeyEnv)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// Registered modules must match the server so every secret key is known
+	registry := config.NewRegistry()
+	if err := registry.Register("logger", &logger.Config{}); err != nil {
+		log.Fatalf("❌ Failed to register logger config: %v", err)
+	}
+
+	dbClient, err := database.Connect(ctx, database.DefaultConfig())
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer dbClient.Close()
+
+	configService, err := config.NewBootstrapWithRegistry(configDir, registry).
+		WithSecretCipher(secretCipher).
+		CreateService(ctx, dbClient)
+	if err != nil {
+		log.Fatalf("❌ Failed to create config service: %v", err)
+	}
+
+	keys, err := configService.ReencryptSecrets(config.WithActor(ctx, "reencrypt"))
+	if err != nil {
+		log.Fatalf("❌ Re-encryption failed: %v", err)
+	}
+
+	log.Printf("✅ Re-encrypted %d secret config value(s) with master key %s", len(keys), secretCipher.KeyID())
+	for _, key := range keys {
+		log.Printf("   - %s", key)
+	}
+}
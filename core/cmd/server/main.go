@@ -7,6 +7,7 @@ import (
 
 	_ "apprun/docs" // Swagger docs (自动生成)
 	"apprun/modules/config"
+	"apprun/modules/flags"
 	"apprun/pkg/database"
 	"apprun/pkg/env"
 	"apprun/pkg/logger"
@@ -16,6 +17,10 @@ import (
 	_ "github.com/lib/pq"
 )
 
+// loggerCloseDelay is how long a replaced business logger stays open for
+// goroutines still writing through it
+const loggerCloseDelay = 5 * time.Second
+
 // @title           AppRun API
 // @version         1.0
 // @description     AppRun Platform REST API Documentation
@@ -39,15 +44,6 @@ func main() {
 		}
 	}()
 
-	// Phase 0: Load infrastructure config from file to environment variables
-	// This allows default.yaml to provide defaults while respecting existing env vars
-	// Priority: runtime env > config file > code defaults
-	configDir := env.Get("CONFIG_DIR", "./config")
-	if err := env.LoadConfigToEnv(configDir); err != nil {
-		log.Printf("⚠️  Warning: Failed to load config file: %v", err)
-		log.Println("⚠️  Using environment variables and code defaults only")
-	}
-
 	// Create context with timeout for startup phase
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -55,48 +51,104 @@ func main() {
 	// Phase 1: Initialize Configuration Module Registry
 	// Register business module configurations for centralized management
 	// Note: Infrastructure configs (server, database) are NOT registered here
-	// They are managed via environment variables loaded in Phase 0
+	// They are loaded by bootstrap.LoadInfraConfig in Phase 2
 	registry := config.NewRegistry()
 	if err := registry.Register("logger", &logger.Config{}); err != nil {
 		log.Fatalf("❌ Failed to register logger config: %v", err)
 	}
 	log.Println("✅ Logger module registered with config center")
 
+	// Master key for secret config values (secret:"true"), encrypted at rest in the database
+	secretCipher, err := config.NewSecretCipherFromEnv()
+	if err != nil {
+		log.Fatalf("❌ Invalid %s: %v", config.MasterKeyEnv, err)
+	}
+	if secretCipher == nil {
+		log.Printf("⚠️  Warning: %s not set, secret config values are stored unencrypted", config.MasterKeyEnv)
+	}
+
 	// Create configuration bootstrapper with registry
-	bootstrap := config.NewBootstrapWithRegistry(env.Get("CONFIG_DIR", "./config"), registry)
+	configDir := env.Get("CONFIG_DIR", "./config")
+	bootstrap := config.NewBootstrapWithRegistry(configDir, registry).
+		WithSecretCipher(secretCipher)
+
+	// Phase 2: Load infrastructure config (server, database) in pre-DB mode
+	// Same loader and key names as the config center: tag defaults < default.yaml < profile
+	// < specialized files < conf_d < env vars (e.g. database.db_name / DATABASE_DB_NAME)
+	// Every validation problem is reported at once
+	infraCfg, err := bootstrap.LoadInfraConfig(ctx)
+	if err != nil {
+		log.Fatalf("❌ Invalid infrastructure config: %v", err)
+	}
+	dbCfg, serverCfg, storeCfg := infraCfg.Database, infraCfg.Server, infraCfg.Store
 
-	// Phase 2: Connect to Database (Layer 1 infrastructure)
-	// Database connection is required for config service and business logic
-	// Note: DB_PASSWORD environment variable is required (set via Phase 0 or export)
-	dbCfg := database.DefaultConfig()
-	dbClient, err := database.Connect(ctx, dbCfg)
+	// Phase 3: Connect to Database (Layer 1 infrastructure)
+	// The database is required when dynamic config is stored in it (config_store.provider=database);
+	// with the file store the server keeps running without it, only feature flags are disabled
+	connectCtx, cancelConnect := ctx, func() {}
+	if storeCfg.Provider == config.StoreProviderFile {
+		// Optional connection: do not spend the whole startup budget waiting for it
+		connectCtx, cancelConnect = context.WithTimeout(ctx, 5*time.Second)
+	}
+	dbClient, err := database.Connect(connectCtx, dbCfg)
+	cancelConnect()
 	if err != nil {
-		log.Fatalf("❌ Failed to connect to database: %v", err)
+		if storeCfg.Provider != config.StoreProviderFile {
+			log.Fatalf("❌ Failed to connect to database: %v", err)
+		}
+		log.Printf("⚠️  Warning: Database unavailable, continuing with the file config store: %v", err)
+		dbClient = nil
+	} else {
+		defer dbClient.Close()
+		log.Println("✅ Database connected")
 	}
-	defer dbClient.Close()
-	log.Println("✅ Database connected")
 
-	// Phase 3: Initialize Config Service (Layer 2 - Configuration Center)
-	// Config service manages runtime configurations stored in database
-	configService, err := bootstrap.CreateService(ctx, dbClient)
+	// Phase 4: Initialize Config Service (Layer 2 - Configuration Center)
+	// Config service manages runtime configurations stored in the selected config store
+	var configService *config.Service
+	configStore, err := config.OpenProvider(storeCfg, dbClient)
+	if err == nil {
+		configService, err = bootstrap.CreateServiceWithProvider(ctx, configStore)
+	}
 	if err != nil {
 		log.Printf("⚠️  Warning: Failed to create config service: %v", err)
 		log.Println("⚠️  Config API routes will not be registered")
+		configService = nil
+	} else {
+		log.Printf("✅ Config service initialized with %s store", storeCfg.Provider)
+	}
+
+	// Feature flags are stored next to the config center and share its change attribution (X-Actor)
+	var flagService *flags.Service
+	if dbClient != nil {
+		flagService = flags.NewService(flags.NewRepository(dbClient))
+		if err := flagService.Load(ctx); err != nil {
+			log.Printf("⚠️  Warning: Failed to load feature flags: %v", err)
+			log.Println("⚠️  Feature flag routes will not be registered")
+			flagService = nil
+		} else {
+			log.Println("✅ Feature flag service initialized")
+		}
 	} else {
-		log.Println("✅ Config service initialized with DB support")
+		log.Println("⚠️  Feature flag routes will not be registered (no database)")
 	}
 
-	// Phase 4: Initialize Business Logger (Layer 2 - Runtime Logger)
+	// Phase 5: Initialize Business Logger (Layer 2 - Runtime Logger)
 	// Business logger is used for application runtime logging (request handling, business logic)
 	// Startup logs continue using standard log package (this is still bootstrap phase)
 	loggerCfg := logger.Config{
-		Level: logger.LevelInfo, // Default level, can be overridden by config service
+		Level: logger.LevelInfo, // Fallback when the config service is unavailable
 		Output: logger.OutputConfig{
 			Targets: []string{"stdout"},
 		},
 	}
-	// TODO: Load logger config from config service in future iterations
-	// For now, use default config for business logger initialization
+	if configService != nil {
+		if cfg, err := config.Module[logger.Config](configService, "logger"); err == nil {
+			loggerCfg = cfg
+		} else {
+			log.Printf("⚠️  Warning: Failed to read logger config, using defaults: %v", err)
+		}
+	}
 	businessLogger, err := logger.NewZapLogger(loggerCfg)
 	if err != nil {
 		log.Printf("⚠️  Warning: Failed to initialize business logger: %v", err)
@@ -104,30 +156,92 @@ func main() {
 		// Fallback to NopLogger if initialization fails
 	} else {
 		logger.SetLogger(businessLogger)
-		defer businessLogger.Close()
+		defer func() { logger.L().Close() }()
 		log.Println("✅ Business logger initialized (runtime logging ready)")
+
+		// React to logger.* changes from the config center without restart
+		if configService != nil {
+			configService.Watch("logger.", func(event config.ChangeEvent) {
+				reloadBusinessLogger(configService, event)
+			})
+		}
+	}
+
+	// Runtime context for background workers, cancelled when main returns
+	runCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	// Hot reload file-based config layers (default.yaml, specialized files, conf_d)
+	if configService != nil {
+		if err := configService.WatchFiles(runCtx, config.DefaultReloadDebounce); err != nil {
+			log.Printf("⚠️  Warning: Config file hot reload disabled: %v", err)
+		} else {
+			log.Println("✅ Config file hot reload enabled")
+		}
+	}
+
+	// Follow dynamic config changes committed by other instances (Postgres LISTEN/NOTIFY),
+	// reconciling periodically to catch notifications missed while disconnected.
+	// The file store has no notifications; it is reconciled periodically to pick up
+	// changes written by other processes (e.g. apprunctl --offline)
+	if configService != nil && storeCfg.Provider == config.StoreProviderFile {
+		if err := configService.SyncChanges(runCtx, nil, config.DefaultSyncInterval); err != nil {
+			log.Printf("⚠️  Warning: Config store sync disabled: %v", err)
+		} else {
+			log.Printf("✅ Config store sync enabled (%s)", storeCfg.File)
+		}
+	} else if configService != nil {
+		listener, err := dbClient.Listen(config.ChangeChannel)
+		if err == nil {
+			err = configService.SyncChanges(runCtx, listener, config.DefaultSyncInterval)
+		}
+		if err != nil {
+			log.Printf("⚠️  Warning: Multi-instance config sync disabled: %v", err)
+		} else {
+			log.Println("✅ Multi-instance config sync enabled")
+		}
+	}
+
+	// Reload feature flags changed by other instances (Postgres LISTEN/NOTIFY),
+	// and periodically to catch notifications missed while disconnected
+	if flagService != nil {
+		listener, err := dbClient.Listen(flags.ChangeChannel)
+		if err != nil {
+			log.Printf("⚠️  Warning: Feature flag change notifications disabled, reloading periodically: %v", err)
+			listener = nil
+		}
+		flagService.SyncChanges(runCtx, listener, config.DefaultSyncInterval)
+		log.Println("✅ Feature flag sync enabled")
+	}
+
+	// Apply scheduled config changes (PUT /api/config with effective_at/expires_at)
+	// and restore prior values at expiry; schedules persist in the config store
+	if configService != nil {
+		if err := configService.RunScheduler(runCtx, config.DefaultScheduleInterval); err != nil {
+			log.Printf("⚠️  Warning: Config scheduler disabled: %v", err)
+		} else {
+			log.Println("✅ Config scheduler enabled")
+		}
 	}
 
-	// Phase 5: Setup HTTP Routes
+	// Phase 6: Setup HTTP Routes
 	// Register all HTTP handlers and middleware
-	router := routes.SetupRoutes(configService)
+	router := routes.SetupRoutes(configService, flagService)
 	log.Println("✅ HTTP routes configured")
 
-	// Phase 6: Configure HTTP/HTTPS Server
-	// Server configuration is automatically loaded from environment variables by DefaultConfig()
-	// Environment variables are set in Phase 0 by LoadConfigToEnv() from default.yaml
-	// Naming convention: SERVER_HTTP_PORT, SERVER_HTTPS_PORT, SERVER_SSL_CERT_FILE, etc.
-	serverCfg := server.DefaultConfig()
-
 	// Print startup summary (still using standard log - bootstrap phase)
 	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	log.Println("🚀 AppRun Server Starting...")
-	log.Printf("   Database: %s@%s:%d/%s", dbCfg.User, dbCfg.Host, dbCfg.Port, dbCfg.DBName)
+	if dbClient != nil {
+		log.Printf("   Database: %s@%s:%d/%s", dbCfg.User, dbCfg.Host, dbCfg.Port, dbCfg.DBName)
+	}
+	log.Printf("   Config Store: %s", storeCfg.Provider)
 	log.Printf("   HTTP Port: %s", serverCfg.HTTPPort)
 	if serverCfg.SSLCertFile != "" {
 		log.Printf("   HTTPS Port: %s (TLS Enabled)", serverCfg.HTTPSPort)
 	}
-	log.Printf("   Config Dir: %s", env.Get("CONFIG_DIR", "./config"))
+	log.Printf("   Config Dir: %s", configDir)
+	log.Printf("   Config Profile: %s", env.Get(config.ProfileEnv, "(none)"))
 	log.Printf("   Logger Level: %s", loggerCfg.Level)
 	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	log.Println("📝 Note: Using standard log for startup, business logger for runtime")
@@ -138,3 +252,41 @@ func main() {
 		log.Fatalf("❌ Server failed: %v", err)
 	}
 }
+
+// reloadBusinessLogger rebuilds the business logger from the bound logger module
+// config after a logger.* config change
+func reloadBusinessLogger(configService *config.Service, event config.ChangeEvent) {
+	newCfg, err := config.Module[logger.Config](configService, "logger")
+	if err == nil {
+		err = replaceBusinessLogger(newCfg)
+	}
+	if err != nil {
+		logger.Error("failed to apply logger config change",
+			logger.Field{Key: "key", Value: event.Key},
+			logger.Field{Key: "value", Value: event.NewValue},
+			logger.Field{Key: "error", Value: err})
+		return
+	}
+
+	logger.Info("logger config reloaded",
+		logger.Field{Key: "key", Value: event.Key},
+		logger.Field{Key: "old_value", Value: event.OldValue},
+		logger.Field{Key: "new_value", Value: event.NewValue},
+		logger.Field{Key: "actor", Value: event.Actor})
+}
+
+// replaceBusinessLogger swaps the global business logger for one built from cfg
+// Goroutines that fetched the previous logger may still be writing through it,
+// so it is closed only after loggerCloseDelay; log files kept by the new config
+// share their handle and stay open
+func replaceBusinessLogger(cfg logger.Config) error {
+	newLogger, err := logger.NewZapLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	oldLogger := logger.L()
+	logger.SetLogger(newLogger)
+	time.AfterFunc(loggerCloseDelay, func() { _ = oldLogger.Close() })
+	return nil
+}
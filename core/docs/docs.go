@@ -25,7 +25,7 @@ const docTemplate = `{
     "paths": {
         "/config": {
             "get": {
-                "description": "Query a single configuration item by key, returns value, source and dynamic flag",
+                "description": "Query a single configuration item by key, returns value, source and dynamic flag\nValues of secret configuration items (secret:\"true\") are masked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -43,31 +43,43 @@ const docTemplate = `{
                         "name": "key",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Configuration retrieved successfully",
+                        "description": "Configuration retrieved successfully (ETag header carries the revision)",
                         "schema": {
                             "$ref": "#/definitions/config.GetConfigResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Database revision of the key, e.g. \\\"3\\"
+                            }
                         }
                     },
                     "400": {
                         "description": "Missing key parameter",
                         "schema": {
-                            "$ref": "#/definitions/config.ErrorResponse"
+                            "$ref": "#/definitions/response.Response"
                         }
                     },
                     "404": {
                         "description": "Configuration not found",
                         "schema": {
-                            "$ref": "#/definitions/config.ErrorResponse"
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update a single dynamic configuration item (only for db:true configs).\nStatic configurations (db:false) cannot be updated via API.\nChanges are persisted to database and take effect immediately.",
+                "description": "Update a single dynamic configuration item (only for db:true configs).\nStatic configurations (db:false) cannot be updated via API.\nChanges are persisted to database and take effect immediately.\nThe value is a JSON value of the field type, e.g. true, 8080, \"30s\" or [\"stdout\",\"file:/var/log/a.log\"];\na JSON string is parsed as the field type, so \"true\" and true are equivalent.\nSend the ETag from GET /config as If-Match to reject the update when the key was changed concurrently.\nWith effective_at and/or expires_at (RFC 3339) the change is scheduled instead and the response data is a Schedule:\nit is applied at effective_at (now if omitted) and the prior value is restored at expires_at.\nSchedules are persisted and survive restarts; list them with GET /config/schedules, cancel with DELETE /config/schedules/{id}.\nKeys tagged approval:\"required\" (x-approval in GET /config/schema) are not written directly: the response is 202\nwith a pending ChangeRequest that another operator approves via POST /config/changes/{id}/approve. X-Actor is required for them.",
                 "consumes": [
                     "application/json"
                 ],
@@ -87,25 +99,67 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/config.UpdateConfigRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operator recorded in config history",
+                        "name": "X-Actor",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expected revision as returned in ETag, e.g. \\",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Configuration updated successfully",
+                        "description": "Configuration updated successfully (ETag header carries the new revision); a Schedule when effective_at or expires_at is set",
                         "schema": {
                             "$ref": "#/definitions/config.UpdateConfigResponse"
                         }
                     },
+                    "202": {
+                        "description": "Change request created for a key that requires approval (Location header points to it)",
+                        "schema": {
+                            "$ref": "#/definitions/config.ChangeRequest"
+                        }
+                    },
                     "400": {
-                        "description": "Invalid request or config not allowed to store in database",
+                        "description": "Invalid request, invalid schedule times or config not allowed to store in database",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Scheduled change of a key that requires approval",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Revision mismatch, error details carry the current value and revision",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing field, malformed If-Match header, If-Match on a scheduled change or approval request, missing X-Actor or invalid scope",
                         "schema": {
-                            "$ref": "#/definitions/config.ErrorResponse"
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a dynamic configuration item from database (config will fallback to file or default value).\nOnly dynamic configurations (db:true) can be deleted.\nAfter deletion, the config will use the value from config files or built-in defaults.",
+                "description": "Delete a dynamic configuration item from database (config will fallback to file or default value).\nOnly dynamic configurations (db:true) can be deleted.\nAfter deletion, the config will use the value from config files or built-in defaults.\nKeys that require approval (approval:\"required\") cannot be deleted through the API.",
                 "consumes": [
                     "application/json"
                 ],
@@ -124,6 +178,24 @@ const docTemplate = `{
                         "name": "key",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operator recorded in config history",
+                        "name": "X-Actor",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expected revision as returned in ETag, e.g. \\",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -137,7 +209,25 @@ const docTemplate = `{
                     "400": {
                         "description": "Missing key parameter or deletion failed",
                         "schema": {
-                            "$ref": "#/definitions/config.ErrorResponse"
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "The key requires approval",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Revision mismatch, error details carry the current value and revision",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Malformed If-Match header",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
@@ -167,9 +257,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/config/list": {
-            "get": {
-                "description": "Returns all dynamic configuration items stored in database.\nThis does not include static configurations from files.\nUse this to see which configs have been overridden dynamically.",
+        "/config/batch": {
+            "put": {
+                "description": "Update several dynamic configuration items (db:true) together.\nThe merged configuration is validated once with all changes applied, then every key is written in a single transaction.\nEither all items are applied or none of them.\nKeys that require approval (approval:\"required\") are rejected; change them one at a time with PUT /config.",
                 "consumes": [
                     "application/json"
                 ],
@@ -179,117 +269,2225 @@ const docTemplate = `{
                 "tags": [
                     "config"
                 ],
-                "summary": "List dynamic configurations",
+                "summary": "Update configuration items in a batch",
+                "parameters": [
+                    {
+                        "description": "Batch update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/config.BatchUpdateConfigRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operator recorded in config history",
+                        "name": "X-Actor",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "Configuration list",
+                        "description": "All configuration items updated",
                         "schema": {
-                            "$ref": "#/definitions/config.ListConfigsResponse"
+                            "$ref": "#/definitions/config.BatchUpdateConfigResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Invalid request or validation failed, nothing applied",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "A key requires approval",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Empty batch, missing field or duplicate key",
                         "schema": {
-                            "$ref": "#/definitions/config.ErrorResponse"
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "config.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "details": {
-                    "type": "string",
-                    "example": ""
-                },
-                "error": {
-                    "type": "string",
-                    "example": "missing 'key' query parameter"
-                }
-            }
         },
-        "config.GetConfigResponse": {
-            "type": "object",
-            "properties": {
-                "is_dynamic": {
-                    "description": "Whether it's a dynamic configuration",
-                    "type": "boolean",
-                    "example": false
-                },
-                "key": {
-                    "description": "Configuration key",
-                    "type": "string",
-                    "example": "app.name"
-                },
-                "source": {
-                    "description": "Source: \"database\", \"file\", \"env\", \"default\"",
-                    "type": "string",
-                    "example": "default"
-                },
-                "value": {
-                    "description": "Configuration value",
-                    "type": "string",
-                    "example": "apprun"
+        "/config/changes": {
+            "get": {
+                "description": "Returns change requests created by PUT /config for keys that require approval (approval:\"required\") across all scopes, oldest first.\nBy default only pending requests are returned; pending requests past expires_at are reported as expired.\nValues of secret configuration items are masked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "List configuration change requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated statuses: pending, approved, rejected, expired, failed or all (default: pending)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Change requests",
+                        "schema": {
+                            "$ref": "#/definitions/config.ListChangeRequestsResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list change requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
                 }
             }
         },
-        "config.ListConfigsResponse": {
-            "type": "object",
-            "properties": {
-                "configs": {
-                    "description": "Key-value mapping of dynamic configurations",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+        "/config/changes/{id}": {
+            "get": {
+                "description": "Returns a single change request; values of secret configuration items are masked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Get a configuration change request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Change request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Change request",
+                        "schema": {
+                            "$ref": "#/definitions/config.ChangeRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Change request not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid change request ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
                     }
-                },
-                "count": {
-                    "description": "Number of configuration items",
-                    "type": "integer",
-                    "example": 3
                 }
             }
         },
-        "config.UpdateConfigRequest": {
-            "type": "object",
-            "required": [
-                "key",
-                "value"
-            ],
-            "properties": {
-                "key": {
-                    "description": "Configuration key",
-                    "type": "string",
-                    "example": "poc.enabled"
-                },
-                "value": {
-                    "description": "New value",
-                    "type": "string",
-                    "example": "true"
+        "/config/changes/{id}/approve": {
+            "post": {
+                "description": "Approves a pending change request and applies it through the same validation as PUT /config,\nin the scope it was requested for; config history records the requester as the actor.\nThe approver (X-Actor, required) must be someone other than the requester.\nX-Actor is not authenticated by the server; deploy behind a proxy that authenticates users and sets X-Actor.\nWhen the value no longer validates, the request is marked failed and 400 is returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Approve a configuration change request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Change request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reviewer's comment",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/config.ReviewChangeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Approver",
+                        "name": "X-Actor",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Approved and applied change request",
+                        "schema": {
+                            "$ref": "#/definitions/config.ChangeRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or the approved value failed validation",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Approver missing or same as the requester",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Change request not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Change request already reviewed or expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid change request ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
                 }
             }
         },
-        "config.UpdateConfigResponse": {
-            "type": "object",
-            "properties": {
-                "key": {
-                    "type": "string",
-                    "example": "poc.enabled"
-                },
-                "message": {
-                    "type": "string",
-                    "example": "config updated successfully"
-                },
-                "success": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "value": {
-                    "type": "string",
-                    "example": "true"
+        "/config/changes/{id}/reject": {
+            "post": {
+                "description": "Rejects a pending change request; nothing is written.\nThe reviewer (X-Actor, required) must be someone other than the requester.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Reject a configuration change request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Change request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reviewer's comment",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/config.ReviewChangeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reviewer",
+                        "name": "X-Actor",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rejected change request",
+                        "schema": {
+                            "$ref": "#/definitions/config.ChangeRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Reviewer missing or same as the requester",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Change request not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Change request already reviewed or expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid change request ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/explain": {
+            "get": {
+                "description": "List the value every layer contributes for a key, lowest precedence first:\ntag default, default.yaml, specialized files, each conf_d file by name, database and the env var.\nThe layer in effect is marked; database values of db:false keys are marked as ignored.\nValues of secret configuration items (secret:\"true\") are masked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Explain configuration item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration key, e.g. poc.enabled",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Per-layer provenance of the key",
+                        "schema": {
+                            "$ref": "#/definitions/config.ExplainConfigResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Configuration not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing key parameter",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/export": {
+            "get": {
+                "description": "Render the fully merged configuration (application config and all registered modules) as a YAML or JSON document.\nSecret configuration items (secret:\"true\") are redacted. The document can be posted to /config/import.",
+                "produces": [
+                    "application/json",
+                    "application/x-yaml"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Export effective configuration",
+                "parameters": [
+                    {
+                        "enum": [
+                            "yaml",
+                            "json"
+                        ],
+                        "type": "string",
+                        "default": "yaml",
+                        "description": "Document format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Nested configuration document",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unsupported format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Config not loaded",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/history": {
+            "get": {
+                "description": "Returns the change history of a dynamic configuration item, newest first.\nEach entry records the old and new value, the actor, the request ID and the time of the change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "List configuration change history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "poc.enabled",
+                        "description": "Configuration key",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries to return (default: all)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration change history",
+                        "schema": {
+                            "$ref": "#/definitions/config.ListHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown configuration key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing key or invalid limit parameter",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/history/rollback": {
+            "post": {
+                "description": "Restore a dynamic configuration item to the value it had right after the given revision.\nThe restored value goes through the same validation as PUT /config and is recorded in history as a rollback.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Roll back configuration item",
+                "parameters": [
+                    {
+                        "description": "Rollback request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/config.RollbackConfigRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operator recorded in config history",
+                        "name": "X-Actor",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration rolled back successfully",
+                        "schema": {
+                            "$ref": "#/definitions/config.RollbackConfigResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, unknown revision or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "The key requires approval, submit the old value with PUT /config instead",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/import": {
+            "post": {
+                "description": "Compare a YAML or JSON configuration document (e.g. from /config/export) with the effective configuration.\nOnly dynamic keys (db:true) are applied, with the same validation as PUT /config, in a single transaction.\nStatic keys (db:false), unknown keys and redacted secrets are reported as skipped.\nWith dry_run=true only the diff is returned. When any key is invalid nothing is applied.",
+                "consumes": [
+                    "application/json",
+                    "application/x-yaml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Import configuration",
+                "parameters": [
+                    {
+                        "description": "Nested configuration document",
+                        "name": "document",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only return the diff",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operator recorded in config history",
+                        "name": "X-Actor",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Per-key diff and number of applied keys",
+                        "schema": {
+                            "$ref": "#/definitions/config.ImportConfigResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid document or rejected import, error details carry the per-key diff",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid dry_run parameter",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/list": {
+            "get": {
+                "description": "Returns all dynamic configuration items stored in database for the requested scope\n(values inherited from parent scopes are not included).\nThis does not include static configurations from files.\nUse this to see which configs have been overridden dynamically.\nValues of secret configuration items (secret:\"true\") are masked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "List dynamic configurations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration list",
+                        "schema": {
+                            "$ref": "#/definitions/config.ListConfigsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/reload/status": {
+            "get": {
+                "description": "Returns counters of file-triggered config reloads and the last reload error.\nA failed reload (parse or validation error) keeps the previous configuration in effect.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Get config file reload status",
+                "responses": {
+                    "200": {
+                        "description": "Reload status",
+                        "schema": {
+                            "$ref": "#/definitions/config.ReloadStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/schedules": {
+            "get": {
+                "description": "Returns changes scheduled with effective_at/expires_at on PUT /config across all scopes, oldest first.\nBy default only pending (not applied yet) and active (applied, waiting for expiry) schedules are returned.\nValues of secret configuration items are masked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "List scheduled configuration changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated statuses: pending, active, completed, cancelled, failed or all (default: pending,active)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled changes",
+                        "schema": {
+                            "$ref": "#/definitions/config.ListSchedulesResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list schedules",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/schedules/{id}": {
+            "delete": {
+                "description": "Cancels a pending schedule so it is never applied.\nCancelling an active schedule restores the prior value immediately (recorded in history as a revert),\nunless the key was changed after the schedule was applied.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Cancel a scheduled configuration change",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operator recorded in config history",
+                        "name": "X-Actor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cancelled schedule",
+                        "schema": {
+                            "$ref": "#/definitions/config.Schedule"
+                        }
+                    },
+                    "404": {
+                        "description": "Schedule not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Schedule already completed, cancelled or failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid schedule ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/schema": {
+            "get": {
+                "description": "JSON Schema (draft 2020-12) of the application config and all registered modules, generated from the struct tags:\ntypes, defaults, enums (oneof), min/max and other validate rules.\nx-dynamic marks keys that can be changed via the API (db:true), x-secret marks secret keys.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Get configuration JSON Schema",
+                "responses": {
+                    "200": {
+                        "description": "JSON Schema document (application/schema+json)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/config/sync/status": {
+            "get": {
+                "description": "Returns how this instance follows dynamic config changes committed by other instances\n(Postgres LISTEN/NOTIFY plus periodic reconciliation) and the last synchronization error.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Get config sync status",
+                "responses": {
+                    "200": {
+                        "description": "Sync status",
+                        "schema": {
+                            "$ref": "#/definitions/config.SyncStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/validate": {
+            "post": {
+                "description": "Run one or more key/value pairs through the same checks as PUT /config/batch without persisting anything:\neach key must be dynamic (db:true), each value must parse as the field type and satisfy its validate tag,\nthen the merged configuration (including registered modules) is validated as a whole.\nEvery failure is returned as a structured field error; values of secret items are masked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Validate configuration changes (dry run)",
+                "parameters": [
+                    {
+                        "description": "Changes to validate",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/config.ValidateConfigRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope: global (default), project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation result (valid is false when any error is reported)",
+                        "schema": {
+                            "$ref": "#/definitions/config.ValidateConfigResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Empty items, missing field or duplicate key",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/watch": {
+            "get": {
+                "description": "Streams changes of dynamic configuration items as Server-Sent Events.\nEach event has type \"change\", its id is the change revision and its data is a ChangeRecord JSON.\nTo resume after a disconnect, pass the last seen revision via the Last-Event-ID header or the revision parameter;\nall changes after that revision are replayed before live changes. Without a revision only new changes are sent.\nRevisions are assigned when a change is written, not when its transaction commits. Changes after a missing revision\nare held back for up to 2 seconds so that events stay in revision order; a missing revision is then assumed rolled back.\nIf it is committed later (within a minute), the change is sent as a \"reset\" event (data: ChangeRecord, id unchanged):\nthe client may have missed it and should re-read the configuration. Such late commits are not detected across reconnects.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Watch configuration changes (SSE)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "logger.",
+                        "description": "Only changes whose key starts with this prefix",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only changes of this scope: global, project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e (default: all scopes)",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this revision",
+                        "name": "revision",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resume after this revision (set automatically by EventSource)",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of change events",
+                        "schema": {
+                            "$ref": "#/definitions/config.ChangeRecord"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid revision or scope",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Streaming not supported",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/config/watch/poll": {
+            "get": {
+                "description": "Returns changes after the given revision. If there are none, the request blocks until a change happens or the timeout expires.\nPass the returned revision on the next call to continue without missing updates.\nWithout a revision the current revision is returned immediately, so clients can start from \"now\".\nChanges after a missing revision (a transaction that has not committed yet) are held back for up to 2 seconds;\na missing revision is then assumed rolled back and skipped. Commits later than that are not reported.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "config"
+                ],
+                "summary": "Long-poll configuration changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "logger.",
+                        "description": "Only changes whose key starts with this prefix",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only changes of this scope: global, project:\u003cid\u003e or project:\u003cid\u003e/user:\u003cid\u003e (default: all scopes)",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Return changes after this revision",
+                        "name": "revision",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum wait time, e.g. 30s (default 30s, max 60s)",
+                        "name": "timeout",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Changes after the revision (may be empty on timeout)",
+                        "schema": {
+                            "$ref": "#/definitions/config.WatchPollResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid revision, timeout or scope",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/flags": {
+            "get": {
+                "description": "Get the definition of a feature flag by key",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "flags"
+                ],
+                "summary": "Get feature flag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Flag key, e.g. checkout.new_flow",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Flag definition",
+                        "schema": {
+                            "$ref": "#/definitions/flags.Flag"
+                        }
+                    },
+                    "404": {
+                        "description": "Flag not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing key parameter",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create a feature flag or replace its whole definition. Flags without variants are boolean (on=true, off=false, default off).\nRules are checked in order, the first rule whose conditions all match serves its variant or rollout;\notherwise the flag-level rollout or default_variant is served. Rollout weights are percentages summing to 100,\nsubjects are bucketed by hashing the flag key with the user ID (project ID if no user).\nThe change is recorded in the flag history with the X-Actor header as actor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "flags"
+                ],
+                "summary": "Create or replace feature flag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Who makes the change (recorded in history)",
+                        "name": "X-Actor",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Flag definition (revision, updated_at and updated_by are ignored)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/flags.Flag"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved flag",
+                        "schema": {
+                            "$ref": "#/definitions/flags.Flag"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid flag definition",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a feature flag; evaluations then report reason \"not_found\"",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "flags"
+                ],
+                "summary": "Delete feature flag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Flag key",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who makes the change (recorded in history)",
+                        "name": "X-Actor",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Flag deleted",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Flag not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing key parameter",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/flags/evaluate": {
+            "post": {
+                "description": "Evaluate flags for a subject (user, project and custom attributes). Unknown flags are returned with reason \"not_found\" and a null value.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "flags"
+                ],
+                "summary": "Evaluate feature flags",
+                "parameters": [
+                    {
+                        "description": "Flags and subject to evaluate",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/flags.EvaluateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Evaluations",
+                        "schema": {
+                            "$ref": "#/definitions/flags.EvaluateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/flags/history": {
+            "get": {
+                "description": "List changes of a feature flag, newest first, with the full definition before and after each change",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "flags"
+                ],
+                "summary": "List feature flag history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Flag key",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of changes (0 = all)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Flag history",
+                        "schema": {
+                            "$ref": "#/definitions/flags.ListHistoryResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing key or invalid limit",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/flags/list": {
+            "get": {
+                "description": "List all feature flag definitions sorted by key",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "flags"
+                ],
+                "summary": "List feature flags",
+                "responses": {
+                    "200": {
+                        "description": "Flags",
+                        "schema": {
+                            "$ref": "#/definitions/flags.ListFlagsResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "config.BatchUpdateConfigRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "description": "Configuration items to update together",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/config.UpdateConfigRequest"
+                    }
+                }
+            }
+        },
+        "config.BatchUpdateConfigResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of updated items",
+                    "type": "integer",
+                    "example": 3
+                },
+                "items": {
+                    "description": "Updated configuration items",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.UpdateConfigResponse"
+                    }
+                }
+            }
+        },
+        "config.ChangeRecord": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Change action: set, delete, rollback, reencrypt, migrate, revert",
+                    "type": "string",
+                    "example": "set"
+                },
+                "actor": {
+                    "description": "Who made the change",
+                    "type": "string",
+                    "example": "admin"
+                },
+                "created_at": {
+                    "description": "When the change happened",
+                    "type": "string",
+                    "example": "2025-12-31T10:00:00+08:00"
+                },
+                "key": {
+                    "description": "Configuration key",
+                    "type": "string",
+                    "example": "poc.enabled"
+                },
+                "new_value": {
+                    "description": "Value after the change (null if deleted)",
+                    "type": "string",
+                    "example": "true"
+                },
+                "old_value": {
+                    "description": "Value before the change (null if not set)",
+                    "type": "string",
+                    "example": "false"
+                },
+                "request_id": {
+                    "description": "Request ID that triggered the change",
+                    "type": "string",
+                    "example": "host/abc-000001"
+                },
+                "revision": {
+                    "description": "Revision number of the change",
+                    "type": "integer",
+                    "example": 42
+                },
+                "scope": {
+                    "description": "Scope of the changed value",
+                    "type": "string",
+                    "example": "global"
+                }
+            }
+        },
+        "config.ChangeRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Reviewer's comment",
+                    "type": "string",
+                    "example": "checked with the DBA"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-12-31T10:00:00Z"
+                },
+                "expires_at": {
+                    "description": "Pending requests can no longer be reviewed after this time",
+                    "type": "string",
+                    "example": "2026-01-01T10:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "key": {
+                    "type": "string",
+                    "example": "poc.database"
+                },
+                "message": {
+                    "description": "Why an approved change could not be applied",
+                    "type": "string",
+                    "example": "config validation failed"
+                },
+                "request_id": {
+                    "description": "Request ID that created the change request",
+                    "type": "string",
+                    "example": "host/abc-000001"
+                },
+                "requested_by": {
+                    "description": "Operator who requested the change",
+                    "type": "string",
+                    "example": "alice"
+                },
+                "reviewed_by": {
+                    "description": "Operator who approved or rejected it",
+                    "type": "string",
+                    "example": "bob"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "global"
+                },
+                "status": {
+                    "description": "pending, approved, rejected, expired or failed",
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-12-31T10:05:00Z"
+                },
+                "value": {
+                    "description": "Requested value (secrets masked)",
+                    "type": "string",
+                    "example": "postgres://user:pass@db:5432/poc"
+                }
+            }
+        },
+        "config.ExplainConfigResponse": {
+            "type": "object",
+            "properties": {
+                "approval": {
+                    "description": "Whether API changes need a second operator's approval (approval:\"required\")",
+                    "type": "boolean",
+                    "example": false
+                },
+                "is_dynamic": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "key": {
+                    "type": "string",
+                    "example": "poc.enabled"
+                },
+                "layers": {
+                    "description": "Every examined layer, lowest precedence first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.ExplainLayer"
+                    }
+                },
+                "profile": {
+                    "description": "Active APP_ENV profile",
+                    "type": "string",
+                    "example": "staging"
+                },
+                "secret": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "source": {
+                    "description": "Name of the effective layer",
+                    "type": "string",
+                    "example": "database"
+                },
+                "value": {
+                    "description": "Effective value",
+                    "type": "object"
+                }
+            }
+        },
+        "config.ExplainLayer": {
+            "type": "object",
+            "properties": {
+                "effective": {
+                    "description": "Whether this is the value in effect",
+                    "type": "boolean",
+                    "example": true
+                },
+                "ignored": {
+                    "description": "Set but not applied",
+                    "type": "boolean",
+                    "example": false
+                },
+                "ignored_reason": {
+                    "description": "Why the value was not applied",
+                    "type": "string",
+                    "example": "db:false"
+                },
+                "layer": {
+                    "description": "Layer number, 1 (lowest) to 6 (highest)",
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "description": "tag_default, default_yaml, specialized_file, conf_d, database, env",
+                    "type": "string",
+                    "example": "conf_d"
+                },
+                "raw_value": {
+                    "description": "Value as written in the file, when it contains ${...} expressions",
+                    "type": "object"
+                },
+                "set": {
+                    "description": "Whether this layer sets the key",
+                    "type": "boolean",
+                    "example": true
+                },
+                "source": {
+                    "description": "File name, env var name, \"configitems[@\u003cscope\u003e]\" or \"default tag\"",
+                    "type": "string",
+                    "example": "conf_d/10-poc.yaml"
+                },
+                "value": {
+                    "description": "Value contributed by this layer, with ${...} expressions resolved",
+                    "type": "object"
+                }
+            }
+        },
+        "config.FieldError": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "Configuration key (validator namespace if it maps to no key)",
+                    "type": "string",
+                    "example": "poc.api_key"
+                },
+                "message": {
+                    "description": "Human readable description",
+                    "type": "string",
+                    "example": "failed 'min=10' validation"
+                },
+                "param": {
+                    "description": "Rule parameter (field type for rule \"type\")",
+                    "type": "string",
+                    "example": "10"
+                },
+                "rule": {
+                    "description": "Failed rule: validate tag, or unknown, db, type",
+                    "type": "string",
+                    "example": "min"
+                },
+                "stage": {
+                    "description": "key, value or config (merged struct)",
+                    "type": "string",
+                    "example": "value"
+                },
+                "value": {
+                    "description": "Offending value (secrets masked)",
+                    "type": "object"
+                }
+            }
+        },
+        "config.GetConfigResponse": {
+            "type": "object",
+            "properties": {
+                "from_scope": {
+                    "description": "Scope that supplied the value (source \"database\")",
+                    "type": "string",
+                    "example": "project:alpha"
+                },
+                "is_dynamic": {
+                    "description": "Whether it's a dynamic configuration",
+                    "type": "boolean",
+                    "example": false
+                },
+                "key": {
+                    "description": "Configuration key",
+                    "type": "string",
+                    "example": "app.name"
+                },
+                "revision": {
+                    "description": "Database revision in the requested scope (0 if not stored), also returned as ETag",
+                    "type": "integer",
+                    "example": 3
+                },
+                "scope": {
+                    "description": "Requested scope",
+                    "type": "string",
+                    "example": "global"
+                },
+                "source": {
+                    "description": "Source: \"database\", \"file\", \"profile:\u003cname\u003e\", \"env\", \"default\"",
+                    "type": "string",
+                    "example": "default"
+                },
+                "source_file": {
+                    "description": "File that supplied the value (source \"file\" or \"profile:\u003cname\u003e\"), relative to the config dir",
+                    "type": "string",
+                    "example": "conf_d/10-db.yaml"
+                },
+                "value": {
+                    "description": "Configuration value, typed by the field (string, number, bool, list, map; durations as \"30s\")",
+                    "type": "object"
+                }
+            }
+        },
+        "config.ImportChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "update, unchanged, skipped or invalid",
+                    "type": "string",
+                    "example": "update"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "poc.enabled"
+                },
+                "new_value": {
+                    "description": "Imported value",
+                    "type": "object"
+                },
+                "old_value": {
+                    "description": "Effective value before import",
+                    "type": "object"
+                },
+                "reason": {
+                    "description": "Why the key is skipped or invalid",
+                    "type": "string",
+                    "example": "db:false"
+                }
+            }
+        },
+        "config.ImportConfigResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "description": "Number of keys written to the database",
+                    "type": "integer",
+                    "example": 2
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.ImportChange"
+                    }
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "config.ListChangeRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "Change requests, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.ChangeRequest"
+                    }
+                },
+                "count": {
+                    "description": "Number of change requests returned",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "config.ListConfigsResponse": {
+            "type": "object",
+            "properties": {
+                "configs": {
+                    "description": "Key to typed value mapping of dynamic configurations",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "count": {
+                    "description": "Number of configuration items",
+                    "type": "integer",
+                    "example": 3
+                },
+                "scope": {
+                    "description": "Requested scope",
+                    "type": "string",
+                    "example": "global"
+                }
+            }
+        },
+        "config.ListHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of changes returned",
+                    "type": "integer",
+                    "example": 2
+                },
+                "history": {
+                    "description": "Changes, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.ChangeRecord"
+                    }
+                },
+                "key": {
+                    "description": "Configuration key",
+                    "type": "string",
+                    "example": "poc.enabled"
+                }
+            }
+        },
+        "config.ListSchedulesResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of schedules returned",
+                    "type": "integer",
+                    "example": 1
+                },
+                "schedules": {
+                    "description": "Scheduled changes, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.Schedule"
+                    }
+                }
+            }
+        },
+        "config.ReloadStatus": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "description": "Rejected reloads (load or validation error)",
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_error": {
+                    "description": "Error of the last failed reload",
+                    "type": "string",
+                    "example": "config validation failed"
+                },
+                "last_error_at": {
+                    "description": "Time of the last failed reload",
+                    "type": "string",
+                    "example": "2025-12-31T09:59:00Z"
+                },
+                "last_reload_at": {
+                    "description": "Time of the last successful reload",
+                    "type": "string",
+                    "example": "2025-12-31T10:00:00Z"
+                },
+                "reloads": {
+                    "description": "Successful reloads",
+                    "type": "integer",
+                    "example": 3
+                },
+                "watching": {
+                    "description": "Whether the config directory is being watched",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "config.ReviewChangeRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Reviewer's comment",
+                    "type": "string",
+                    "example": "checked with the DBA"
+                }
+            }
+        },
+        "config.RollbackConfigRequest": {
+            "type": "object",
+            "required": [
+                "key",
+                "revision"
+            ],
+            "properties": {
+                "key": {
+                    "description": "Configuration key",
+                    "type": "string",
+                    "example": "poc.enabled"
+                },
+                "revision": {
+                    "description": "Revision to restore",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "config.RollbackConfigResponse": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "example": "poc.enabled"
+                },
+                "revision": {
+                    "description": "Revision that was restored",
+                    "type": "integer",
+                    "example": 42
+                },
+                "value": {
+                    "description": "Restored value (null if the key was removed)",
+                    "type": "string",
+                    "example": "true"
+                }
+            }
+        },
+        "config.Schedule": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "Who scheduled the change",
+                    "type": "string",
+                    "example": "admin"
+                },
+                "applied_revision": {
+                    "description": "Revision written at effective_at; a later change skips the revert",
+                    "type": "integer",
+                    "example": 5
+                },
+                "created_at": {
+                    "description": "When the schedule was created",
+                    "type": "string",
+                    "example": "2025-12-31T21:00:00Z"
+                },
+                "effective_at": {
+                    "description": "When the change is applied",
+                    "type": "string",
+                    "example": "2025-12-31T22:00:00Z"
+                },
+                "expires_at": {
+                    "description": "When the prior value is restored (null: kept)",
+                    "type": "string",
+                    "example": "2025-12-31T22:30:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "key": {
+                    "type": "string",
+                    "example": "logger.level"
+                },
+                "message": {
+                    "description": "Failure reason, or why the prior value was not restored",
+                    "type": "string",
+                    "example": "config validation failed"
+                },
+                "prior_value": {
+                    "description": "Stored value replaced at effective_at (null if not stored), restored at expiry",
+                    "type": "string",
+                    "example": "info"
+                },
+                "request_id": {
+                    "description": "Request ID that created the schedule",
+                    "type": "string",
+                    "example": "host/abc-000001"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "global"
+                },
+                "status": {
+                    "description": "pending, active, completed, cancelled or failed",
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "description": "Last status change",
+                    "type": "string",
+                    "example": "2025-12-31T22:00:01Z"
+                },
+                "value": {
+                    "description": "Value written at effective_at",
+                    "type": "string",
+                    "example": "debug"
+                }
+            }
+        },
+        "config.SyncStatus": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "description": "Failed synchronizations (query, load or validation error)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "last_error": {
+                    "description": "Error of the last failed synchronization",
+                    "type": "string",
+                    "example": "config validation failed"
+                },
+                "last_error_at": {
+                    "description": "Time of the last failed synchronization",
+                    "type": "string",
+                    "example": "2025-12-31T09:59:00Z"
+                },
+                "last_sync_at": {
+                    "description": "Time of the last reload",
+                    "type": "string",
+                    "example": "2025-12-31T10:00:00Z"
+                },
+                "listening": {
+                    "description": "Whether change notifications are being received",
+                    "type": "boolean",
+                    "example": true
+                },
+                "revision": {
+                    "description": "Latest revision reflected by this instance",
+                    "type": "integer",
+                    "example": 42
+                },
+                "syncs": {
+                    "description": "Reloads after new global changes were found in the database",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "config.UpdateConfigRequest": {
+            "type": "object",
+            "required": [
+                "key",
+                "value"
+            ],
+            "properties": {
+                "effective_at": {
+                    "description": "Apply the change at this time instead of now (PUT /config only)",
+                    "type": "string",
+                    "example": "2025-12-31T22:00:00Z"
+                },
+                "expires_at": {
+                    "description": "Restore the prior value at this time (PUT /config only)",
+                    "type": "string",
+                    "example": "2025-12-31T22:30:00Z"
+                },
+                "key": {
+                    "description": "Configuration key",
+                    "type": "string",
+                    "example": "poc.enabled"
+                },
+                "value": {
+                    "description": "New value: JSON value of the field type (e.g. true, 8080, \"30s\", [\"stdout\"]); strings are parsed as the field type",
+                    "type": "object"
+                }
+            }
+        },
+        "config.UpdateConfigResponse": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "example": "poc.enabled"
+                },
+                "value": {
+                    "type": "object"
+                }
+            }
+        },
+        "config.ValidateConfigRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "description": "Configuration items to validate together",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/config.UpdateConfigRequest"
+                    }
+                }
+            }
+        },
+        "config.ValidateConfigResponse": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "description": "Number of submitted keys",
+                    "type": "integer",
+                    "example": 2
+                },
+                "errors": {
+                    "description": "Every field error, per-key checks first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.FieldError"
+                    }
+                },
+                "valid": {
+                    "description": "Whether the changes would be accepted",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "config.WatchPollResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "Changes after the requested revision, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/config.ChangeRecord"
+                    }
+                },
+                "count": {
+                    "description": "Number of changes returned",
+                    "type": "integer",
+                    "example": 1
+                },
+                "revision": {
+                    "description": "Revision to pass on the next poll",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "flags.Condition": {
+            "type": "object",
+            "properties": {
+                "attribute": {
+                    "description": "\"user\", \"project\" or a custom attribute name",
+                    "type": "string",
+                    "example": "project"
+                },
+                "operator": {
+                    "description": "in, not_in, starts_with, ends_with, contains, matches",
+                    "type": "string",
+                    "example": "in"
+                },
+                "values": {
+                    "description": "Values compared against the attribute",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "alpha",
+                        "beta"
+                    ]
+                }
+            }
+        },
+        "flags.EvaluateRequest": {
+            "type": "object",
+            "properties": {
+                "context": {
+                    "description": "Subject to evaluate for",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/flags.EvaluationContext"
+                        }
+                    ]
+                },
+                "keys": {
+                    "description": "Flags to evaluate (all flags if empty)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "checkout.new_flow"
+                    ]
+                }
+            }
+        },
+        "flags.EvaluateResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "flags": {
+                    "description": "Flag key to evaluation",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/flags.Evaluation"
+                    }
+                }
+            }
+        },
+        "flags.Evaluation": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "example": "checkout.new_flow"
+                },
+                "reason": {
+                    "description": "disabled, rule, default or not_found",
+                    "type": "string",
+                    "example": "rule"
+                },
+                "rule": {
+                    "description": "1-based index of the matched rule (reason \"rule\")",
+                    "type": "integer",
+                    "example": 1
+                },
+                "value": {
+                    "description": "Value of the served variant (null if not found)",
+                    "type": "object"
+                },
+                "variant": {
+                    "description": "Served variant",
+                    "type": "string",
+                    "example": "on"
+                }
+            }
+        },
+        "flags.EvaluationContext": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "description": "Custom attributes, e.g. {\"plan\": \"pro\"}",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "project": {
+                    "type": "string",
+                    "example": "alpha"
+                },
+                "user": {
+                    "type": "string",
+                    "example": "bob"
+                }
+            }
+        },
+        "flags.Flag": {
+            "type": "object",
+            "properties": {
+                "default_variant": {
+                    "description": "Served when no rule matches and there is no rollout",
+                    "type": "string",
+                    "example": "off"
+                },
+                "description": {
+                    "description": "Human readable description",
+                    "type": "string",
+                    "example": "New checkout flow"
+                },
+                "enabled": {
+                    "description": "When false every subject gets off_variant",
+                    "type": "boolean",
+                    "example": true
+                },
+                "key": {
+                    "description": "Flag key",
+                    "type": "string",
+                    "example": "checkout.new_flow"
+                },
+                "off_variant": {
+                    "description": "Served when the flag is disabled",
+                    "type": "string",
+                    "example": "off"
+                },
+                "revision": {
+                    "description": "Stored revision (read-only)",
+                    "type": "integer",
+                    "example": 3
+                },
+                "rollout": {
+                    "description": "Percentage rollout when no rule matches",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/flags.WeightedVariant"
+                    }
+                },
+                "rules": {
+                    "description": "Targeting rules, first match wins",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/flags.Rule"
+                    }
+                },
+                "updated_at": {
+                    "description": "Last change time (read-only)",
+                    "type": "string",
+                    "example": "2025-12-31T10:00:00+08:00"
+                },
+                "updated_by": {
+                    "description": "Last change actor (read-only)",
+                    "type": "string",
+                    "example": "admin"
+                },
+                "variants": {
+                    "description": "Variant name to JSON value",
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "flags.FlagChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "set or delete",
+                    "type": "string",
+                    "example": "set"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-12-31T10:00:00+08:00"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "checkout.new_flow"
+                },
+                "new": {
+                    "$ref": "#/definitions/flags.Flag"
+                },
+                "old": {
+                    "$ref": "#/definitions/flags.Flag"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "host/abc-000001"
+                },
+                "revision": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "flags.ListFlagsResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/flags.Flag"
+                    }
+                }
+            }
+        },
+        "flags.ListHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "history": {
+                    "description": "Changes, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/flags.FlagChange"
+                    }
+                },
+                "key": {
+                    "type": "string",
+                    "example": "checkout.new_flow"
+                }
+            }
+        },
+        "flags.Rule": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "description": "All conditions must match",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/flags.Condition"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Beta projects"
+                },
+                "rollout": {
+                    "description": "Percentage rollout on match (exclusive with variant)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/flags.WeightedVariant"
+                    }
+                },
+                "variant": {
+                    "description": "Variant served on match (exclusive with rollout)",
+                    "type": "string",
+                    "example": "on"
+                }
+            }
+        },
+        "flags.WeightedVariant": {
+            "type": "object",
+            "properties": {
+                "variant": {
+                    "type": "string",
+                    "example": "on"
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 20
+                }
+            }
+        },
+        "response.ErrorInfo": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "error": {
+                    "$ref": "#/definitions/response.ErrorInfo"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         }
@@ -299,7 +2497,7 @@ const docTemplate = `{
 // SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
-	Host:             "",
+	Host:             "localhost:8080",
 	BasePath:         "/api",
 	Schemes:          []string{"http", "https"},
 	Title:            "AppRun API",
@@ -11,7 +11,12 @@ import (
 
 	"apprun/ent/migrate"
 
+	"apprun/ent/configchangerequest"
+	"apprun/ent/confighistory"
 	"apprun/ent/configitem"
+	"apprun/ent/configschedule"
+	"apprun/ent/featureflag"
+	"apprun/ent/featureflaghistory"
 	"apprun/ent/servers"
 	"apprun/ent/users"
 
@@ -26,8 +31,18 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ConfigChangeRequest is the client for interacting with the ConfigChangeRequest builders.
+	ConfigChangeRequest *ConfigChangeRequestClient
+	// ConfigHistory is the client for interacting with the ConfigHistory builders.
+	ConfigHistory *ConfigHistoryClient
+	// ConfigSchedule is the client for interacting with the ConfigSchedule builders.
+	ConfigSchedule *ConfigScheduleClient
 	// Configitem is the client for interacting with the Configitem builders.
 	Configitem *ConfigitemClient
+	// FeatureFlag is the client for interacting with the FeatureFlag builders.
+	FeatureFlag *FeatureFlagClient
+	// FeatureFlagHistory is the client for interacting with the FeatureFlagHistory builders.
+	FeatureFlagHistory *FeatureFlagHistoryClient
 	// Servers is the client for interacting with the Servers builders.
 	Servers *ServersClient
 	// Users is the client for interacting with the Users builders.
@@ -43,7 +58,12 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ConfigChangeRequest = NewConfigChangeRequestClient(c.config)
+	c.ConfigHistory = NewConfigHistoryClient(c.config)
+	c.ConfigSchedule = NewConfigScheduleClient(c.config)
 	c.Configitem = NewConfigitemClient(c.config)
+	c.FeatureFlag = NewFeatureFlagClient(c.config)
+	c.FeatureFlagHistory = NewFeatureFlagHistoryClient(c.config)
 	c.Servers = NewServersClient(c.config)
 	c.Users = NewUsersClient(c.config)
 }
@@ -136,11 +156,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Configitem: NewConfigitemClient(cfg),
-		Servers:    NewServersClient(cfg),
-		Users:      NewUsersClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ConfigChangeRequest: NewConfigChangeRequestClient(cfg),
+		ConfigHistory:       NewConfigHistoryClient(cfg),
+		ConfigSchedule:      NewConfigScheduleClient(cfg),
+		Configitem:          NewConfigitemClient(cfg),
+		FeatureFlag:         NewFeatureFlagClient(cfg),
+		FeatureFlagHistory:  NewFeatureFlagHistoryClient(cfg),
+		Servers:             NewServersClient(cfg),
+		Users:               NewUsersClient(cfg),
 	}, nil
 }
 
@@ -158,18 +183,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Configitem: NewConfigitemClient(cfg),
-		Servers:    NewServersClient(cfg),
-		Users:      NewUsersClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ConfigChangeRequest: NewConfigChangeRequestClient(cfg),
+		ConfigHistory:       NewConfigHistoryClient(cfg),
+		ConfigSchedule:      NewConfigScheduleClient(cfg),
+		Configitem:          NewConfigitemClient(cfg),
+		FeatureFlag:         NewFeatureFlagClient(cfg),
+		FeatureFlagHistory:  NewFeatureFlagHistoryClient(cfg),
+		Servers:             NewServersClient(cfg),
+		Users:               NewUsersClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Configitem.
+//		ConfigChangeRequest.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -191,24 +221,40 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Configitem.Use(hooks...)
-	c.Servers.Use(hooks...)
-	c.Users.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ConfigChangeRequest, c.ConfigHistory, c.ConfigSchedule, c.Configitem,
+		c.FeatureFlag, c.FeatureFlagHistory, c.Servers, c.Users,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Configitem.Intercept(interceptors...)
-	c.Servers.Intercept(interceptors...)
-	c.Users.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ConfigChangeRequest, c.ConfigHistory, c.ConfigSchedule, c.Configitem,
+		c.FeatureFlag, c.FeatureFlagHistory, c.Servers, c.Users,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ConfigChangeRequestMutation:
+		return c.ConfigChangeRequest.mutate(ctx, m)
+	case *ConfigHistoryMutation:
+		return c.ConfigHistory.mutate(ctx, m)
+	case *ConfigScheduleMutation:
+		return c.ConfigSchedule.mutate(ctx, m)
 	case *ConfigitemMutation:
 		return c.Configitem.mutate(ctx, m)
+	case *FeatureFlagMutation:
+		return c.FeatureFlag.mutate(ctx, m)
+	case *FeatureFlagHistoryMutation:
+		return c.FeatureFlagHistory.mutate(ctx, m)
 	case *ServersMutation:
 		return c.Servers.mutate(ctx, m)
 	case *UsersMutation:
@@ -218,6 +264,405 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ConfigChangeRequestClient is a client for the ConfigChangeRequest schema.
+type ConfigChangeRequestClient struct {
+	config
+}
+
+// NewConfigChangeRequestClient returns a client for the ConfigChangeRequest from the given config.
+func NewConfigChangeRequestClient(c config) *ConfigChangeRequestClient {
+	return &ConfigChangeRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `configchangerequest.Hooks(f(g(h())))`.
+func (c *ConfigChangeRequestClient) Use(hooks ...Hook) {
+	c.hooks.ConfigChangeRequest = append(c.hooks.ConfigChangeRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `configchangerequest.Intercept(f(g(h())))`.
+func (c *ConfigChangeRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigChangeRequest = append(c.inters.ConfigChangeRequest, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigChangeRequest entity.
+func (c *ConfigChangeRequestClient) Create() *ConfigChangeRequestCreate {
+	mutation := newConfigChangeRequestMutation(c.config, OpCreate)
+	return &ConfigChangeRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigChangeRequest entities.
+func (c *ConfigChangeRequestClient) CreateBulk(builders ...*ConfigChangeRequestCreate) *ConfigChangeRequestCreateBulk {
+	return &ConfigChangeRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigChangeRequestClient) MapCreateBulk(slice any, setFunc func(*ConfigChangeRequestCreate, int)) *ConfigChangeRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigChangeRequestCreateBulk{err: fmt.Errorf("calling to ConfigChangeRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigChangeRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigChangeRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigChangeRequest.
+func (c *ConfigChangeRequestClient) Update() *ConfigChangeRequestUpdate {
+	mutation := newConfigChangeRequestMutation(c.config, OpUpdate)
+	return &ConfigChangeRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigChangeRequestClient) UpdateOne(_m *ConfigChangeRequest) *ConfigChangeRequestUpdateOne {
+	mutation := newConfigChangeRequestMutation(c.config, OpUpdateOne, withConfigChangeRequest(_m))
+	return &ConfigChangeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigChangeRequestClient) UpdateOneID(id int) *ConfigChangeRequestUpdateOne {
+	mutation := newConfigChangeRequestMutation(c.config, OpUpdateOne, withConfigChangeRequestID(id))
+	return &ConfigChangeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigChangeRequest.
+func (c *ConfigChangeRequestClient) Delete() *ConfigChangeRequestDelete {
+	mutation := newConfigChangeRequestMutation(c.config, OpDelete)
+	return &ConfigChangeRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigChangeRequestClient) DeleteOne(_m *ConfigChangeRequest) *ConfigChangeRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigChangeRequestClient) DeleteOneID(id int) *ConfigChangeRequestDeleteOne {
+	builder := c.Delete().Where(configchangerequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigChangeRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigChangeRequest.
+func (c *ConfigChangeRequestClient) Query() *ConfigChangeRequestQuery {
+	return &ConfigChangeRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigChangeRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigChangeRequest entity by its id.
+func (c *ConfigChangeRequestClient) Get(ctx context.Context, id int) (*ConfigChangeRequest, error) {
+	return c.Query().Where(configchangerequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigChangeRequestClient) GetX(ctx context.Context, id int) *ConfigChangeRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigChangeRequestClient) Hooks() []Hook {
+	return c.hooks.ConfigChangeRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigChangeRequestClient) Interceptors() []Interceptor {
+	return c.inters.ConfigChangeRequest
+}
+
+func (c *ConfigChangeRequestClient) mutate(ctx context.Context, m *ConfigChangeRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigChangeRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigChangeRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigChangeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigChangeRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConfigChangeRequest mutation op: %q", m.Op())
+	}
+}
+
+// ConfigHistoryClient is a client for the ConfigHistory schema.
+type ConfigHistoryClient struct {
+	config
+}
+
+// NewConfigHistoryClient returns a client for the ConfigHistory from the given config.
+func NewConfigHistoryClient(c config) *ConfigHistoryClient {
+	return &ConfigHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `confighistory.Hooks(f(g(h())))`.
+func (c *ConfigHistoryClient) Use(hooks ...Hook) {
+	c.hooks.ConfigHistory = append(c.hooks.ConfigHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `confighistory.Intercept(f(g(h())))`.
+func (c *ConfigHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigHistory = append(c.inters.ConfigHistory, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigHistory entity.
+func (c *ConfigHistoryClient) Create() *ConfigHistoryCreate {
+	mutation := newConfigHistoryMutation(c.config, OpCreate)
+	return &ConfigHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigHistory entities.
+func (c *ConfigHistoryClient) CreateBulk(builders ...*ConfigHistoryCreate) *ConfigHistoryCreateBulk {
+	return &ConfigHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigHistoryClient) MapCreateBulk(slice any, setFunc func(*ConfigHistoryCreate, int)) *ConfigHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigHistoryCreateBulk{err: fmt.Errorf("calling to ConfigHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigHistory.
+func (c *ConfigHistoryClient) Update() *ConfigHistoryUpdate {
+	mutation := newConfigHistoryMutation(c.config, OpUpdate)
+	return &ConfigHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigHistoryClient) UpdateOne(_m *ConfigHistory) *ConfigHistoryUpdateOne {
+	mutation := newConfigHistoryMutation(c.config, OpUpdateOne, withConfigHistory(_m))
+	return &ConfigHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigHistoryClient) UpdateOneID(id int) *ConfigHistoryUpdateOne {
+	mutation := newConfigHistoryMutation(c.config, OpUpdateOne, withConfigHistoryID(id))
+	return &ConfigHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigHistory.
+func (c *ConfigHistoryClient) Delete() *ConfigHistoryDelete {
+	mutation := newConfigHistoryMutation(c.config, OpDelete)
+	return &ConfigHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigHistoryClient) DeleteOne(_m *ConfigHistory) *ConfigHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigHistoryClient) DeleteOneID(id int) *ConfigHistoryDeleteOne {
+	builder := c.Delete().Where(confighistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigHistory.
+func (c *ConfigHistoryClient) Query() *ConfigHistoryQuery {
+	return &ConfigHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigHistory entity by its id.
+func (c *ConfigHistoryClient) Get(ctx context.Context, id int) (*ConfigHistory, error) {
+	return c.Query().Where(confighistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigHistoryClient) GetX(ctx context.Context, id int) *ConfigHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigHistoryClient) Hooks() []Hook {
+	return c.hooks.ConfigHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigHistoryClient) Interceptors() []Interceptor {
+	return c.inters.ConfigHistory
+}
+
+func (c *ConfigHistoryClient) mutate(ctx context.Context, m *ConfigHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConfigHistory mutation op: %q", m.Op())
+	}
+}
+
+// ConfigScheduleClient is a client for the ConfigSchedule schema.
+type ConfigScheduleClient struct {
+	config
+}
+
+// NewConfigScheduleClient returns a client for the ConfigSchedule from the given config.
+func NewConfigScheduleClient(c config) *ConfigScheduleClient {
+	return &ConfigScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `configschedule.Hooks(f(g(h())))`.
+func (c *ConfigScheduleClient) Use(hooks ...Hook) {
+	c.hooks.ConfigSchedule = append(c.hooks.ConfigSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `configschedule.Intercept(f(g(h())))`.
+func (c *ConfigScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigSchedule = append(c.inters.ConfigSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigSchedule entity.
+func (c *ConfigScheduleClient) Create() *ConfigScheduleCreate {
+	mutation := newConfigScheduleMutation(c.config, OpCreate)
+	return &ConfigScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigSchedule entities.
+func (c *ConfigScheduleClient) CreateBulk(builders ...*ConfigScheduleCreate) *ConfigScheduleCreateBulk {
+	return &ConfigScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigScheduleClient) MapCreateBulk(slice any, setFunc func(*ConfigScheduleCreate, int)) *ConfigScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigScheduleCreateBulk{err: fmt.Errorf("calling to ConfigScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigSchedule.
+func (c *ConfigScheduleClient) Update() *ConfigScheduleUpdate {
+	mutation := newConfigScheduleMutation(c.config, OpUpdate)
+	return &ConfigScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigScheduleClient) UpdateOne(_m *ConfigSchedule) *ConfigScheduleUpdateOne {
+	mutation := newConfigScheduleMutation(c.config, OpUpdateOne, withConfigSchedule(_m))
+	return &ConfigScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigScheduleClient) UpdateOneID(id int) *ConfigScheduleUpdateOne {
+	mutation := newConfigScheduleMutation(c.config, OpUpdateOne, withConfigScheduleID(id))
+	return &ConfigScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigSchedule.
+func (c *ConfigScheduleClient) Delete() *ConfigScheduleDelete {
+	mutation := newConfigScheduleMutation(c.config, OpDelete)
+	return &ConfigScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigScheduleClient) DeleteOne(_m *ConfigSchedule) *ConfigScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigScheduleClient) DeleteOneID(id int) *ConfigScheduleDeleteOne {
+	builder := c.Delete().Where(configschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigSchedule.
+func (c *ConfigScheduleClient) Query() *ConfigScheduleQuery {
+	return &ConfigScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigSchedule entity by its id.
+func (c *ConfigScheduleClient) Get(ctx context.Context, id int) (*ConfigSchedule, error) {
+	return c.Query().Where(configschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigScheduleClient) GetX(ctx context.Context, id int) *ConfigSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigScheduleClient) Hooks() []Hook {
+	return c.hooks.ConfigSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigScheduleClient) Interceptors() []Interceptor {
+	return c.inters.ConfigSchedule
+}
+
+func (c *ConfigScheduleClient) mutate(ctx context.Context, m *ConfigScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConfigSchedule mutation op: %q", m.Op())
+	}
+}
+
 // ConfigitemClient is a client for the Configitem schema.
 type ConfigitemClient struct {
 	config
@@ -351,6 +796,272 @@ func (c *ConfigitemClient) mutate(ctx context.Context, m *ConfigitemMutation) (V
 	}
 }
 
+// FeatureFlagClient is a client for the FeatureFlag schema.
+type FeatureFlagClient struct {
+	config
+}
+
+// NewFeatureFlagClient returns a client for the FeatureFlag from the given config.
+func NewFeatureFlagClient(c config) *FeatureFlagClient {
+	return &FeatureFlagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `featureflag.Hooks(f(g(h())))`.
+func (c *FeatureFlagClient) Use(hooks ...Hook) {
+	c.hooks.FeatureFlag = append(c.hooks.FeatureFlag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `featureflag.Intercept(f(g(h())))`.
+func (c *FeatureFlagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeatureFlag = append(c.inters.FeatureFlag, interceptors...)
+}
+
+// Create returns a builder for creating a FeatureFlag entity.
+func (c *FeatureFlagClient) Create() *FeatureFlagCreate {
+	mutation := newFeatureFlagMutation(c.config, OpCreate)
+	return &FeatureFlagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FeatureFlag entities.
+func (c *FeatureFlagClient) CreateBulk(builders ...*FeatureFlagCreate) *FeatureFlagCreateBulk {
+	return &FeatureFlagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeatureFlagClient) MapCreateBulk(slice any, setFunc func(*FeatureFlagCreate, int)) *FeatureFlagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeatureFlagCreateBulk{err: fmt.Errorf("calling to FeatureFlagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeatureFlagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeatureFlagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FeatureFlag.
+func (c *FeatureFlagClient) Update() *FeatureFlagUpdate {
+	mutation := newFeatureFlagMutation(c.config, OpUpdate)
+	return &FeatureFlagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeatureFlagClient) UpdateOne(_m *FeatureFlag) *FeatureFlagUpdateOne {
+	mutation := newFeatureFlagMutation(c.config, OpUpdateOne, withFeatureFlag(_m))
+	return &FeatureFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeatureFlagClient) UpdateOneID(id int) *FeatureFlagUpdateOne {
+	mutation := newFeatureFlagMutation(c.config, OpUpdateOne, withFeatureFlagID(id))
+	return &FeatureFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FeatureFlag.
+func (c *FeatureFlagClient) Delete() *FeatureFlagDelete {
+	mutation := newFeatureFlagMutation(c.config, OpDelete)
+	return &FeatureFlagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeatureFlagClient) DeleteOne(_m *FeatureFlag) *FeatureFlagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeatureFlagClient) DeleteOneID(id int) *FeatureFlagDeleteOne {
+	builder := c.Delete().Where(featureflag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeatureFlagDeleteOne{builder}
+}
+
+// Query returns a query builder for FeatureFlag.
+func (c *FeatureFlagClient) Query() *FeatureFlagQuery {
+	return &FeatureFlagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeatureFlag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FeatureFlag entity by its id.
+func (c *FeatureFlagClient) Get(ctx context.Context, id int) (*FeatureFlag, error) {
+	return c.Query().Where(featureflag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeatureFlagClient) GetX(ctx context.Context, id int) *FeatureFlag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FeatureFlagClient) Hooks() []Hook {
+	return c.hooks.FeatureFlag
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeatureFlagClient) Interceptors() []Interceptor {
+	return c.inters.FeatureFlag
+}
+
+func (c *FeatureFlagClient) mutate(ctx context.Context, m *FeatureFlagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeatureFlagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeatureFlagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeatureFlagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeatureFlagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FeatureFlag mutation op: %q", m.Op())
+	}
+}
+
+// FeatureFlagHistoryClient is a client for the FeatureFlagHistory schema.
+type FeatureFlagHistoryClient struct {
+	config
+}
+
+// NewFeatureFlagHistoryClient returns a client for the FeatureFlagHistory from the given config.
+func NewFeatureFlagHistoryClient(c config) *FeatureFlagHistoryClient {
+	return &FeatureFlagHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `featureflaghistory.Hooks(f(g(h())))`.
+func (c *FeatureFlagHistoryClient) Use(hooks ...Hook) {
+	c.hooks.FeatureFlagHistory = append(c.hooks.FeatureFlagHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `featureflaghistory.Intercept(f(g(h())))`.
+func (c *FeatureFlagHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeatureFlagHistory = append(c.inters.FeatureFlagHistory, interceptors...)
+}
+
+// Create returns a builder for creating a FeatureFlagHistory entity.
+func (c *FeatureFlagHistoryClient) Create() *FeatureFlagHistoryCreate {
+	mutation := newFeatureFlagHistoryMutation(c.config, OpCreate)
+	return &FeatureFlagHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FeatureFlagHistory entities.
+func (c *FeatureFlagHistoryClient) CreateBulk(builders ...*FeatureFlagHistoryCreate) *FeatureFlagHistoryCreateBulk {
+	return &FeatureFlagHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeatureFlagHistoryClient) MapCreateBulk(slice any, setFunc func(*FeatureFlagHistoryCreate, int)) *FeatureFlagHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeatureFlagHistoryCreateBulk{err: fmt.Errorf("calling to FeatureFlagHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeatureFlagHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeatureFlagHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FeatureFlagHistory.
+func (c *FeatureFlagHistoryClient) Update() *FeatureFlagHistoryUpdate {
+	mutation := newFeatureFlagHistoryMutation(c.config, OpUpdate)
+	return &FeatureFlagHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeatureFlagHistoryClient) UpdateOne(_m *FeatureFlagHistory) *FeatureFlagHistoryUpdateOne {
+	mutation := newFeatureFlagHistoryMutation(c.config, OpUpdateOne, withFeatureFlagHistory(_m))
+	return &FeatureFlagHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeatureFlagHistoryClient) UpdateOneID(id int) *FeatureFlagHistoryUpdateOne {
+	mutation := newFeatureFlagHistoryMutation(c.config, OpUpdateOne, withFeatureFlagHistoryID(id))
+	return &FeatureFlagHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FeatureFlagHistory.
+func (c *FeatureFlagHistoryClient) Delete() *FeatureFlagHistoryDelete {
+	mutation := newFeatureFlagHistoryMutation(c.config, OpDelete)
+	return &FeatureFlagHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeatureFlagHistoryClient) DeleteOne(_m *FeatureFlagHistory) *FeatureFlagHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeatureFlagHistoryClient) DeleteOneID(id int) *FeatureFlagHistoryDeleteOne {
+	builder := c.Delete().Where(featureflaghistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeatureFlagHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for FeatureFlagHistory.
+func (c *FeatureFlagHistoryClient) Query() *FeatureFlagHistoryQuery {
+	return &FeatureFlagHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeatureFlagHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FeatureFlagHistory entity by its id.
+func (c *FeatureFlagHistoryClient) Get(ctx context.Context, id int) (*FeatureFlagHistory, error) {
+	return c.Query().Where(featureflaghistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeatureFlagHistoryClient) GetX(ctx context.Context, id int) *FeatureFlagHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FeatureFlagHistoryClient) Hooks() []Hook {
+	return c.hooks.FeatureFlagHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeatureFlagHistoryClient) Interceptors() []Interceptor {
+	return c.inters.FeatureFlagHistory
+}
+
+func (c *FeatureFlagHistoryClient) mutate(ctx context.Context, m *FeatureFlagHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeatureFlagHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeatureFlagHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeatureFlagHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeatureFlagHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FeatureFlagHistory mutation op: %q", m.Op())
+	}
+}
+
 // ServersClient is a client for the Servers schema.
 type ServersClient struct {
 	config
@@ -652,9 +1363,11 @@ func (c *UsersClient) mutate(ctx context.Context, m *UsersMutation) (Value, erro
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Configitem, Servers, Users []ent.Hook
+		ConfigChangeRequest, ConfigHistory, ConfigSchedule, Configitem, FeatureFlag,
+		FeatureFlagHistory, Servers, Users []ent.Hook
 	}
 	inters struct {
-		Configitem, Servers, Users []ent.Interceptor
+		ConfigChangeRequest, ConfigHistory, ConfigSchedule, Configitem, FeatureFlag,
+		FeatureFlagHistory, Servers, Users []ent.Interceptor
 	}
 )
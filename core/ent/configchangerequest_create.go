@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/configchangerequest"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigChangeRequestCreate is the builder for creating a ConfigChangeRequest entity.
+type ConfigChangeRequestCreate struct {
+	config
+	mutation *ConfigChangeRequestMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *ConfigChangeRequestCreate) SetKey(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *ConfigChangeRequestCreate) SetScope(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableScope(v *string) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *ConfigChangeRequestCreate) SetValue(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ConfigChangeRequestCreate) SetStatus(v configchangerequest.Status) *ConfigChangeRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableStatus(v *configchangerequest.Status) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *ConfigChangeRequestCreate) SetRequestedBy(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *ConfigChangeRequestCreate) SetRequestID(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableRequestID(v *string) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *ConfigChangeRequestCreate) SetReviewedBy(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableReviewedBy(v *string) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetComment sets the "comment" field.
+func (_c *ConfigChangeRequestCreate) SetComment(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetComment(v)
+	return _c
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableComment(v *string) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetComment(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ConfigChangeRequestCreate) SetMessage(v string) *ConfigChangeRequestCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableMessage(v *string) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ConfigChangeRequestCreate) SetExpiresAt(v time.Time) *ConfigChangeRequestCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConfigChangeRequestCreate) SetCreatedAt(v time.Time) *ConfigChangeRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableCreatedAt(v *time.Time) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConfigChangeRequestCreate) SetUpdatedAt(v time.Time) *ConfigChangeRequestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConfigChangeRequestCreate) SetNillableUpdatedAt(v *time.Time) *ConfigChangeRequestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ConfigChangeRequestMutation object of the builder.
+func (_c *ConfigChangeRequestCreate) Mutation() *ConfigChangeRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the ConfigChangeRequest in the database.
+func (_c *ConfigChangeRequestCreate) Save(ctx context.Context) (*ConfigChangeRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConfigChangeRequestCreate) SaveX(ctx context.Context) *ConfigChangeRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigChangeRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigChangeRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConfigChangeRequestCreate) defaults() {
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := configchangerequest.DefaultScope
+		_c.mutation.SetScope(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := configchangerequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := configchangerequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := configchangerequest.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConfigChangeRequestCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ConfigChangeRequest.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := configchangerequest.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ConfigChangeRequest.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "ConfigChangeRequest.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := configchangerequest.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ConfigChangeRequest.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ConfigChangeRequest.value"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ConfigChangeRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := configchangerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConfigChangeRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`ent: missing required field "ConfigChangeRequest.requested_by"`)}
+	}
+	if v, ok := _c.mutation.RequestedBy(); ok {
+		if err := configchangerequest.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requested_by", err: fmt.Errorf(`ent: validator failed for field "ConfigChangeRequest.requested_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ConfigChangeRequest.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConfigChangeRequest.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ConfigChangeRequest.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ConfigChangeRequestCreate) sqlSave(ctx context.Context) (*ConfigChangeRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConfigChangeRequestCreate) createSpec() (*ConfigChangeRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfigChangeRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(configchangerequest.Table, sqlgraph.NewFieldSpec(configchangerequest.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(configchangerequest.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(configchangerequest.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(configchangerequest.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(configchangerequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(configchangerequest.FieldRequestedBy, field.TypeString, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(configchangerequest.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(configchangerequest.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = value
+	}
+	if value, ok := _c.mutation.Comment(); ok {
+		_spec.SetField(configchangerequest.FieldComment, field.TypeString, value)
+		_node.Comment = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(configchangerequest.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(configchangerequest.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(configchangerequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(configchangerequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConfigChangeRequestCreateBulk is the builder for creating many ConfigChangeRequest entities in bulk.
+type ConfigChangeRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ConfigChangeRequestCreate
+}
+
+// Save creates the ConfigChangeRequest entities in the database.
+func (_c *ConfigChangeRequestCreateBulk) Save(ctx context.Context) ([]*ConfigChangeRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConfigChangeRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfigChangeRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConfigChangeRequestCreateBulk) SaveX(ctx context.Context) []*ConfigChangeRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigChangeRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigChangeRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/configchangerequest"
+	"apprun/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigChangeRequestDelete is the builder for deleting a ConfigChangeRequest entity.
+type ConfigChangeRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConfigChangeRequestMutation
+}
+
+// Where appends a list predicates to the ConfigChangeRequestDelete builder.
+func (_d *ConfigChangeRequestDelete) Where(ps ...predicate.ConfigChangeRequest) *ConfigChangeRequestDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ConfigChangeRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigChangeRequestDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ConfigChangeRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(configchangerequest.Table, sqlgraph.NewFieldSpec(configchangerequest.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ConfigChangeRequestDeleteOne is the builder for deleting a single ConfigChangeRequest entity.
+type ConfigChangeRequestDeleteOne struct {
+	_d *ConfigChangeRequestDelete
+}
+
+// Where appends a list predicates to the ConfigChangeRequestDelete builder.
+func (_d *ConfigChangeRequestDeleteOne) Where(ps ...predicate.ConfigChangeRequest) *ConfigChangeRequestDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ConfigChangeRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{configchangerequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigChangeRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/configchangerequest"
+	"apprun/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigChangeRequestUpdate is the builder for updating ConfigChangeRequest entities.
+type ConfigChangeRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConfigChangeRequestMutation
+}
+
+// Where appends a list predicates to the ConfigChangeRequestUpdate builder.
+func (_u *ConfigChangeRequestUpdate) Where(ps ...predicate.ConfigChangeRequest) *ConfigChangeRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ConfigChangeRequestUpdate) SetStatus(v configchangerequest.Status) *ConfigChangeRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdate) SetNillableStatus(v *configchangerequest.Status) *ConfigChangeRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *ConfigChangeRequestUpdate) SetReviewedBy(v string) *ConfigChangeRequestUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdate) SetNillableReviewedBy(v *string) *ConfigChangeRequestUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *ConfigChangeRequestUpdate) ClearReviewedBy() *ConfigChangeRequestUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *ConfigChangeRequestUpdate) SetComment(v string) *ConfigChangeRequestUpdate {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdate) SetNillableComment(v *string) *ConfigChangeRequestUpdate {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *ConfigChangeRequestUpdate) ClearComment() *ConfigChangeRequestUpdate {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ConfigChangeRequestUpdate) SetMessage(v string) *ConfigChangeRequestUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdate) SetNillableMessage(v *string) *ConfigChangeRequestUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ConfigChangeRequestUpdate) ClearMessage() *ConfigChangeRequestUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigChangeRequestUpdate) SetUpdatedAt(v time.Time) *ConfigChangeRequestUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ConfigChangeRequestMutation object of the builder.
+func (_u *ConfigChangeRequestUpdate) Mutation() *ConfigChangeRequestMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConfigChangeRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigChangeRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConfigChangeRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigChangeRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConfigChangeRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := configchangerequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigChangeRequestUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := configchangerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConfigChangeRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigChangeRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(configchangerequest.Table, configchangerequest.Columns, sqlgraph.NewFieldSpec(configchangerequest.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(configchangerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(configchangerequest.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(configchangerequest.FieldReviewedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(configchangerequest.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(configchangerequest.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(configchangerequest.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(configchangerequest.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(configchangerequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(configchangerequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configchangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConfigChangeRequestUpdateOne is the builder for updating a single ConfigChangeRequest entity.
+type ConfigChangeRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConfigChangeRequestMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *ConfigChangeRequestUpdateOne) SetStatus(v configchangerequest.Status) *ConfigChangeRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdateOne) SetNillableStatus(v *configchangerequest.Status) *ConfigChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *ConfigChangeRequestUpdateOne) SetReviewedBy(v string) *ConfigChangeRequestUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdateOne) SetNillableReviewedBy(v *string) *ConfigChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *ConfigChangeRequestUpdateOne) ClearReviewedBy() *ConfigChangeRequestUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *ConfigChangeRequestUpdateOne) SetComment(v string) *ConfigChangeRequestUpdateOne {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdateOne) SetNillableComment(v *string) *ConfigChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *ConfigChangeRequestUpdateOne) ClearComment() *ConfigChangeRequestUpdateOne {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ConfigChangeRequestUpdateOne) SetMessage(v string) *ConfigChangeRequestUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ConfigChangeRequestUpdateOne) SetNillableMessage(v *string) *ConfigChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ConfigChangeRequestUpdateOne) ClearMessage() *ConfigChangeRequestUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigChangeRequestUpdateOne) SetUpdatedAt(v time.Time) *ConfigChangeRequestUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ConfigChangeRequestMutation object of the builder.
+func (_u *ConfigChangeRequestUpdateOne) Mutation() *ConfigChangeRequestMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConfigChangeRequestUpdate builder.
+func (_u *ConfigChangeRequestUpdateOne) Where(ps ...predicate.ConfigChangeRequest) *ConfigChangeRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConfigChangeRequestUpdateOne) Select(field string, fields ...string) *ConfigChangeRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConfigChangeRequest entity.
+func (_u *ConfigChangeRequestUpdateOne) Save(ctx context.Context) (*ConfigChangeRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigChangeRequestUpdateOne) SaveX(ctx context.Context) *ConfigChangeRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConfigChangeRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigChangeRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConfigChangeRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := configchangerequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigChangeRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := configchangerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConfigChangeRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigChangeRequestUpdateOne) sqlSave(ctx context.Context) (_node *ConfigChangeRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(configchangerequest.Table, configchangerequest.Columns, sqlgraph.NewFieldSpec(configchangerequest.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConfigChangeRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, configchangerequest.FieldID)
+		for _, f := range fields {
+			if !configchangerequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != configchangerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(configchangerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(configchangerequest.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(configchangerequest.FieldReviewedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(configchangerequest.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(configchangerequest.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(configchangerequest.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(configchangerequest.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(configchangerequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(configchangerequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ConfigChangeRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configchangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
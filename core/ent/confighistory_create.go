@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/confighistory"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigHistoryCreate is the builder for creating a ConfigHistory entity.
+type ConfigHistoryCreate struct {
+	config
+	mutation *ConfigHistoryMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *ConfigHistoryCreate) SetKey(v string) *ConfigHistoryCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *ConfigHistoryCreate) SetScope(v string) *ConfigHistoryCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *ConfigHistoryCreate) SetNillableScope(v *string) *ConfigHistoryCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetOldValue sets the "old_value" field.
+func (_c *ConfigHistoryCreate) SetOldValue(v string) *ConfigHistoryCreate {
+	_c.mutation.SetOldValue(v)
+	return _c
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (_c *ConfigHistoryCreate) SetNillableOldValue(v *string) *ConfigHistoryCreate {
+	if v != nil {
+		_c.SetOldValue(*v)
+	}
+	return _c
+}
+
+// SetNewValue sets the "new_value" field.
+func (_c *ConfigHistoryCreate) SetNewValue(v string) *ConfigHistoryCreate {
+	_c.mutation.SetNewValue(v)
+	return _c
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (_c *ConfigHistoryCreate) SetNillableNewValue(v *string) *ConfigHistoryCreate {
+	if v != nil {
+		_c.SetNewValue(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ConfigHistoryCreate) SetAction(v confighistory.Action) *ConfigHistoryCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *ConfigHistoryCreate) SetActor(v string) *ConfigHistoryCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *ConfigHistoryCreate) SetNillableActor(v *string) *ConfigHistoryCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *ConfigHistoryCreate) SetRequestID(v string) *ConfigHistoryCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *ConfigHistoryCreate) SetNillableRequestID(v *string) *ConfigHistoryCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConfigHistoryCreate) SetCreatedAt(v time.Time) *ConfigHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConfigHistoryCreate) SetNillableCreatedAt(v *time.Time) *ConfigHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ConfigHistoryMutation object of the builder.
+func (_c *ConfigHistoryCreate) Mutation() *ConfigHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the ConfigHistory in the database.
+func (_c *ConfigHistoryCreate) Save(ctx context.Context) (*ConfigHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConfigHistoryCreate) SaveX(ctx context.Context) *ConfigHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConfigHistoryCreate) defaults() {
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := confighistory.DefaultScope
+		_c.mutation.SetScope(v)
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		v := confighistory.DefaultActor
+		_c.mutation.SetActor(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := confighistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConfigHistoryCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ConfigHistory.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := confighistory.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ConfigHistory.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "ConfigHistory.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := confighistory.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ConfigHistory.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ConfigHistory.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := confighistory.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ConfigHistory.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "ConfigHistory.actor"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConfigHistory.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ConfigHistoryCreate) sqlSave(ctx context.Context) (*ConfigHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConfigHistoryCreate) createSpec() (*ConfigHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfigHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(confighistory.Table, sqlgraph.NewFieldSpec(confighistory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(confighistory.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(confighistory.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.OldValue(); ok {
+		_spec.SetField(confighistory.FieldOldValue, field.TypeString, value)
+		_node.OldValue = &value
+	}
+	if value, ok := _c.mutation.NewValue(); ok {
+		_spec.SetField(confighistory.FieldNewValue, field.TypeString, value)
+		_node.NewValue = &value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(confighistory.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(confighistory.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(confighistory.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(confighistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConfigHistoryCreateBulk is the builder for creating many ConfigHistory entities in bulk.
+type ConfigHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*ConfigHistoryCreate
+}
+
+// Save creates the ConfigHistory entities in the database.
+func (_c *ConfigHistoryCreateBulk) Save(ctx context.Context) ([]*ConfigHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConfigHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfigHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConfigHistoryCreateBulk) SaveX(ctx context.Context) []*ConfigHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/confighistory"
+	"apprun/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigHistoryUpdate is the builder for updating ConfigHistory entities.
+type ConfigHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConfigHistoryMutation
+}
+
+// Where appends a list predicates to the ConfigHistoryUpdate builder.
+func (_u *ConfigHistoryUpdate) Where(ps ...predicate.ConfigHistory) *ConfigHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOldValue sets the "old_value" field.
+func (_u *ConfigHistoryUpdate) SetOldValue(v string) *ConfigHistoryUpdate {
+	_u.mutation.SetOldValue(v)
+	return _u
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (_u *ConfigHistoryUpdate) SetNillableOldValue(v *string) *ConfigHistoryUpdate {
+	if v != nil {
+		_u.SetOldValue(*v)
+	}
+	return _u
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (_u *ConfigHistoryUpdate) ClearOldValue() *ConfigHistoryUpdate {
+	_u.mutation.ClearOldValue()
+	return _u
+}
+
+// SetNewValue sets the "new_value" field.
+func (_u *ConfigHistoryUpdate) SetNewValue(v string) *ConfigHistoryUpdate {
+	_u.mutation.SetNewValue(v)
+	return _u
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (_u *ConfigHistoryUpdate) SetNillableNewValue(v *string) *ConfigHistoryUpdate {
+	if v != nil {
+		_u.SetNewValue(*v)
+	}
+	return _u
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (_u *ConfigHistoryUpdate) ClearNewValue() *ConfigHistoryUpdate {
+	_u.mutation.ClearNewValue()
+	return _u
+}
+
+// Mutation returns the ConfigHistoryMutation object of the builder.
+func (_u *ConfigHistoryUpdate) Mutation() *ConfigHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConfigHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConfigHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ConfigHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(confighistory.Table, confighistory.Columns, sqlgraph.NewFieldSpec(confighistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OldValue(); ok {
+		_spec.SetField(confighistory.FieldOldValue, field.TypeString, value)
+	}
+	if _u.mutation.OldValueCleared() {
+		_spec.ClearField(confighistory.FieldOldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.NewValue(); ok {
+		_spec.SetField(confighistory.FieldNewValue, field.TypeString, value)
+	}
+	if _u.mutation.NewValueCleared() {
+		_spec.ClearField(confighistory.FieldNewValue, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(confighistory.FieldRequestID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{confighistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConfigHistoryUpdateOne is the builder for updating a single ConfigHistory entity.
+type ConfigHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConfigHistoryMutation
+}
+
+// SetOldValue sets the "old_value" field.
+func (_u *ConfigHistoryUpdateOne) SetOldValue(v string) *ConfigHistoryUpdateOne {
+	_u.mutation.SetOldValue(v)
+	return _u
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (_u *ConfigHistoryUpdateOne) SetNillableOldValue(v *string) *ConfigHistoryUpdateOne {
+	if v != nil {
+		_u.SetOldValue(*v)
+	}
+	return _u
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (_u *ConfigHistoryUpdateOne) ClearOldValue() *ConfigHistoryUpdateOne {
+	_u.mutation.ClearOldValue()
+	return _u
+}
+
+// SetNewValue sets the "new_value" field.
+func (_u *ConfigHistoryUpdateOne) SetNewValue(v string) *ConfigHistoryUpdateOne {
+	_u.mutation.SetNewValue(v)
+	return _u
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (_u *ConfigHistoryUpdateOne) SetNillableNewValue(v *string) *ConfigHistoryUpdateOne {
+	if v != nil {
+		_u.SetNewValue(*v)
+	}
+	return _u
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (_u *ConfigHistoryUpdateOne) ClearNewValue() *ConfigHistoryUpdateOne {
+	_u.mutation.ClearNewValue()
+	return _u
+}
+
+// Mutation returns the ConfigHistoryMutation object of the builder.
+func (_u *ConfigHistoryUpdateOne) Mutation() *ConfigHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConfigHistoryUpdate builder.
+func (_u *ConfigHistoryUpdateOne) Where(ps ...predicate.ConfigHistory) *ConfigHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConfigHistoryUpdateOne) Select(field string, fields ...string) *ConfigHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConfigHistory entity.
+func (_u *ConfigHistoryUpdateOne) Save(ctx context.Context) (*ConfigHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigHistoryUpdateOne) SaveX(ctx context.Context) *ConfigHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConfigHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ConfigHistoryUpdateOne) sqlSave(ctx context.Context) (_node *ConfigHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(confighistory.Table, confighistory.Columns, sqlgraph.NewFieldSpec(confighistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConfigHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, confighistory.FieldID)
+		for _, f := range fields {
+			if !confighistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != confighistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OldValue(); ok {
+		_spec.SetField(confighistory.FieldOldValue, field.TypeString, value)
+	}
+	if _u.mutation.OldValueCleared() {
+		_spec.ClearField(confighistory.FieldOldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.NewValue(); ok {
+		_spec.SetField(confighistory.FieldNewValue, field.TypeString, value)
+	}
+	if _u.mutation.NewValueCleared() {
+		_spec.ClearField(confighistory.FieldNewValue, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(confighistory.FieldRequestID, field.TypeString)
+	}
+	_node = &ConfigHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{confighistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -18,10 +18,14 @@ type Configitem struct {
 	ID int `json:"id,omitempty"`
 	// 配置项的键，如 poc.enabled
 	Key string `json:"key,omitempty"`
+	// 作用域：global、project:<id> 或 project:<id>/user:<id>（已有数据为 global）
+	Scope string `json:"scope,omitempty"`
 	// 配置项的值（JSON字符串）
 	Value string `json:"value,omitempty"`
 	// 是否为动态配置（db:true）
-	IsDynamic    bool `json:"is_dynamic,omitempty"`
+	IsDynamic bool `json:"is_dynamic,omitempty"`
+	// 修订号，每次更新加 1，用于乐观并发控制（ETag）
+	Revision     int `json:"revision,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -32,9 +36,9 @@ func (*Configitem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case configitem.FieldIsDynamic:
 			values[i] = new(sql.NullBool)
-		case configitem.FieldID:
+		case configitem.FieldID, configitem.FieldRevision:
 			values[i] = new(sql.NullInt64)
-		case configitem.FieldKey, configitem.FieldValue:
+		case configitem.FieldKey, configitem.FieldScope, configitem.FieldValue:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -63,6 +67,12 @@ func (_m *Configitem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Key = value.String
 			}
+		case configitem.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = value.String
+			}
 		case configitem.FieldValue:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field value", values[i])
@@ -75,6 +85,12 @@ func (_m *Configitem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsDynamic = value.Bool
 			}
+		case configitem.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -114,11 +130,17 @@ func (_m *Configitem) String() string {
 	builder.WriteString("key=")
 	builder.WriteString(_m.Key)
 	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
 	builder.WriteString("value=")
 	builder.WriteString(_m.Value)
 	builder.WriteString(", ")
 	builder.WriteString("is_dynamic=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsDynamic))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -13,10 +13,14 @@ const (
 	FieldID = "id"
 	// FieldKey holds the string denoting the key field in the database.
 	FieldKey = "key"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
 	// FieldValue holds the string denoting the value field in the database.
 	FieldValue = "value"
 	// FieldIsDynamic holds the string denoting the is_dynamic field in the database.
 	FieldIsDynamic = "is_dynamic"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
 	// Table holds the table name of the configitem in the database.
 	Table = "configitems"
 )
@@ -25,8 +29,10 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldKey,
+	FieldScope,
 	FieldValue,
 	FieldIsDynamic,
+	FieldRevision,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -42,8 +48,16 @@ func ValidColumn(column string) bool {
 var (
 	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
 	KeyValidator func(string) error
+	// DefaultScope holds the default value on creation for the "scope" field.
+	DefaultScope string
+	// ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	ScopeValidator func(string) error
 	// DefaultIsDynamic holds the default value on creation for the "is_dynamic" field.
 	DefaultIsDynamic bool
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int
+	// RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	RevisionValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the Configitem queries.
@@ -59,6 +73,11 @@ func ByKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKey, opts...).ToFunc()
 }
 
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
 // ByValue orders the results by the value field.
 func ByValue(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldValue, opts...).ToFunc()
@@ -68,3 +87,8 @@ func ByValue(opts ...sql.OrderTermOption) OrderOption {
 func ByIsDynamic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDynamic, opts...).ToFunc()
 }
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
@@ -58,6 +58,11 @@ func Key(v string) predicate.Configitem {
 	return predicate.Configitem(sql.FieldEQ(FieldKey, v))
 }
 
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldEQ(FieldScope, v))
+}
+
 // Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
 func Value(v string) predicate.Configitem {
 	return predicate.Configitem(sql.FieldEQ(FieldValue, v))
@@ -68,6 +73,11 @@ func IsDynamic(v bool) predicate.Configitem {
 	return predicate.Configitem(sql.FieldEQ(FieldIsDynamic, v))
 }
 
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldEQ(FieldRevision, v))
+}
+
 // KeyEQ applies the EQ predicate on the "key" field.
 func KeyEQ(v string) predicate.Configitem {
 	return predicate.Configitem(sql.FieldEQ(FieldKey, v))
@@ -133,6 +143,71 @@ func KeyContainsFold(v string) predicate.Configitem {
 	return predicate.Configitem(sql.FieldContainsFold(FieldKey, v))
 }
 
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.Configitem {
+	return predicate.Configitem(sql.FieldContainsFold(FieldScope, v))
+}
+
 // ValueEQ applies the EQ predicate on the "value" field.
 func ValueEQ(v string) predicate.Configitem {
 	return predicate.Configitem(sql.FieldEQ(FieldValue, v))
@@ -208,6 +283,46 @@ func IsDynamicNEQ(v bool) predicate.Configitem {
 	return predicate.Configitem(sql.FieldNEQ(FieldIsDynamic, v))
 }
 
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.Configitem {
+	return predicate.Configitem(sql.FieldLTE(FieldRevision, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Configitem) predicate.Configitem {
 	return predicate.Configitem(sql.AndPredicates(predicates...))
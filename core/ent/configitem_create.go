@@ -25,6 +25,20 @@ func (_c *ConfigitemCreate) SetKey(v string) *ConfigitemCreate {
 	return _c
 }
 
+// SetScope sets the "scope" field.
+func (_c *ConfigitemCreate) SetScope(v string) *ConfigitemCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *ConfigitemCreate) SetNillableScope(v *string) *ConfigitemCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
 // SetValue sets the "value" field.
 func (_c *ConfigitemCreate) SetValue(v string) *ConfigitemCreate {
 	_c.mutation.SetValue(v)
@@ -45,6 +59,20 @@ func (_c *ConfigitemCreate) SetNillableIsDynamic(v *bool) *ConfigitemCreate {
 	return _c
 }
 
+// SetRevision sets the "revision" field.
+func (_c *ConfigitemCreate) SetRevision(v int) *ConfigitemCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *ConfigitemCreate) SetNillableRevision(v *int) *ConfigitemCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
 // Mutation returns the ConfigitemMutation object of the builder.
 func (_c *ConfigitemCreate) Mutation() *ConfigitemMutation {
 	return _c.mutation
@@ -80,10 +108,18 @@ func (_c *ConfigitemCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *ConfigitemCreate) defaults() {
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := configitem.DefaultScope
+		_c.mutation.SetScope(v)
+	}
 	if _, ok := _c.mutation.IsDynamic(); !ok {
 		v := configitem.DefaultIsDynamic
 		_c.mutation.SetIsDynamic(v)
 	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := configitem.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -96,12 +132,28 @@ func (_c *ConfigitemCreate) check() error {
 			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Configitem.key": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "Configitem.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := configitem.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Configitem.scope": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Value(); !ok {
 		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Configitem.value"`)}
 	}
 	if _, ok := _c.mutation.IsDynamic(); !ok {
 		return &ValidationError{Name: "is_dynamic", err: errors.New(`ent: missing required field "Configitem.is_dynamic"`)}
 	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "Configitem.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := configitem.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "Configitem.revision": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -132,6 +184,10 @@ func (_c *ConfigitemCreate) createSpec() (*Configitem, *sqlgraph.CreateSpec) {
 		_spec.SetField(configitem.FieldKey, field.TypeString, value)
 		_node.Key = value
 	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(configitem.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
 	if value, ok := _c.mutation.Value(); ok {
 		_spec.SetField(configitem.FieldValue, field.TypeString, value)
 		_node.Value = value
@@ -140,6 +196,10 @@ func (_c *ConfigitemCreate) createSpec() (*Configitem, *sqlgraph.CreateSpec) {
 		_spec.SetField(configitem.FieldIsDynamic, field.TypeBool, value)
 		_node.IsDynamic = value
 	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(configitem.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
 	return _node, _spec
 }
 
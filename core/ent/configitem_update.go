@@ -41,6 +41,20 @@ func (_u *ConfigitemUpdate) SetNillableKey(v *string) *ConfigitemUpdate {
 	return _u
 }
 
+// SetScope sets the "scope" field.
+func (_u *ConfigitemUpdate) SetScope(v string) *ConfigitemUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ConfigitemUpdate) SetNillableScope(v *string) *ConfigitemUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
 // SetValue sets the "value" field.
 func (_u *ConfigitemUpdate) SetValue(v string) *ConfigitemUpdate {
 	_u.mutation.SetValue(v)
@@ -69,6 +83,27 @@ func (_u *ConfigitemUpdate) SetNillableIsDynamic(v *bool) *ConfigitemUpdate {
 	return _u
 }
 
+// SetRevision sets the "revision" field.
+func (_u *ConfigitemUpdate) SetRevision(v int) *ConfigitemUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *ConfigitemUpdate) SetNillableRevision(v *int) *ConfigitemUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *ConfigitemUpdate) AddRevision(v int) *ConfigitemUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
 // Mutation returns the ConfigitemMutation object of the builder.
 func (_u *ConfigitemUpdate) Mutation() *ConfigitemMutation {
 	return _u.mutation
@@ -108,6 +143,16 @@ func (_u *ConfigitemUpdate) check() error {
 			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Configitem.key": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := configitem.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Configitem.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := configitem.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "Configitem.revision": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -126,12 +171,21 @@ func (_u *ConfigitemUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Key(); ok {
 		_spec.SetField(configitem.FieldKey, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(configitem.FieldScope, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Value(); ok {
 		_spec.SetField(configitem.FieldValue, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.IsDynamic(); ok {
 		_spec.SetField(configitem.FieldIsDynamic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(configitem.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(configitem.FieldRevision, field.TypeInt, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{configitem.Label}
@@ -166,6 +220,20 @@ func (_u *ConfigitemUpdateOne) SetNillableKey(v *string) *ConfigitemUpdateOne {
 	return _u
 }
 
+// SetScope sets the "scope" field.
+func (_u *ConfigitemUpdateOne) SetScope(v string) *ConfigitemUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ConfigitemUpdateOne) SetNillableScope(v *string) *ConfigitemUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
 // SetValue sets the "value" field.
 func (_u *ConfigitemUpdateOne) SetValue(v string) *ConfigitemUpdateOne {
 	_u.mutation.SetValue(v)
@@ -194,6 +262,27 @@ func (_u *ConfigitemUpdateOne) SetNillableIsDynamic(v *bool) *ConfigitemUpdateOn
 	return _u
 }
 
+// SetRevision sets the "revision" field.
+func (_u *ConfigitemUpdateOne) SetRevision(v int) *ConfigitemUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *ConfigitemUpdateOne) SetNillableRevision(v *int) *ConfigitemUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *ConfigitemUpdateOne) AddRevision(v int) *ConfigitemUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
 // Mutation returns the ConfigitemMutation object of the builder.
 func (_u *ConfigitemUpdateOne) Mutation() *ConfigitemMutation {
 	return _u.mutation
@@ -246,6 +335,16 @@ func (_u *ConfigitemUpdateOne) check() error {
 			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Configitem.key": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := configitem.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Configitem.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := configitem.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "Configitem.revision": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -281,12 +380,21 @@ func (_u *ConfigitemUpdateOne) sqlSave(ctx context.Context) (_node *Configitem,
 	if value, ok := _u.mutation.Key(); ok {
 		_spec.SetField(configitem.FieldKey, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(configitem.FieldScope, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Value(); ok {
 		_spec.SetField(configitem.FieldValue, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.IsDynamic(); ok {
 		_spec.SetField(configitem.FieldIsDynamic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(configitem.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(configitem.FieldRevision, field.TypeInt, value)
+	}
 	_node = &Configitem{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/configschedule"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConfigSchedule is the model entity for the ConfigSchedule schema.
+type ConfigSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 配置项的键，如 logger.level
+	Key string `json:"key,omitempty"`
+	// 配置项的作用域
+	Scope string `json:"scope,omitempty"`
+	// 计划写入的值
+	Value string `json:"value,omitempty"`
+	// 生效前作用域中存储的值（为空表示生效前不存在），到期时恢复
+	PriorValue *string `json:"prior_value,omitempty"`
+	// 生效时写入后的配置项修订号，到期恢复时用于检测之后的修改
+	AppliedRevision int `json:"applied_revision,omitempty"`
+	// 生效时间
+	EffectiveAt time.Time `json:"effective_at,omitempty"`
+	// 到期时间（为空表示生效后不恢复）
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// 计划状态
+	Status configschedule.Status `json:"status,omitempty"`
+	// 失败原因或未恢复的说明
+	Message string `json:"message,omitempty"`
+	// 创建计划的操作人
+	Actor string `json:"actor,omitempty"`
+	// 创建计划的请求 ID
+	RequestID string `json:"request_id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 状态更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConfigSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case configschedule.FieldID, configschedule.FieldAppliedRevision:
+			values[i] = new(sql.NullInt64)
+		case configschedule.FieldKey, configschedule.FieldScope, configschedule.FieldValue, configschedule.FieldPriorValue, configschedule.FieldStatus, configschedule.FieldMessage, configschedule.FieldActor, configschedule.FieldRequestID:
+			values[i] = new(sql.NullString)
+		case configschedule.FieldEffectiveAt, configschedule.FieldExpiresAt, configschedule.FieldCreatedAt, configschedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConfigSchedule fields.
+func (_m *ConfigSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case configschedule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case configschedule.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case configschedule.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = value.String
+			}
+		case configschedule.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case configschedule.FieldPriorValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prior_value", values[i])
+			} else if value.Valid {
+				_m.PriorValue = new(string)
+				*_m.PriorValue = value.String
+			}
+		case configschedule.FieldAppliedRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_revision", values[i])
+			} else if value.Valid {
+				_m.AppliedRevision = int(value.Int64)
+			}
+		case configschedule.FieldEffectiveAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_at", values[i])
+			} else if value.Valid {
+				_m.EffectiveAt = value.Time
+			}
+		case configschedule.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case configschedule.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = configschedule.Status(value.String)
+			}
+		case configschedule.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case configschedule.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case configschedule.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = value.String
+			}
+		case configschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case configschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the ConfigSchedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConfigSchedule) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConfigSchedule.
+// Note that you need to call ConfigSchedule.Unwrap() before calling this method if this ConfigSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConfigSchedule) Update() *ConfigScheduleUpdateOne {
+	return NewConfigScheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConfigSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConfigSchedule) Unwrap() *ConfigSchedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConfigSchedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConfigSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConfigSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteString(", ")
+	if v := _m.PriorValue; v != nil {
+		builder.WriteString("prior_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("applied_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AppliedRevision))
+	builder.WriteString(", ")
+	builder.WriteString("effective_at=")
+	builder.WriteString(_m.EffectiveAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(_m.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConfigSchedules is a parsable slice of ConfigSchedule.
+type ConfigSchedules []*ConfigSchedule
@@ -0,0 +1,845 @@
+// Code generated by ent, DO NOT EDIT.
+
+package configschedule
+
+import (
+	"apprun/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldKey, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldScope, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldValue, v))
+}
+
+// PriorValue applies equality check predicate on the "prior_value" field. It's identical to PriorValueEQ.
+func PriorValue(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldPriorValue, v))
+}
+
+// AppliedRevision applies equality check predicate on the "applied_revision" field. It's identical to AppliedRevisionEQ.
+func AppliedRevision(v int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldAppliedRevision, v))
+}
+
+// EffectiveAt applies equality check predicate on the "effective_at" field. It's identical to EffectiveAtEQ.
+func EffectiveAt(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldEffectiveAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldMessage, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldActor, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldRequestID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContainsFold(FieldScope, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContainsFold(FieldValue, v))
+}
+
+// PriorValueEQ applies the EQ predicate on the "prior_value" field.
+func PriorValueEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldPriorValue, v))
+}
+
+// PriorValueNEQ applies the NEQ predicate on the "prior_value" field.
+func PriorValueNEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldPriorValue, v))
+}
+
+// PriorValueIn applies the In predicate on the "prior_value" field.
+func PriorValueIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldPriorValue, vs...))
+}
+
+// PriorValueNotIn applies the NotIn predicate on the "prior_value" field.
+func PriorValueNotIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldPriorValue, vs...))
+}
+
+// PriorValueGT applies the GT predicate on the "prior_value" field.
+func PriorValueGT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldPriorValue, v))
+}
+
+// PriorValueGTE applies the GTE predicate on the "prior_value" field.
+func PriorValueGTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldPriorValue, v))
+}
+
+// PriorValueLT applies the LT predicate on the "prior_value" field.
+func PriorValueLT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldPriorValue, v))
+}
+
+// PriorValueLTE applies the LTE predicate on the "prior_value" field.
+func PriorValueLTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldPriorValue, v))
+}
+
+// PriorValueContains applies the Contains predicate on the "prior_value" field.
+func PriorValueContains(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContains(FieldPriorValue, v))
+}
+
+// PriorValueHasPrefix applies the HasPrefix predicate on the "prior_value" field.
+func PriorValueHasPrefix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasPrefix(FieldPriorValue, v))
+}
+
+// PriorValueHasSuffix applies the HasSuffix predicate on the "prior_value" field.
+func PriorValueHasSuffix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasSuffix(FieldPriorValue, v))
+}
+
+// PriorValueIsNil applies the IsNil predicate on the "prior_value" field.
+func PriorValueIsNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIsNull(FieldPriorValue))
+}
+
+// PriorValueNotNil applies the NotNil predicate on the "prior_value" field.
+func PriorValueNotNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotNull(FieldPriorValue))
+}
+
+// PriorValueEqualFold applies the EqualFold predicate on the "prior_value" field.
+func PriorValueEqualFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEqualFold(FieldPriorValue, v))
+}
+
+// PriorValueContainsFold applies the ContainsFold predicate on the "prior_value" field.
+func PriorValueContainsFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContainsFold(FieldPriorValue, v))
+}
+
+// AppliedRevisionEQ applies the EQ predicate on the "applied_revision" field.
+func AppliedRevisionEQ(v int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionNEQ applies the NEQ predicate on the "applied_revision" field.
+func AppliedRevisionNEQ(v int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionIn applies the In predicate on the "applied_revision" field.
+func AppliedRevisionIn(vs ...int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldAppliedRevision, vs...))
+}
+
+// AppliedRevisionNotIn applies the NotIn predicate on the "applied_revision" field.
+func AppliedRevisionNotIn(vs ...int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldAppliedRevision, vs...))
+}
+
+// AppliedRevisionGT applies the GT predicate on the "applied_revision" field.
+func AppliedRevisionGT(v int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionGTE applies the GTE predicate on the "applied_revision" field.
+func AppliedRevisionGTE(v int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionLT applies the LT predicate on the "applied_revision" field.
+func AppliedRevisionLT(v int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionLTE applies the LTE predicate on the "applied_revision" field.
+func AppliedRevisionLTE(v int) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldAppliedRevision, v))
+}
+
+// EffectiveAtEQ applies the EQ predicate on the "effective_at" field.
+func EffectiveAtEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldEffectiveAt, v))
+}
+
+// EffectiveAtNEQ applies the NEQ predicate on the "effective_at" field.
+func EffectiveAtNEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldEffectiveAt, v))
+}
+
+// EffectiveAtIn applies the In predicate on the "effective_at" field.
+func EffectiveAtIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldEffectiveAt, vs...))
+}
+
+// EffectiveAtNotIn applies the NotIn predicate on the "effective_at" field.
+func EffectiveAtNotIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldEffectiveAt, vs...))
+}
+
+// EffectiveAtGT applies the GT predicate on the "effective_at" field.
+func EffectiveAtGT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldEffectiveAt, v))
+}
+
+// EffectiveAtGTE applies the GTE predicate on the "effective_at" field.
+func EffectiveAtGTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldEffectiveAt, v))
+}
+
+// EffectiveAtLT applies the LT predicate on the "effective_at" field.
+func EffectiveAtLT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldEffectiveAt, v))
+}
+
+// EffectiveAtLTE applies the LTE predicate on the "effective_at" field.
+func EffectiveAtLTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldEffectiveAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContainsFold(FieldActor, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConfigSchedule) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConfigSchedule) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConfigSchedule) predicate.ConfigSchedule {
+	return predicate.ConfigSchedule(sql.NotPredicates(p))
+}
@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/configschedule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigScheduleCreate is the builder for creating a ConfigSchedule entity.
+type ConfigScheduleCreate struct {
+	config
+	mutation *ConfigScheduleMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *ConfigScheduleCreate) SetKey(v string) *ConfigScheduleCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *ConfigScheduleCreate) SetScope(v string) *ConfigScheduleCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableScope(v *string) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *ConfigScheduleCreate) SetValue(v string) *ConfigScheduleCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetPriorValue sets the "prior_value" field.
+func (_c *ConfigScheduleCreate) SetPriorValue(v string) *ConfigScheduleCreate {
+	_c.mutation.SetPriorValue(v)
+	return _c
+}
+
+// SetNillablePriorValue sets the "prior_value" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillablePriorValue(v *string) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetPriorValue(*v)
+	}
+	return _c
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (_c *ConfigScheduleCreate) SetAppliedRevision(v int) *ConfigScheduleCreate {
+	_c.mutation.SetAppliedRevision(v)
+	return _c
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableAppliedRevision(v *int) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetAppliedRevision(*v)
+	}
+	return _c
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (_c *ConfigScheduleCreate) SetEffectiveAt(v time.Time) *ConfigScheduleCreate {
+	_c.mutation.SetEffectiveAt(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ConfigScheduleCreate) SetExpiresAt(v time.Time) *ConfigScheduleCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableExpiresAt(v *time.Time) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ConfigScheduleCreate) SetStatus(v configschedule.Status) *ConfigScheduleCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableStatus(v *configschedule.Status) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ConfigScheduleCreate) SetMessage(v string) *ConfigScheduleCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableMessage(v *string) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *ConfigScheduleCreate) SetActor(v string) *ConfigScheduleCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableActor(v *string) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *ConfigScheduleCreate) SetRequestID(v string) *ConfigScheduleCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableRequestID(v *string) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConfigScheduleCreate) SetCreatedAt(v time.Time) *ConfigScheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableCreatedAt(v *time.Time) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConfigScheduleCreate) SetUpdatedAt(v time.Time) *ConfigScheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConfigScheduleCreate) SetNillableUpdatedAt(v *time.Time) *ConfigScheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ConfigScheduleMutation object of the builder.
+func (_c *ConfigScheduleCreate) Mutation() *ConfigScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the ConfigSchedule in the database.
+func (_c *ConfigScheduleCreate) Save(ctx context.Context) (*ConfigSchedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConfigScheduleCreate) SaveX(ctx context.Context) *ConfigSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConfigScheduleCreate) defaults() {
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := configschedule.DefaultScope
+		_c.mutation.SetScope(v)
+	}
+	if _, ok := _c.mutation.AppliedRevision(); !ok {
+		v := configschedule.DefaultAppliedRevision
+		_c.mutation.SetAppliedRevision(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := configschedule.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		v := configschedule.DefaultActor
+		_c.mutation.SetActor(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := configschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := configschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConfigScheduleCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ConfigSchedule.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := configschedule.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ConfigSchedule.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "ConfigSchedule.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := configschedule.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ConfigSchedule.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ConfigSchedule.value"`)}
+	}
+	if _, ok := _c.mutation.AppliedRevision(); !ok {
+		return &ValidationError{Name: "applied_revision", err: errors.New(`ent: missing required field "ConfigSchedule.applied_revision"`)}
+	}
+	if _, ok := _c.mutation.EffectiveAt(); !ok {
+		return &ValidationError{Name: "effective_at", err: errors.New(`ent: missing required field "ConfigSchedule.effective_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ConfigSchedule.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := configschedule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConfigSchedule.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "ConfigSchedule.actor"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConfigSchedule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ConfigSchedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ConfigScheduleCreate) sqlSave(ctx context.Context) (*ConfigSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConfigScheduleCreate) createSpec() (*ConfigSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfigSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(configschedule.Table, sqlgraph.NewFieldSpec(configschedule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(configschedule.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(configschedule.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(configschedule.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.PriorValue(); ok {
+		_spec.SetField(configschedule.FieldPriorValue, field.TypeString, value)
+		_node.PriorValue = &value
+	}
+	if value, ok := _c.mutation.AppliedRevision(); ok {
+		_spec.SetField(configschedule.FieldAppliedRevision, field.TypeInt, value)
+		_node.AppliedRevision = value
+	}
+	if value, ok := _c.mutation.EffectiveAt(); ok {
+		_spec.SetField(configschedule.FieldEffectiveAt, field.TypeTime, value)
+		_node.EffectiveAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(configschedule.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(configschedule.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(configschedule.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(configschedule.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(configschedule.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(configschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(configschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConfigScheduleCreateBulk is the builder for creating many ConfigSchedule entities in bulk.
+type ConfigScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ConfigScheduleCreate
+}
+
+// Save creates the ConfigSchedule entities in the database.
+func (_c *ConfigScheduleCreateBulk) Save(ctx context.Context) ([]*ConfigSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConfigSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfigScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConfigScheduleCreateBulk) SaveX(ctx context.Context) []*ConfigSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/configschedule"
+	"apprun/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigScheduleUpdate is the builder for updating ConfigSchedule entities.
+type ConfigScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConfigScheduleMutation
+}
+
+// Where appends a list predicates to the ConfigScheduleUpdate builder.
+func (_u *ConfigScheduleUpdate) Where(ps ...predicate.ConfigSchedule) *ConfigScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPriorValue sets the "prior_value" field.
+func (_u *ConfigScheduleUpdate) SetPriorValue(v string) *ConfigScheduleUpdate {
+	_u.mutation.SetPriorValue(v)
+	return _u
+}
+
+// SetNillablePriorValue sets the "prior_value" field if the given value is not nil.
+func (_u *ConfigScheduleUpdate) SetNillablePriorValue(v *string) *ConfigScheduleUpdate {
+	if v != nil {
+		_u.SetPriorValue(*v)
+	}
+	return _u
+}
+
+// ClearPriorValue clears the value of the "prior_value" field.
+func (_u *ConfigScheduleUpdate) ClearPriorValue() *ConfigScheduleUpdate {
+	_u.mutation.ClearPriorValue()
+	return _u
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (_u *ConfigScheduleUpdate) SetAppliedRevision(v int) *ConfigScheduleUpdate {
+	_u.mutation.ResetAppliedRevision()
+	_u.mutation.SetAppliedRevision(v)
+	return _u
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_u *ConfigScheduleUpdate) SetNillableAppliedRevision(v *int) *ConfigScheduleUpdate {
+	if v != nil {
+		_u.SetAppliedRevision(*v)
+	}
+	return _u
+}
+
+// AddAppliedRevision adds value to the "applied_revision" field.
+func (_u *ConfigScheduleUpdate) AddAppliedRevision(v int) *ConfigScheduleUpdate {
+	_u.mutation.AddAppliedRevision(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ConfigScheduleUpdate) SetStatus(v configschedule.Status) *ConfigScheduleUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ConfigScheduleUpdate) SetNillableStatus(v *configschedule.Status) *ConfigScheduleUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ConfigScheduleUpdate) SetMessage(v string) *ConfigScheduleUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ConfigScheduleUpdate) SetNillableMessage(v *string) *ConfigScheduleUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ConfigScheduleUpdate) ClearMessage() *ConfigScheduleUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigScheduleUpdate) SetUpdatedAt(v time.Time) *ConfigScheduleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ConfigScheduleMutation object of the builder.
+func (_u *ConfigScheduleUpdate) Mutation() *ConfigScheduleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConfigScheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConfigScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConfigScheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := configschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigScheduleUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := configschedule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConfigSchedule.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(configschedule.Table, configschedule.Columns, sqlgraph.NewFieldSpec(configschedule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PriorValue(); ok {
+		_spec.SetField(configschedule.FieldPriorValue, field.TypeString, value)
+	}
+	if _u.mutation.PriorValueCleared() {
+		_spec.ClearField(configschedule.FieldPriorValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppliedRevision(); ok {
+		_spec.SetField(configschedule.FieldAppliedRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedRevision(); ok {
+		_spec.AddField(configschedule.FieldAppliedRevision, field.TypeInt, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(configschedule.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(configschedule.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(configschedule.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(configschedule.FieldMessage, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(configschedule.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(configschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConfigScheduleUpdateOne is the builder for updating a single ConfigSchedule entity.
+type ConfigScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConfigScheduleMutation
+}
+
+// SetPriorValue sets the "prior_value" field.
+func (_u *ConfigScheduleUpdateOne) SetPriorValue(v string) *ConfigScheduleUpdateOne {
+	_u.mutation.SetPriorValue(v)
+	return _u
+}
+
+// SetNillablePriorValue sets the "prior_value" field if the given value is not nil.
+func (_u *ConfigScheduleUpdateOne) SetNillablePriorValue(v *string) *ConfigScheduleUpdateOne {
+	if v != nil {
+		_u.SetPriorValue(*v)
+	}
+	return _u
+}
+
+// ClearPriorValue clears the value of the "prior_value" field.
+func (_u *ConfigScheduleUpdateOne) ClearPriorValue() *ConfigScheduleUpdateOne {
+	_u.mutation.ClearPriorValue()
+	return _u
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (_u *ConfigScheduleUpdateOne) SetAppliedRevision(v int) *ConfigScheduleUpdateOne {
+	_u.mutation.ResetAppliedRevision()
+	_u.mutation.SetAppliedRevision(v)
+	return _u
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_u *ConfigScheduleUpdateOne) SetNillableAppliedRevision(v *int) *ConfigScheduleUpdateOne {
+	if v != nil {
+		_u.SetAppliedRevision(*v)
+	}
+	return _u
+}
+
+// AddAppliedRevision adds value to the "applied_revision" field.
+func (_u *ConfigScheduleUpdateOne) AddAppliedRevision(v int) *ConfigScheduleUpdateOne {
+	_u.mutation.AddAppliedRevision(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ConfigScheduleUpdateOne) SetStatus(v configschedule.Status) *ConfigScheduleUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ConfigScheduleUpdateOne) SetNillableStatus(v *configschedule.Status) *ConfigScheduleUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ConfigScheduleUpdateOne) SetMessage(v string) *ConfigScheduleUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ConfigScheduleUpdateOne) SetNillableMessage(v *string) *ConfigScheduleUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ConfigScheduleUpdateOne) ClearMessage() *ConfigScheduleUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigScheduleUpdateOne) SetUpdatedAt(v time.Time) *ConfigScheduleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ConfigScheduleMutation object of the builder.
+func (_u *ConfigScheduleUpdateOne) Mutation() *ConfigScheduleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConfigScheduleUpdate builder.
+func (_u *ConfigScheduleUpdateOne) Where(ps ...predicate.ConfigSchedule) *ConfigScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConfigScheduleUpdateOne) Select(field string, fields ...string) *ConfigScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConfigSchedule entity.
+func (_u *ConfigScheduleUpdateOne) Save(ctx context.Context) (*ConfigSchedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigScheduleUpdateOne) SaveX(ctx context.Context) *ConfigSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConfigScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConfigScheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := configschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := configschedule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConfigSchedule.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigScheduleUpdateOne) sqlSave(ctx context.Context) (_node *ConfigSchedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(configschedule.Table, configschedule.Columns, sqlgraph.NewFieldSpec(configschedule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConfigSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, configschedule.FieldID)
+		for _, f := range fields {
+			if !configschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != configschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PriorValue(); ok {
+		_spec.SetField(configschedule.FieldPriorValue, field.TypeString, value)
+	}
+	if _u.mutation.PriorValueCleared() {
+		_spec.ClearField(configschedule.FieldPriorValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppliedRevision(); ok {
+		_spec.SetField(configschedule.FieldAppliedRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedRevision(); ok {
+		_spec.AddField(configschedule.FieldAppliedRevision, field.TypeInt, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(configschedule.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(configschedule.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(configschedule.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(configschedule.FieldMessage, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(configschedule.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(configschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ConfigSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
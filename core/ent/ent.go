@@ -3,7 +3,12 @@
 package ent
 
 import (
+	"apprun/ent/configchangerequest"
+	"apprun/ent/confighistory"
 	"apprun/ent/configitem"
+	"apprun/ent/configschedule"
+	"apprun/ent/featureflag"
+	"apprun/ent/featureflaghistory"
 	"apprun/ent/servers"
 	"apprun/ent/users"
 	"context"
@@ -75,9 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			configitem.Table: configitem.ValidColumn,
-			servers.Table:    servers.ValidColumn,
-			users.Table:      users.ValidColumn,
+			configchangerequest.Table: configchangerequest.ValidColumn,
+			confighistory.Table:       confighistory.ValidColumn,
+			configschedule.Table:      configschedule.ValidColumn,
+			configitem.Table:          configitem.ValidColumn,
+			featureflag.Table:         featureflag.ValidColumn,
+			featureflaghistory.Table:  featureflaghistory.ValidColumn,
+			servers.Table:             servers.ValidColumn,
+			users.Table:               users.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
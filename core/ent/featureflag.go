@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/featureflag"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FeatureFlag is the model entity for the FeatureFlag schema.
+type FeatureFlag struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Flag 的键，如 checkout.new_flow
+	Key string `json:"key,omitempty"`
+	// Flag 定义（变体、定向规则、灰度比例）的 JSON
+	Definition string `json:"definition,omitempty"`
+	// 修订号，每次更新加 1
+	Revision int `json:"revision,omitempty"`
+	// 最后修改时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 最后修改人
+	UpdatedBy    string `json:"updated_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeatureFlag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case featureflag.FieldID, featureflag.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case featureflag.FieldKey, featureflag.FieldDefinition, featureflag.FieldUpdatedBy:
+			values[i] = new(sql.NullString)
+		case featureflag.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeatureFlag fields.
+func (_m *FeatureFlag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case featureflag.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case featureflag.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case featureflag.FieldDefinition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field definition", values[i])
+			} else if value.Valid {
+				_m.Definition = value.String
+			}
+		case featureflag.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case featureflag.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case featureflag.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeatureFlag.
+// This includes values selected through modifiers, order, etc.
+func (_m *FeatureFlag) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FeatureFlag.
+// Note that you need to call FeatureFlag.Unwrap() before calling this method if this FeatureFlag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FeatureFlag) Update() *FeatureFlagUpdateOne {
+	return NewFeatureFlagClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FeatureFlag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FeatureFlag) Unwrap() *FeatureFlag {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FeatureFlag is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FeatureFlag) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeatureFlag(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("definition=")
+	builder.WriteString(_m.Definition)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(_m.UpdatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FeatureFlags is a parsable slice of FeatureFlag.
+type FeatureFlags []*FeatureFlag
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/featureflaghistory"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FeatureFlagHistory is the model entity for the FeatureFlagHistory schema.
+type FeatureFlagHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Flag 的键
+	Key string `json:"key,omitempty"`
+	// 变更前的定义（为空表示变更前不存在）
+	OldDefinition *string `json:"old_definition,omitempty"`
+	// 变更后的定义（为空表示已删除）
+	NewDefinition *string `json:"new_definition,omitempty"`
+	// 变更类型
+	Action featureflaghistory.Action `json:"action,omitempty"`
+	// 变更操作人
+	Actor string `json:"actor,omitempty"`
+	// 触发变更的请求 ID
+	RequestID string `json:"request_id,omitempty"`
+	// 变更时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeatureFlagHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case featureflaghistory.FieldID:
+			values[i] = new(sql.NullInt64)
+		case featureflaghistory.FieldKey, featureflaghistory.FieldOldDefinition, featureflaghistory.FieldNewDefinition, featureflaghistory.FieldAction, featureflaghistory.FieldActor, featureflaghistory.FieldRequestID:
+			values[i] = new(sql.NullString)
+		case featureflaghistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeatureFlagHistory fields.
+func (_m *FeatureFlagHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case featureflaghistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case featureflaghistory.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case featureflaghistory.FieldOldDefinition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_definition", values[i])
+			} else if value.Valid {
+				_m.OldDefinition = new(string)
+				*_m.OldDefinition = value.String
+			}
+		case featureflaghistory.FieldNewDefinition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_definition", values[i])
+			} else if value.Valid {
+				_m.NewDefinition = new(string)
+				*_m.NewDefinition = value.String
+			}
+		case featureflaghistory.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = featureflaghistory.Action(value.String)
+			}
+		case featureflaghistory.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case featureflaghistory.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = value.String
+			}
+		case featureflaghistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeatureFlagHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *FeatureFlagHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FeatureFlagHistory.
+// Note that you need to call FeatureFlagHistory.Unwrap() before calling this method if this FeatureFlagHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FeatureFlagHistory) Update() *FeatureFlagHistoryUpdateOne {
+	return NewFeatureFlagHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FeatureFlagHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FeatureFlagHistory) Unwrap() *FeatureFlagHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FeatureFlagHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FeatureFlagHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeatureFlagHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	if v := _m.OldDefinition; v != nil {
+		builder.WriteString("old_definition=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.NewDefinition; v != nil {
+		builder.WriteString("new_definition=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(_m.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FeatureFlagHistories is a parsable slice of FeatureFlagHistory.
+type FeatureFlagHistories []*FeatureFlagHistory
@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package featureflaghistory
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the featureflaghistory type in the database.
+	Label = "feature_flag_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldOldDefinition holds the string denoting the old_definition field in the database.
+	FieldOldDefinition = "old_definition"
+	// FieldNewDefinition holds the string denoting the new_definition field in the database.
+	FieldNewDefinition = "new_definition"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the featureflaghistory in the database.
+	Table = "feature_flag_histories"
+)
+
+// Columns holds all SQL columns for featureflaghistory fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldOldDefinition,
+	FieldNewDefinition,
+	FieldAction,
+	FieldActor,
+	FieldRequestID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// DefaultActor holds the default value on creation for the "actor" field.
+	DefaultActor string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionSet    Action = "set"
+	ActionDelete Action = "delete"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionSet, ActionDelete:
+		return nil
+	default:
+		return fmt.Errorf("featureflaghistory: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the FeatureFlagHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByOldDefinition orders the results by the old_definition field.
+func ByOldDefinition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldDefinition, opts...).ToFunc()
+}
+
+// ByNewDefinition orders the results by the new_definition field.
+func ByNewDefinition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewDefinition, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
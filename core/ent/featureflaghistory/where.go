@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package featureflaghistory
+
+import (
+	"apprun/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldKey, v))
+}
+
+// OldDefinition applies equality check predicate on the "old_definition" field. It's identical to OldDefinitionEQ.
+func OldDefinition(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldOldDefinition, v))
+}
+
+// NewDefinition applies equality check predicate on the "new_definition" field. It's identical to NewDefinitionEQ.
+func NewDefinition(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldNewDefinition, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldRequestID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContainsFold(FieldKey, v))
+}
+
+// OldDefinitionEQ applies the EQ predicate on the "old_definition" field.
+func OldDefinitionEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldOldDefinition, v))
+}
+
+// OldDefinitionNEQ applies the NEQ predicate on the "old_definition" field.
+func OldDefinitionNEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldOldDefinition, v))
+}
+
+// OldDefinitionIn applies the In predicate on the "old_definition" field.
+func OldDefinitionIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldOldDefinition, vs...))
+}
+
+// OldDefinitionNotIn applies the NotIn predicate on the "old_definition" field.
+func OldDefinitionNotIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldOldDefinition, vs...))
+}
+
+// OldDefinitionGT applies the GT predicate on the "old_definition" field.
+func OldDefinitionGT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGT(FieldOldDefinition, v))
+}
+
+// OldDefinitionGTE applies the GTE predicate on the "old_definition" field.
+func OldDefinitionGTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGTE(FieldOldDefinition, v))
+}
+
+// OldDefinitionLT applies the LT predicate on the "old_definition" field.
+func OldDefinitionLT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLT(FieldOldDefinition, v))
+}
+
+// OldDefinitionLTE applies the LTE predicate on the "old_definition" field.
+func OldDefinitionLTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLTE(FieldOldDefinition, v))
+}
+
+// OldDefinitionContains applies the Contains predicate on the "old_definition" field.
+func OldDefinitionContains(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContains(FieldOldDefinition, v))
+}
+
+// OldDefinitionHasPrefix applies the HasPrefix predicate on the "old_definition" field.
+func OldDefinitionHasPrefix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasPrefix(FieldOldDefinition, v))
+}
+
+// OldDefinitionHasSuffix applies the HasSuffix predicate on the "old_definition" field.
+func OldDefinitionHasSuffix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasSuffix(FieldOldDefinition, v))
+}
+
+// OldDefinitionIsNil applies the IsNil predicate on the "old_definition" field.
+func OldDefinitionIsNil() predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIsNull(FieldOldDefinition))
+}
+
+// OldDefinitionNotNil applies the NotNil predicate on the "old_definition" field.
+func OldDefinitionNotNil() predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotNull(FieldOldDefinition))
+}
+
+// OldDefinitionEqualFold applies the EqualFold predicate on the "old_definition" field.
+func OldDefinitionEqualFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEqualFold(FieldOldDefinition, v))
+}
+
+// OldDefinitionContainsFold applies the ContainsFold predicate on the "old_definition" field.
+func OldDefinitionContainsFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContainsFold(FieldOldDefinition, v))
+}
+
+// NewDefinitionEQ applies the EQ predicate on the "new_definition" field.
+func NewDefinitionEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldNewDefinition, v))
+}
+
+// NewDefinitionNEQ applies the NEQ predicate on the "new_definition" field.
+func NewDefinitionNEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldNewDefinition, v))
+}
+
+// NewDefinitionIn applies the In predicate on the "new_definition" field.
+func NewDefinitionIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldNewDefinition, vs...))
+}
+
+// NewDefinitionNotIn applies the NotIn predicate on the "new_definition" field.
+func NewDefinitionNotIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldNewDefinition, vs...))
+}
+
+// NewDefinitionGT applies the GT predicate on the "new_definition" field.
+func NewDefinitionGT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGT(FieldNewDefinition, v))
+}
+
+// NewDefinitionGTE applies the GTE predicate on the "new_definition" field.
+func NewDefinitionGTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGTE(FieldNewDefinition, v))
+}
+
+// NewDefinitionLT applies the LT predicate on the "new_definition" field.
+func NewDefinitionLT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLT(FieldNewDefinition, v))
+}
+
+// NewDefinitionLTE applies the LTE predicate on the "new_definition" field.
+func NewDefinitionLTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLTE(FieldNewDefinition, v))
+}
+
+// NewDefinitionContains applies the Contains predicate on the "new_definition" field.
+func NewDefinitionContains(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContains(FieldNewDefinition, v))
+}
+
+// NewDefinitionHasPrefix applies the HasPrefix predicate on the "new_definition" field.
+func NewDefinitionHasPrefix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasPrefix(FieldNewDefinition, v))
+}
+
+// NewDefinitionHasSuffix applies the HasSuffix predicate on the "new_definition" field.
+func NewDefinitionHasSuffix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasSuffix(FieldNewDefinition, v))
+}
+
+// NewDefinitionIsNil applies the IsNil predicate on the "new_definition" field.
+func NewDefinitionIsNil() predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIsNull(FieldNewDefinition))
+}
+
+// NewDefinitionNotNil applies the NotNil predicate on the "new_definition" field.
+func NewDefinitionNotNil() predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotNull(FieldNewDefinition))
+}
+
+// NewDefinitionEqualFold applies the EqualFold predicate on the "new_definition" field.
+func NewDefinitionEqualFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEqualFold(FieldNewDefinition, v))
+}
+
+// NewDefinitionContainsFold applies the ContainsFold predicate on the "new_definition" field.
+func NewDefinitionContainsFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContainsFold(FieldNewDefinition, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContainsFold(FieldActor, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeatureFlagHistory) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeatureFlagHistory) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeatureFlagHistory) predicate.FeatureFlagHistory {
+	return predicate.FeatureFlagHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/featureflaghistory"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FeatureFlagHistoryCreate is the builder for creating a FeatureFlagHistory entity.
+type FeatureFlagHistoryCreate struct {
+	config
+	mutation *FeatureFlagHistoryMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *FeatureFlagHistoryCreate) SetKey(v string) *FeatureFlagHistoryCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetOldDefinition sets the "old_definition" field.
+func (_c *FeatureFlagHistoryCreate) SetOldDefinition(v string) *FeatureFlagHistoryCreate {
+	_c.mutation.SetOldDefinition(v)
+	return _c
+}
+
+// SetNillableOldDefinition sets the "old_definition" field if the given value is not nil.
+func (_c *FeatureFlagHistoryCreate) SetNillableOldDefinition(v *string) *FeatureFlagHistoryCreate {
+	if v != nil {
+		_c.SetOldDefinition(*v)
+	}
+	return _c
+}
+
+// SetNewDefinition sets the "new_definition" field.
+func (_c *FeatureFlagHistoryCreate) SetNewDefinition(v string) *FeatureFlagHistoryCreate {
+	_c.mutation.SetNewDefinition(v)
+	return _c
+}
+
+// SetNillableNewDefinition sets the "new_definition" field if the given value is not nil.
+func (_c *FeatureFlagHistoryCreate) SetNillableNewDefinition(v *string) *FeatureFlagHistoryCreate {
+	if v != nil {
+		_c.SetNewDefinition(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *FeatureFlagHistoryCreate) SetAction(v featureflaghistory.Action) *FeatureFlagHistoryCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *FeatureFlagHistoryCreate) SetActor(v string) *FeatureFlagHistoryCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *FeatureFlagHistoryCreate) SetNillableActor(v *string) *FeatureFlagHistoryCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *FeatureFlagHistoryCreate) SetRequestID(v string) *FeatureFlagHistoryCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *FeatureFlagHistoryCreate) SetNillableRequestID(v *string) *FeatureFlagHistoryCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FeatureFlagHistoryCreate) SetCreatedAt(v time.Time) *FeatureFlagHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FeatureFlagHistoryCreate) SetNillableCreatedAt(v *time.Time) *FeatureFlagHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FeatureFlagHistoryMutation object of the builder.
+func (_c *FeatureFlagHistoryCreate) Mutation() *FeatureFlagHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the FeatureFlagHistory in the database.
+func (_c *FeatureFlagHistoryCreate) Save(ctx context.Context) (*FeatureFlagHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FeatureFlagHistoryCreate) SaveX(ctx context.Context) *FeatureFlagHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeatureFlagHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeatureFlagHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FeatureFlagHistoryCreate) defaults() {
+	if _, ok := _c.mutation.Actor(); !ok {
+		v := featureflaghistory.DefaultActor
+		_c.mutation.SetActor(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := featureflaghistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FeatureFlagHistoryCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "FeatureFlagHistory.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := featureflaghistory.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "FeatureFlagHistory.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "FeatureFlagHistory.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := featureflaghistory.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "FeatureFlagHistory.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "FeatureFlagHistory.actor"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FeatureFlagHistory.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *FeatureFlagHistoryCreate) sqlSave(ctx context.Context) (*FeatureFlagHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FeatureFlagHistoryCreate) createSpec() (*FeatureFlagHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeatureFlagHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(featureflaghistory.Table, sqlgraph.NewFieldSpec(featureflaghistory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(featureflaghistory.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.OldDefinition(); ok {
+		_spec.SetField(featureflaghistory.FieldOldDefinition, field.TypeString, value)
+		_node.OldDefinition = &value
+	}
+	if value, ok := _c.mutation.NewDefinition(); ok {
+		_spec.SetField(featureflaghistory.FieldNewDefinition, field.TypeString, value)
+		_node.NewDefinition = &value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(featureflaghistory.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(featureflaghistory.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(featureflaghistory.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(featureflaghistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// FeatureFlagHistoryCreateBulk is the builder for creating many FeatureFlagHistory entities in bulk.
+type FeatureFlagHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*FeatureFlagHistoryCreate
+}
+
+// Save creates the FeatureFlagHistory entities in the database.
+func (_c *FeatureFlagHistoryCreateBulk) Save(ctx context.Context) ([]*FeatureFlagHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FeatureFlagHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeatureFlagHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FeatureFlagHistoryCreateBulk) SaveX(ctx context.Context) []*FeatureFlagHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeatureFlagHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeatureFlagHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
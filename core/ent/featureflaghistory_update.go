@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"apprun/ent/featureflaghistory"
+	"apprun/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FeatureFlagHistoryUpdate is the builder for updating FeatureFlagHistory entities.
+type FeatureFlagHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FeatureFlagHistoryMutation
+}
+
+// Where appends a list predicates to the FeatureFlagHistoryUpdate builder.
+func (_u *FeatureFlagHistoryUpdate) Where(ps ...predicate.FeatureFlagHistory) *FeatureFlagHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the FeatureFlagHistoryMutation object of the builder.
+func (_u *FeatureFlagHistoryUpdate) Mutation() *FeatureFlagHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FeatureFlagHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeatureFlagHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FeatureFlagHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeatureFlagHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *FeatureFlagHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(featureflaghistory.Table, featureflaghistory.Columns, sqlgraph.NewFieldSpec(featureflaghistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OldDefinitionCleared() {
+		_spec.ClearField(featureflaghistory.FieldOldDefinition, field.TypeString)
+	}
+	if _u.mutation.NewDefinitionCleared() {
+		_spec.ClearField(featureflaghistory.FieldNewDefinition, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(featureflaghistory.FieldRequestID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{featureflaghistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FeatureFlagHistoryUpdateOne is the builder for updating a single FeatureFlagHistory entity.
+type FeatureFlagHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FeatureFlagHistoryMutation
+}
+
+// Mutation returns the FeatureFlagHistoryMutation object of the builder.
+func (_u *FeatureFlagHistoryUpdateOne) Mutation() *FeatureFlagHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FeatureFlagHistoryUpdate builder.
+func (_u *FeatureFlagHistoryUpdateOne) Where(ps ...predicate.FeatureFlagHistory) *FeatureFlagHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FeatureFlagHistoryUpdateOne) Select(field string, fields ...string) *FeatureFlagHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FeatureFlagHistory entity.
+func (_u *FeatureFlagHistoryUpdateOne) Save(ctx context.Context) (*FeatureFlagHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeatureFlagHistoryUpdateOne) SaveX(ctx context.Context) *FeatureFlagHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FeatureFlagHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeatureFlagHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *FeatureFlagHistoryUpdateOne) sqlSave(ctx context.Context) (_node *FeatureFlagHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(featureflaghistory.Table, featureflaghistory.Columns, sqlgraph.NewFieldSpec(featureflaghistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FeatureFlagHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, featureflaghistory.FieldID)
+		for _, f := range fields {
+			if !featureflaghistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != featureflaghistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OldDefinitionCleared() {
+		_spec.ClearField(featureflaghistory.FieldOldDefinition, field.TypeString)
+	}
+	if _u.mutation.NewDefinitionCleared() {
+		_spec.ClearField(featureflaghistory.FieldNewDefinition, field.TypeString)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(featureflaghistory.FieldRequestID, field.TypeString)
+	}
+	_node = &FeatureFlagHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{featureflaghistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
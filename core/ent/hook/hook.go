@@ -8,6 +8,42 @@ import (
 	"fmt"
 )
 
+// The ConfigChangeRequestFunc type is an adapter to allow the use of ordinary
+// function as ConfigChangeRequest mutator.
+type ConfigChangeRequestFunc func(context.Context, *ent.ConfigChangeRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConfigChangeRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConfigChangeRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConfigChangeRequestMutation", m)
+}
+
+// The ConfigHistoryFunc type is an adapter to allow the use of ordinary
+// function as ConfigHistory mutator.
+type ConfigHistoryFunc func(context.Context, *ent.ConfigHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConfigHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConfigHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConfigHistoryMutation", m)
+}
+
+// The ConfigScheduleFunc type is an adapter to allow the use of ordinary
+// function as ConfigSchedule mutator.
+type ConfigScheduleFunc func(context.Context, *ent.ConfigScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConfigScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConfigScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConfigScheduleMutation", m)
+}
+
 // The ConfigitemFunc type is an adapter to allow the use of ordinary
 // function as Configitem mutator.
 type ConfigitemFunc func(context.Context, *ent.ConfigitemMutation) (ent.Value, error)
@@ -20,6 +56,30 @@ func (f ConfigitemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConfigitemMutation", m)
 }
 
+// The FeatureFlagFunc type is an adapter to allow the use of ordinary
+// function as FeatureFlag mutator.
+type FeatureFlagFunc func(context.Context, *ent.FeatureFlagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeatureFlagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FeatureFlagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeatureFlagMutation", m)
+}
+
+// The FeatureFlagHistoryFunc type is an adapter to allow the use of ordinary
+// function as FeatureFlagHistory mutator.
+type FeatureFlagHistoryFunc func(context.Context, *ent.FeatureFlagHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeatureFlagHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FeatureFlagHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeatureFlagHistoryMutation", m)
+}
+
 // The ServersFunc type is an adapter to allow the use of ordinary
 // function as Servers mutator.
 type ServersFunc func(context.Context, *ent.ServersMutation) (ent.Value, error)
@@ -8,18 +8,150 @@ import (
 )
 
 var (
+	// ConfigChangeRequestsColumns holds the columns for the "config_change_requests" table.
+	ConfigChangeRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Default: "global"},
+		{Name: "value", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "expired", "failed"}, Default: "pending"},
+		{Name: "requested_by", Type: field.TypeString},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true},
+		{Name: "comment", Type: field.TypeString, Nullable: true},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ConfigChangeRequestsTable holds the schema information for the "config_change_requests" table.
+	ConfigChangeRequestsTable = &schema.Table{
+		Name:       "config_change_requests",
+		Columns:    ConfigChangeRequestsColumns,
+		PrimaryKey: []*schema.Column{ConfigChangeRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "configchangerequest_status",
+				Unique:  false,
+				Columns: []*schema.Column{ConfigChangeRequestsColumns[4]},
+			},
+		},
+	}
+	// ConfigHistoriesColumns holds the columns for the "config_histories" table.
+	ConfigHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Default: "global"},
+		{Name: "old_value", Type: field.TypeString, Nullable: true},
+		{Name: "new_value", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"set", "delete", "rollback", "reencrypt", "migrate", "revert"}},
+		{Name: "actor", Type: field.TypeString, Default: "system"},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ConfigHistoriesTable holds the schema information for the "config_histories" table.
+	ConfigHistoriesTable = &schema.Table{
+		Name:       "config_histories",
+		Columns:    ConfigHistoriesColumns,
+		PrimaryKey: []*schema.Column{ConfigHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "confighistory_key",
+				Unique:  false,
+				Columns: []*schema.Column{ConfigHistoriesColumns[1]},
+			},
+		},
+	}
+	// ConfigSchedulesColumns holds the columns for the "config_schedules" table.
+	ConfigSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Default: "global"},
+		{Name: "value", Type: field.TypeString},
+		{Name: "prior_value", Type: field.TypeString, Nullable: true},
+		{Name: "applied_revision", Type: field.TypeInt, Default: 0},
+		{Name: "effective_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "completed", "cancelled", "failed"}, Default: "pending"},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Default: "system"},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ConfigSchedulesTable holds the schema information for the "config_schedules" table.
+	ConfigSchedulesTable = &schema.Table{
+		Name:       "config_schedules",
+		Columns:    ConfigSchedulesColumns,
+		PrimaryKey: []*schema.Column{ConfigSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "configschedule_status",
+				Unique:  false,
+				Columns: []*schema.Column{ConfigSchedulesColumns[8]},
+			},
+		},
+	}
 	// ConfigitemsColumns holds the columns for the "configitems" table.
 	ConfigitemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Default: "global"},
 		{Name: "value", Type: field.TypeString},
 		{Name: "is_dynamic", Type: field.TypeBool, Default: false},
+		{Name: "revision", Type: field.TypeInt, Default: 1},
 	}
 	// ConfigitemsTable holds the schema information for the "configitems" table.
 	ConfigitemsTable = &schema.Table{
 		Name:       "configitems",
 		Columns:    ConfigitemsColumns,
 		PrimaryKey: []*schema.Column{ConfigitemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "configitem_key_scope",
+				Unique:  true,
+				Columns: []*schema.Column{ConfigitemsColumns[1], ConfigitemsColumns[2]},
+			},
+		},
+	}
+	// FeatureFlagsColumns holds the columns for the "feature_flags" table.
+	FeatureFlagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "definition", Type: field.TypeString, Size: 2147483647},
+		{Name: "revision", Type: field.TypeInt, Default: 1},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString, Default: "system"},
+	}
+	// FeatureFlagsTable holds the schema information for the "feature_flags" table.
+	FeatureFlagsTable = &schema.Table{
+		Name:       "feature_flags",
+		Columns:    FeatureFlagsColumns,
+		PrimaryKey: []*schema.Column{FeatureFlagsColumns[0]},
+	}
+	// FeatureFlagHistoriesColumns holds the columns for the "feature_flag_histories" table.
+	FeatureFlagHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "old_definition", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "new_definition", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"set", "delete"}},
+		{Name: "actor", Type: field.TypeString, Default: "system"},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// FeatureFlagHistoriesTable holds the schema information for the "feature_flag_histories" table.
+	FeatureFlagHistoriesTable = &schema.Table{
+		Name:       "feature_flag_histories",
+		Columns:    FeatureFlagHistoriesColumns,
+		PrimaryKey: []*schema.Column{FeatureFlagHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "featureflaghistory_key",
+				Unique:  false,
+				Columns: []*schema.Column{FeatureFlagHistoriesColumns[1]},
+			},
+		},
 	}
 	// ServersColumns holds the columns for the "servers" table.
 	ServersColumns = []*schema.Column{
@@ -56,7 +188,12 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ConfigChangeRequestsTable,
+		ConfigHistoriesTable,
+		ConfigSchedulesTable,
 		ConfigitemsTable,
+		FeatureFlagsTable,
+		FeatureFlagHistoriesTable,
 		ServersTable,
 		UsersTable,
 	}
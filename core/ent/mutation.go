@@ -3,7 +3,12 @@
 package ent
 
 import (
+	"apprun/ent/configchangerequest"
+	"apprun/ent/confighistory"
 	"apprun/ent/configitem"
+	"apprun/ent/configschedule"
+	"apprun/ent/featureflag"
+	"apprun/ent/featureflaghistory"
 	"apprun/ent/predicate"
 	"apprun/ent/servers"
 	"apprun/ent/users"
@@ -11,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -25,37 +31,4054 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeConfigitem = "Configitem"
-	TypeServers    = "Servers"
-	TypeUsers      = "Users"
+	TypeConfigChangeRequest = "ConfigChangeRequest"
+	TypeConfigHistory       = "ConfigHistory"
+	TypeConfigSchedule      = "ConfigSchedule"
+	TypeConfigitem          = "Configitem"
+	TypeFeatureFlag         = "FeatureFlag"
+	TypeFeatureFlagHistory  = "FeatureFlagHistory"
+	TypeServers             = "Servers"
+	TypeUsers               = "Users"
 )
 
-// ConfigitemMutation represents an operation that mutates the Configitem nodes in the graph.
-type ConfigitemMutation struct {
+// ConfigChangeRequestMutation represents an operation that mutates the ConfigChangeRequest nodes in the graph.
+type ConfigChangeRequestMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	key           *string
+	scope         *string
 	value         *string
-	is_dynamic    *bool
+	status        *configchangerequest.Status
+	requested_by  *string
+	request_id    *string
+	reviewed_by   *string
+	comment       *string
+	message       *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Configitem, error)
-	predicates    []predicate.Configitem
+	oldValue      func(context.Context) (*ConfigChangeRequest, error)
+	predicates    []predicate.ConfigChangeRequest
+}
+
+var _ ent.Mutation = (*ConfigChangeRequestMutation)(nil)
+
+// configchangerequestOption allows management of the mutation configuration using functional options.
+type configchangerequestOption func(*ConfigChangeRequestMutation)
+
+// newConfigChangeRequestMutation creates new mutation for the ConfigChangeRequest entity.
+func newConfigChangeRequestMutation(c config, op Op, opts ...configchangerequestOption) *ConfigChangeRequestMutation {
+	m := &ConfigChangeRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigChangeRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigChangeRequestID sets the ID field of the mutation.
+func withConfigChangeRequestID(id int) configchangerequestOption {
+	return func(m *ConfigChangeRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigChangeRequest
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigChangeRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigChangeRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigChangeRequest sets the old ConfigChangeRequest of the mutation.
+func withConfigChangeRequest(node *ConfigChangeRequest) configchangerequestOption {
+	return func(m *ConfigChangeRequestMutation) {
+		m.oldValue = func(context.Context) (*ConfigChangeRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigChangeRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigChangeRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigChangeRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigChangeRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigChangeRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ConfigChangeRequestMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ConfigChangeRequestMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ConfigChangeRequestMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *ConfigChangeRequestMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *ConfigChangeRequestMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *ConfigChangeRequestMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ConfigChangeRequestMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ConfigChangeRequestMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ConfigChangeRequestMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ConfigChangeRequestMutation) SetStatus(c configchangerequest.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ConfigChangeRequestMutation) Status() (r configchangerequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldStatus(ctx context.Context) (v configchangerequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ConfigChangeRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (m *ConfigChangeRequestMutation) SetRequestedBy(s string) {
+	m.requested_by = &s
+}
+
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *ConfigChangeRequestMutation) RequestedBy() (r string, exists bool) {
+	v := m.requested_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requested_by" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldRequestedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *ConfigChangeRequestMutation) ResetRequestedBy() {
+	m.requested_by = nil
+}
+
+// SetRequestID sets the "request_id" field.
+func (m *ConfigChangeRequestMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *ConfigChangeRequestMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestID returns the old "request_id" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (m *ConfigChangeRequestMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[configchangerequest.FieldRequestID] = struct{}{}
+}
+
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *ConfigChangeRequestMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[configchangerequest.FieldRequestID]
+	return ok
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *ConfigChangeRequestMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, configchangerequest.FieldRequestID)
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *ConfigChangeRequestMutation) SetReviewedBy(s string) {
+	m.reviewed_by = &s
+}
+
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *ConfigChangeRequestMutation) ReviewedBy() (r string, exists bool) {
+	v := m.reviewed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedBy returns the old "reviewed_by" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldReviewedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
+	}
+	return oldValue.ReviewedBy, nil
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (m *ConfigChangeRequestMutation) ClearReviewedBy() {
+	m.reviewed_by = nil
+	m.clearedFields[configchangerequest.FieldReviewedBy] = struct{}{}
+}
+
+// ReviewedByCleared returns if the "reviewed_by" field was cleared in this mutation.
+func (m *ConfigChangeRequestMutation) ReviewedByCleared() bool {
+	_, ok := m.clearedFields[configchangerequest.FieldReviewedBy]
+	return ok
+}
+
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *ConfigChangeRequestMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+	delete(m.clearedFields, configchangerequest.FieldReviewedBy)
+}
+
+// SetComment sets the "comment" field.
+func (m *ConfigChangeRequestMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *ConfigChangeRequestMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *ConfigChangeRequestMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[configchangerequest.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *ConfigChangeRequestMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[configchangerequest.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *ConfigChangeRequestMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, configchangerequest.FieldComment)
+}
+
+// SetMessage sets the "message" field.
+func (m *ConfigChangeRequestMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ConfigChangeRequestMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ConfigChangeRequestMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[configchangerequest.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ConfigChangeRequestMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[configchangerequest.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ConfigChangeRequestMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, configchangerequest.FieldMessage)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ConfigChangeRequestMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ConfigChangeRequestMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ConfigChangeRequestMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigChangeRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigChangeRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigChangeRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigChangeRequestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigChangeRequestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigChangeRequest entity.
+// If the ConfigChangeRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigChangeRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigChangeRequestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ConfigChangeRequestMutation builder.
+func (m *ConfigChangeRequestMutation) Where(ps ...predicate.ConfigChangeRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigChangeRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigChangeRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigChangeRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigChangeRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigChangeRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigChangeRequest).
+func (m *ConfigChangeRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigChangeRequestMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.key != nil {
+		fields = append(fields, configchangerequest.FieldKey)
+	}
+	if m.scope != nil {
+		fields = append(fields, configchangerequest.FieldScope)
+	}
+	if m.value != nil {
+		fields = append(fields, configchangerequest.FieldValue)
+	}
+	if m.status != nil {
+		fields = append(fields, configchangerequest.FieldStatus)
+	}
+	if m.requested_by != nil {
+		fields = append(fields, configchangerequest.FieldRequestedBy)
+	}
+	if m.request_id != nil {
+		fields = append(fields, configchangerequest.FieldRequestID)
+	}
+	if m.reviewed_by != nil {
+		fields = append(fields, configchangerequest.FieldReviewedBy)
+	}
+	if m.comment != nil {
+		fields = append(fields, configchangerequest.FieldComment)
+	}
+	if m.message != nil {
+		fields = append(fields, configchangerequest.FieldMessage)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, configchangerequest.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, configchangerequest.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, configchangerequest.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigChangeRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case configchangerequest.FieldKey:
+		return m.Key()
+	case configchangerequest.FieldScope:
+		return m.Scope()
+	case configchangerequest.FieldValue:
+		return m.Value()
+	case configchangerequest.FieldStatus:
+		return m.Status()
+	case configchangerequest.FieldRequestedBy:
+		return m.RequestedBy()
+	case configchangerequest.FieldRequestID:
+		return m.RequestID()
+	case configchangerequest.FieldReviewedBy:
+		return m.ReviewedBy()
+	case configchangerequest.FieldComment:
+		return m.Comment()
+	case configchangerequest.FieldMessage:
+		return m.Message()
+	case configchangerequest.FieldExpiresAt:
+		return m.ExpiresAt()
+	case configchangerequest.FieldCreatedAt:
+		return m.CreatedAt()
+	case configchangerequest.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigChangeRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case configchangerequest.FieldKey:
+		return m.OldKey(ctx)
+	case configchangerequest.FieldScope:
+		return m.OldScope(ctx)
+	case configchangerequest.FieldValue:
+		return m.OldValue(ctx)
+	case configchangerequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case configchangerequest.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case configchangerequest.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case configchangerequest.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	case configchangerequest.FieldComment:
+		return m.OldComment(ctx)
+	case configchangerequest.FieldMessage:
+		return m.OldMessage(ctx)
+	case configchangerequest.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case configchangerequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case configchangerequest.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigChangeRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigChangeRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case configchangerequest.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case configchangerequest.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case configchangerequest.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case configchangerequest.FieldStatus:
+		v, ok := value.(configchangerequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case configchangerequest.FieldRequestedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case configchangerequest.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case configchangerequest.FieldReviewedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedBy(v)
+		return nil
+	case configchangerequest.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case configchangerequest.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case configchangerequest.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case configchangerequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case configchangerequest.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigChangeRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigChangeRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigChangeRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigChangeRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConfigChangeRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigChangeRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(configchangerequest.FieldRequestID) {
+		fields = append(fields, configchangerequest.FieldRequestID)
+	}
+	if m.FieldCleared(configchangerequest.FieldReviewedBy) {
+		fields = append(fields, configchangerequest.FieldReviewedBy)
+	}
+	if m.FieldCleared(configchangerequest.FieldComment) {
+		fields = append(fields, configchangerequest.FieldComment)
+	}
+	if m.FieldCleared(configchangerequest.FieldMessage) {
+		fields = append(fields, configchangerequest.FieldMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigChangeRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigChangeRequestMutation) ClearField(name string) error {
+	switch name {
+	case configchangerequest.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	case configchangerequest.FieldReviewedBy:
+		m.ClearReviewedBy()
+		return nil
+	case configchangerequest.FieldComment:
+		m.ClearComment()
+		return nil
+	case configchangerequest.FieldMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigChangeRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigChangeRequestMutation) ResetField(name string) error {
+	switch name {
+	case configchangerequest.FieldKey:
+		m.ResetKey()
+		return nil
+	case configchangerequest.FieldScope:
+		m.ResetScope()
+		return nil
+	case configchangerequest.FieldValue:
+		m.ResetValue()
+		return nil
+	case configchangerequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case configchangerequest.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case configchangerequest.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case configchangerequest.FieldReviewedBy:
+		m.ResetReviewedBy()
+		return nil
+	case configchangerequest.FieldComment:
+		m.ResetComment()
+		return nil
+	case configchangerequest.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case configchangerequest.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case configchangerequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case configchangerequest.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigChangeRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigChangeRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigChangeRequestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigChangeRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigChangeRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigChangeRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigChangeRequestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigChangeRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigChangeRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigChangeRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigChangeRequest edge %s", name)
+}
+
+// ConfigHistoryMutation represents an operation that mutates the ConfigHistory nodes in the graph.
+type ConfigHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	scope         *string
+	old_value     *string
+	new_value     *string
+	action        *confighistory.Action
+	actor         *string
+	request_id    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ConfigHistory, error)
+	predicates    []predicate.ConfigHistory
+}
+
+var _ ent.Mutation = (*ConfigHistoryMutation)(nil)
+
+// confighistoryOption allows management of the mutation configuration using functional options.
+type confighistoryOption func(*ConfigHistoryMutation)
+
+// newConfigHistoryMutation creates new mutation for the ConfigHistory entity.
+func newConfigHistoryMutation(c config, op Op, opts ...confighistoryOption) *ConfigHistoryMutation {
+	m := &ConfigHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigHistoryID sets the ID field of the mutation.
+func withConfigHistoryID(id int) confighistoryOption {
+	return func(m *ConfigHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigHistory
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigHistory sets the old ConfigHistory of the mutation.
+func withConfigHistory(node *ConfigHistory) confighistoryOption {
+	return func(m *ConfigHistoryMutation) {
+		m.oldValue = func(context.Context) (*ConfigHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ConfigHistoryMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ConfigHistoryMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ConfigHistoryMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *ConfigHistoryMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *ConfigHistoryMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *ConfigHistoryMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetOldValue sets the "old_value" field.
+func (m *ConfigHistoryMutation) SetOldValue(s string) {
+	m.old_value = &s
+}
+
+// OldValue returns the value of the "old_value" field in the mutation.
+func (m *ConfigHistoryMutation) OldValue() (r string, exists bool) {
+	v := m.old_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldValue returns the old "old_value" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldOldValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldValue: %w", err)
+	}
+	return oldValue.OldValue, nil
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (m *ConfigHistoryMutation) ClearOldValue() {
+	m.old_value = nil
+	m.clearedFields[confighistory.FieldOldValue] = struct{}{}
+}
+
+// OldValueCleared returns if the "old_value" field was cleared in this mutation.
+func (m *ConfigHistoryMutation) OldValueCleared() bool {
+	_, ok := m.clearedFields[confighistory.FieldOldValue]
+	return ok
+}
+
+// ResetOldValue resets all changes to the "old_value" field.
+func (m *ConfigHistoryMutation) ResetOldValue() {
+	m.old_value = nil
+	delete(m.clearedFields, confighistory.FieldOldValue)
+}
+
+// SetNewValue sets the "new_value" field.
+func (m *ConfigHistoryMutation) SetNewValue(s string) {
+	m.new_value = &s
+}
+
+// NewValue returns the value of the "new_value" field in the mutation.
+func (m *ConfigHistoryMutation) NewValue() (r string, exists bool) {
+	v := m.new_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewValue returns the old "new_value" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldNewValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewValue: %w", err)
+	}
+	return oldValue.NewValue, nil
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (m *ConfigHistoryMutation) ClearNewValue() {
+	m.new_value = nil
+	m.clearedFields[confighistory.FieldNewValue] = struct{}{}
+}
+
+// NewValueCleared returns if the "new_value" field was cleared in this mutation.
+func (m *ConfigHistoryMutation) NewValueCleared() bool {
+	_, ok := m.clearedFields[confighistory.FieldNewValue]
+	return ok
+}
+
+// ResetNewValue resets all changes to the "new_value" field.
+func (m *ConfigHistoryMutation) ResetNewValue() {
+	m.new_value = nil
+	delete(m.clearedFields, confighistory.FieldNewValue)
+}
+
+// SetAction sets the "action" field.
+func (m *ConfigHistoryMutation) SetAction(c confighistory.Action) {
+	m.action = &c
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ConfigHistoryMutation) Action() (r confighistory.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldAction(ctx context.Context) (v confighistory.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ConfigHistoryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *ConfigHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *ConfigHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *ConfigHistoryMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetRequestID sets the "request_id" field.
+func (m *ConfigHistoryMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *ConfigHistoryMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestID returns the old "request_id" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (m *ConfigHistoryMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[confighistory.FieldRequestID] = struct{}{}
+}
+
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *ConfigHistoryMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[confighistory.FieldRequestID]
+	return ok
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *ConfigHistoryMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, confighistory.FieldRequestID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConfigHistory entity.
+// If the ConfigHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the ConfigHistoryMutation builder.
+func (m *ConfigHistoryMutation) Where(ps ...predicate.ConfigHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigHistory).
+func (m *ConfigHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.key != nil {
+		fields = append(fields, confighistory.FieldKey)
+	}
+	if m.scope != nil {
+		fields = append(fields, confighistory.FieldScope)
+	}
+	if m.old_value != nil {
+		fields = append(fields, confighistory.FieldOldValue)
+	}
+	if m.new_value != nil {
+		fields = append(fields, confighistory.FieldNewValue)
+	}
+	if m.action != nil {
+		fields = append(fields, confighistory.FieldAction)
+	}
+	if m.actor != nil {
+		fields = append(fields, confighistory.FieldActor)
+	}
+	if m.request_id != nil {
+		fields = append(fields, confighistory.FieldRequestID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, confighistory.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case confighistory.FieldKey:
+		return m.Key()
+	case confighistory.FieldScope:
+		return m.Scope()
+	case confighistory.FieldOldValue:
+		return m.OldValue()
+	case confighistory.FieldNewValue:
+		return m.NewValue()
+	case confighistory.FieldAction:
+		return m.Action()
+	case confighistory.FieldActor:
+		return m.Actor()
+	case confighistory.FieldRequestID:
+		return m.RequestID()
+	case confighistory.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case confighistory.FieldKey:
+		return m.OldKey(ctx)
+	case confighistory.FieldScope:
+		return m.OldScope(ctx)
+	case confighistory.FieldOldValue:
+		return m.OldOldValue(ctx)
+	case confighistory.FieldNewValue:
+		return m.OldNewValue(ctx)
+	case confighistory.FieldAction:
+		return m.OldAction(ctx)
+	case confighistory.FieldActor:
+		return m.OldActor(ctx)
+	case confighistory.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case confighistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case confighistory.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case confighistory.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case confighistory.FieldOldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldValue(v)
+		return nil
+	case confighistory.FieldNewValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewValue(v)
+		return nil
+	case confighistory.FieldAction:
+		v, ok := value.(confighistory.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case confighistory.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case confighistory.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case confighistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigHistoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConfigHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(confighistory.FieldOldValue) {
+		fields = append(fields, confighistory.FieldOldValue)
+	}
+	if m.FieldCleared(confighistory.FieldNewValue) {
+		fields = append(fields, confighistory.FieldNewValue)
+	}
+	if m.FieldCleared(confighistory.FieldRequestID) {
+		fields = append(fields, confighistory.FieldRequestID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigHistoryMutation) ClearField(name string) error {
+	switch name {
+	case confighistory.FieldOldValue:
+		m.ClearOldValue()
+		return nil
+	case confighistory.FieldNewValue:
+		m.ClearNewValue()
+		return nil
+	case confighistory.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigHistoryMutation) ResetField(name string) error {
+	switch name {
+	case confighistory.FieldKey:
+		m.ResetKey()
+		return nil
+	case confighistory.FieldScope:
+		m.ResetScope()
+		return nil
+	case confighistory.FieldOldValue:
+		m.ResetOldValue()
+		return nil
+	case confighistory.FieldNewValue:
+		m.ResetNewValue()
+		return nil
+	case confighistory.FieldAction:
+		m.ResetAction()
+		return nil
+	case confighistory.FieldActor:
+		m.ResetActor()
+		return nil
+	case confighistory.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case confighistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigHistory edge %s", name)
+}
+
+// ConfigScheduleMutation represents an operation that mutates the ConfigSchedule nodes in the graph.
+type ConfigScheduleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	key                 *string
+	scope               *string
+	value               *string
+	prior_value         *string
+	applied_revision    *int
+	addapplied_revision *int
+	effective_at        *time.Time
+	expires_at          *time.Time
+	status              *configschedule.Status
+	message             *string
+	actor               *string
+	request_id          *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*ConfigSchedule, error)
+	predicates          []predicate.ConfigSchedule
+}
+
+var _ ent.Mutation = (*ConfigScheduleMutation)(nil)
+
+// configscheduleOption allows management of the mutation configuration using functional options.
+type configscheduleOption func(*ConfigScheduleMutation)
+
+// newConfigScheduleMutation creates new mutation for the ConfigSchedule entity.
+func newConfigScheduleMutation(c config, op Op, opts ...configscheduleOption) *ConfigScheduleMutation {
+	m := &ConfigScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigScheduleID sets the ID field of the mutation.
+func withConfigScheduleID(id int) configscheduleOption {
+	return func(m *ConfigScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigSchedule sets the old ConfigSchedule of the mutation.
+func withConfigSchedule(node *ConfigSchedule) configscheduleOption {
+	return func(m *ConfigScheduleMutation) {
+		m.oldValue = func(context.Context) (*ConfigSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigScheduleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigScheduleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ConfigScheduleMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ConfigScheduleMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ConfigScheduleMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *ConfigScheduleMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *ConfigScheduleMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *ConfigScheduleMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ConfigScheduleMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ConfigScheduleMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ConfigScheduleMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetPriorValue sets the "prior_value" field.
+func (m *ConfigScheduleMutation) SetPriorValue(s string) {
+	m.prior_value = &s
+}
+
+// PriorValue returns the value of the "prior_value" field in the mutation.
+func (m *ConfigScheduleMutation) PriorValue() (r string, exists bool) {
+	v := m.prior_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriorValue returns the old "prior_value" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldPriorValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriorValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriorValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriorValue: %w", err)
+	}
+	return oldValue.PriorValue, nil
+}
+
+// ClearPriorValue clears the value of the "prior_value" field.
+func (m *ConfigScheduleMutation) ClearPriorValue() {
+	m.prior_value = nil
+	m.clearedFields[configschedule.FieldPriorValue] = struct{}{}
+}
+
+// PriorValueCleared returns if the "prior_value" field was cleared in this mutation.
+func (m *ConfigScheduleMutation) PriorValueCleared() bool {
+	_, ok := m.clearedFields[configschedule.FieldPriorValue]
+	return ok
+}
+
+// ResetPriorValue resets all changes to the "prior_value" field.
+func (m *ConfigScheduleMutation) ResetPriorValue() {
+	m.prior_value = nil
+	delete(m.clearedFields, configschedule.FieldPriorValue)
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (m *ConfigScheduleMutation) SetAppliedRevision(i int) {
+	m.applied_revision = &i
+	m.addapplied_revision = nil
+}
+
+// AppliedRevision returns the value of the "applied_revision" field in the mutation.
+func (m *ConfigScheduleMutation) AppliedRevision() (r int, exists bool) {
+	v := m.applied_revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppliedRevision returns the old "applied_revision" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldAppliedRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppliedRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppliedRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppliedRevision: %w", err)
+	}
+	return oldValue.AppliedRevision, nil
+}
+
+// AddAppliedRevision adds i to the "applied_revision" field.
+func (m *ConfigScheduleMutation) AddAppliedRevision(i int) {
+	if m.addapplied_revision != nil {
+		*m.addapplied_revision += i
+	} else {
+		m.addapplied_revision = &i
+	}
+}
+
+// AddedAppliedRevision returns the value that was added to the "applied_revision" field in this mutation.
+func (m *ConfigScheduleMutation) AddedAppliedRevision() (r int, exists bool) {
+	v := m.addapplied_revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAppliedRevision resets all changes to the "applied_revision" field.
+func (m *ConfigScheduleMutation) ResetAppliedRevision() {
+	m.applied_revision = nil
+	m.addapplied_revision = nil
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (m *ConfigScheduleMutation) SetEffectiveAt(t time.Time) {
+	m.effective_at = &t
+}
+
+// EffectiveAt returns the value of the "effective_at" field in the mutation.
+func (m *ConfigScheduleMutation) EffectiveAt() (r time.Time, exists bool) {
+	v := m.effective_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEffectiveAt returns the old "effective_at" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldEffectiveAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEffectiveAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEffectiveAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEffectiveAt: %w", err)
+	}
+	return oldValue.EffectiveAt, nil
+}
+
+// ResetEffectiveAt resets all changes to the "effective_at" field.
+func (m *ConfigScheduleMutation) ResetEffectiveAt() {
+	m.effective_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ConfigScheduleMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ConfigScheduleMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ConfigScheduleMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[configschedule.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ConfigScheduleMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[configschedule.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ConfigScheduleMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, configschedule.FieldExpiresAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *ConfigScheduleMutation) SetStatus(c configschedule.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ConfigScheduleMutation) Status() (r configschedule.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldStatus(ctx context.Context) (v configschedule.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ConfigScheduleMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *ConfigScheduleMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ConfigScheduleMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ConfigScheduleMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[configschedule.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ConfigScheduleMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[configschedule.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ConfigScheduleMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, configschedule.FieldMessage)
+}
+
+// SetActor sets the "actor" field.
+func (m *ConfigScheduleMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *ConfigScheduleMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *ConfigScheduleMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetRequestID sets the "request_id" field.
+func (m *ConfigScheduleMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *ConfigScheduleMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestID returns the old "request_id" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (m *ConfigScheduleMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[configschedule.FieldRequestID] = struct{}{}
+}
+
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *ConfigScheduleMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[configschedule.FieldRequestID]
+	return ok
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *ConfigScheduleMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, configschedule.FieldRequestID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigScheduleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigScheduleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigSchedule entity.
+// If the ConfigSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigScheduleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ConfigScheduleMutation builder.
+func (m *ConfigScheduleMutation) Where(ps ...predicate.ConfigSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigSchedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigSchedule).
+func (m *ConfigScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.key != nil {
+		fields = append(fields, configschedule.FieldKey)
+	}
+	if m.scope != nil {
+		fields = append(fields, configschedule.FieldScope)
+	}
+	if m.value != nil {
+		fields = append(fields, configschedule.FieldValue)
+	}
+	if m.prior_value != nil {
+		fields = append(fields, configschedule.FieldPriorValue)
+	}
+	if m.applied_revision != nil {
+		fields = append(fields, configschedule.FieldAppliedRevision)
+	}
+	if m.effective_at != nil {
+		fields = append(fields, configschedule.FieldEffectiveAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, configschedule.FieldExpiresAt)
+	}
+	if m.status != nil {
+		fields = append(fields, configschedule.FieldStatus)
+	}
+	if m.message != nil {
+		fields = append(fields, configschedule.FieldMessage)
+	}
+	if m.actor != nil {
+		fields = append(fields, configschedule.FieldActor)
+	}
+	if m.request_id != nil {
+		fields = append(fields, configschedule.FieldRequestID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, configschedule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, configschedule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case configschedule.FieldKey:
+		return m.Key()
+	case configschedule.FieldScope:
+		return m.Scope()
+	case configschedule.FieldValue:
+		return m.Value()
+	case configschedule.FieldPriorValue:
+		return m.PriorValue()
+	case configschedule.FieldAppliedRevision:
+		return m.AppliedRevision()
+	case configschedule.FieldEffectiveAt:
+		return m.EffectiveAt()
+	case configschedule.FieldExpiresAt:
+		return m.ExpiresAt()
+	case configschedule.FieldStatus:
+		return m.Status()
+	case configschedule.FieldMessage:
+		return m.Message()
+	case configschedule.FieldActor:
+		return m.Actor()
+	case configschedule.FieldRequestID:
+		return m.RequestID()
+	case configschedule.FieldCreatedAt:
+		return m.CreatedAt()
+	case configschedule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case configschedule.FieldKey:
+		return m.OldKey(ctx)
+	case configschedule.FieldScope:
+		return m.OldScope(ctx)
+	case configschedule.FieldValue:
+		return m.OldValue(ctx)
+	case configschedule.FieldPriorValue:
+		return m.OldPriorValue(ctx)
+	case configschedule.FieldAppliedRevision:
+		return m.OldAppliedRevision(ctx)
+	case configschedule.FieldEffectiveAt:
+		return m.OldEffectiveAt(ctx)
+	case configschedule.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case configschedule.FieldStatus:
+		return m.OldStatus(ctx)
+	case configschedule.FieldMessage:
+		return m.OldMessage(ctx)
+	case configschedule.FieldActor:
+		return m.OldActor(ctx)
+	case configschedule.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case configschedule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case configschedule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case configschedule.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case configschedule.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case configschedule.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case configschedule.FieldPriorValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriorValue(v)
+		return nil
+	case configschedule.FieldAppliedRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedRevision(v)
+		return nil
+	case configschedule.FieldEffectiveAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffectiveAt(v)
+		return nil
+	case configschedule.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case configschedule.FieldStatus:
+		v, ok := value.(configschedule.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case configschedule.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case configschedule.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case configschedule.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case configschedule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case configschedule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addapplied_revision != nil {
+		fields = append(fields, configschedule.FieldAppliedRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case configschedule.FieldAppliedRevision:
+		return m.AddedAppliedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case configschedule.FieldAppliedRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAppliedRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(configschedule.FieldPriorValue) {
+		fields = append(fields, configschedule.FieldPriorValue)
+	}
+	if m.FieldCleared(configschedule.FieldExpiresAt) {
+		fields = append(fields, configschedule.FieldExpiresAt)
+	}
+	if m.FieldCleared(configschedule.FieldMessage) {
+		fields = append(fields, configschedule.FieldMessage)
+	}
+	if m.FieldCleared(configschedule.FieldRequestID) {
+		fields = append(fields, configschedule.FieldRequestID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigScheduleMutation) ClearField(name string) error {
+	switch name {
+	case configschedule.FieldPriorValue:
+		m.ClearPriorValue()
+		return nil
+	case configschedule.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case configschedule.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case configschedule.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigScheduleMutation) ResetField(name string) error {
+	switch name {
+	case configschedule.FieldKey:
+		m.ResetKey()
+		return nil
+	case configschedule.FieldScope:
+		m.ResetScope()
+		return nil
+	case configschedule.FieldValue:
+		m.ResetValue()
+		return nil
+	case configschedule.FieldPriorValue:
+		m.ResetPriorValue()
+		return nil
+	case configschedule.FieldAppliedRevision:
+		m.ResetAppliedRevision()
+		return nil
+	case configschedule.FieldEffectiveAt:
+		m.ResetEffectiveAt()
+		return nil
+	case configschedule.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case configschedule.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case configschedule.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case configschedule.FieldActor:
+		m.ResetActor()
+		return nil
+	case configschedule.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case configschedule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case configschedule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigScheduleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigScheduleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigScheduleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigScheduleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigScheduleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigSchedule edge %s", name)
+}
+
+// ConfigitemMutation represents an operation that mutates the Configitem nodes in the graph.
+type ConfigitemMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	scope         *string
+	value         *string
+	is_dynamic    *bool
+	revision      *int
+	addrevision   *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Configitem, error)
+	predicates    []predicate.Configitem
+}
+
+var _ ent.Mutation = (*ConfigitemMutation)(nil)
+
+// configitemOption allows management of the mutation configuration using functional options.
+type configitemOption func(*ConfigitemMutation)
+
+// newConfigitemMutation creates new mutation for the Configitem entity.
+func newConfigitemMutation(c config, op Op, opts ...configitemOption) *ConfigitemMutation {
+	m := &ConfigitemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigitem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigitemID sets the ID field of the mutation.
+func withConfigitemID(id int) configitemOption {
+	return func(m *ConfigitemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Configitem
+		)
+		m.oldValue = func(ctx context.Context) (*Configitem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Configitem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigitem sets the old Configitem of the mutation.
+func withConfigitem(node *Configitem) configitemOption {
+	return func(m *ConfigitemMutation) {
+		m.oldValue = func(context.Context) (*Configitem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigitemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigitemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigitemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigitemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Configitem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ConfigitemMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ConfigitemMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Configitem entity.
+// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigitemMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ConfigitemMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *ConfigitemMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *ConfigitemMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the Configitem entity.
+// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigitemMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *ConfigitemMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ConfigitemMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ConfigitemMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Configitem entity.
+// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigitemMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ConfigitemMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetIsDynamic sets the "is_dynamic" field.
+func (m *ConfigitemMutation) SetIsDynamic(b bool) {
+	m.is_dynamic = &b
+}
+
+// IsDynamic returns the value of the "is_dynamic" field in the mutation.
+func (m *ConfigitemMutation) IsDynamic() (r bool, exists bool) {
+	v := m.is_dynamic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDynamic returns the old "is_dynamic" field's value of the Configitem entity.
+// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigitemMutation) OldIsDynamic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDynamic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDynamic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDynamic: %w", err)
+	}
+	return oldValue.IsDynamic, nil
+}
+
+// ResetIsDynamic resets all changes to the "is_dynamic" field.
+func (m *ConfigitemMutation) ResetIsDynamic() {
+	m.is_dynamic = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *ConfigitemMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *ConfigitemMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the Configitem entity.
+// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigitemMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *ConfigitemMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *ConfigitemMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *ConfigitemMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// Where appends a list predicates to the ConfigitemMutation builder.
+func (m *ConfigitemMutation) Where(ps ...predicate.Configitem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigitemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigitemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Configitem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigitemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigitemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Configitem).
+func (m *ConfigitemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigitemMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.key != nil {
+		fields = append(fields, configitem.FieldKey)
+	}
+	if m.scope != nil {
+		fields = append(fields, configitem.FieldScope)
+	}
+	if m.value != nil {
+		fields = append(fields, configitem.FieldValue)
+	}
+	if m.is_dynamic != nil {
+		fields = append(fields, configitem.FieldIsDynamic)
+	}
+	if m.revision != nil {
+		fields = append(fields, configitem.FieldRevision)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigitemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case configitem.FieldKey:
+		return m.Key()
+	case configitem.FieldScope:
+		return m.Scope()
+	case configitem.FieldValue:
+		return m.Value()
+	case configitem.FieldIsDynamic:
+		return m.IsDynamic()
+	case configitem.FieldRevision:
+		return m.Revision()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigitemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case configitem.FieldKey:
+		return m.OldKey(ctx)
+	case configitem.FieldScope:
+		return m.OldScope(ctx)
+	case configitem.FieldValue:
+		return m.OldValue(ctx)
+	case configitem.FieldIsDynamic:
+		return m.OldIsDynamic(ctx)
+	case configitem.FieldRevision:
+		return m.OldRevision(ctx)
+	}
+	return nil, fmt.Errorf("unknown Configitem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigitemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case configitem.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case configitem.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case configitem.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case configitem.FieldIsDynamic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDynamic(v)
+		return nil
+	case configitem.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Configitem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigitemMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, configitem.FieldRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigitemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case configitem.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigitemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case configitem.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Configitem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigitemMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigitemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigitemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Configitem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigitemMutation) ResetField(name string) error {
+	switch name {
+	case configitem.FieldKey:
+		m.ResetKey()
+		return nil
+	case configitem.FieldScope:
+		m.ResetScope()
+		return nil
+	case configitem.FieldValue:
+		m.ResetValue()
+		return nil
+	case configitem.FieldIsDynamic:
+		m.ResetIsDynamic()
+		return nil
+	case configitem.FieldRevision:
+		m.ResetRevision()
+		return nil
+	}
+	return fmt.Errorf("unknown Configitem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigitemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigitemMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigitemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigitemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigitemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigitemMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigitemMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Configitem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigitemMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Configitem edge %s", name)
+}
+
+// FeatureFlagMutation represents an operation that mutates the FeatureFlag nodes in the graph.
+type FeatureFlagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	definition    *string
+	revision      *int
+	addrevision   *int
+	updated_at    *time.Time
+	updated_by    *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FeatureFlag, error)
+	predicates    []predicate.FeatureFlag
+}
+
+var _ ent.Mutation = (*FeatureFlagMutation)(nil)
+
+// featureflagOption allows management of the mutation configuration using functional options.
+type featureflagOption func(*FeatureFlagMutation)
+
+// newFeatureFlagMutation creates new mutation for the FeatureFlag entity.
+func newFeatureFlagMutation(c config, op Op, opts ...featureflagOption) *FeatureFlagMutation {
+	m := &FeatureFlagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFeatureFlag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFeatureFlagID sets the ID field of the mutation.
+func withFeatureFlagID(id int) featureflagOption {
+	return func(m *FeatureFlagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FeatureFlag
+		)
+		m.oldValue = func(ctx context.Context) (*FeatureFlag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FeatureFlag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFeatureFlag sets the old FeatureFlag of the mutation.
+func withFeatureFlag(node *FeatureFlag) featureflagOption {
+	return func(m *FeatureFlagMutation) {
+		m.oldValue = func(context.Context) (*FeatureFlag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FeatureFlagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FeatureFlagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FeatureFlagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FeatureFlagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FeatureFlag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *FeatureFlagMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *FeatureFlagMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the FeatureFlag entity.
+// If the FeatureFlag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *FeatureFlagMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetDefinition sets the "definition" field.
+func (m *FeatureFlagMutation) SetDefinition(s string) {
+	m.definition = &s
+}
+
+// Definition returns the value of the "definition" field in the mutation.
+func (m *FeatureFlagMutation) Definition() (r string, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinition returns the old "definition" field's value of the FeatureFlag entity.
+// If the FeatureFlag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagMutation) OldDefinition(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinition: %w", err)
+	}
+	return oldValue.Definition, nil
+}
+
+// ResetDefinition resets all changes to the "definition" field.
+func (m *FeatureFlagMutation) ResetDefinition() {
+	m.definition = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *FeatureFlagMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *FeatureFlagMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the FeatureFlag entity.
+// If the FeatureFlag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *FeatureFlagMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *FeatureFlagMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *FeatureFlagMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FeatureFlagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FeatureFlagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FeatureFlag entity.
+// If the FeatureFlag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FeatureFlagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *FeatureFlagMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *FeatureFlagMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the FeatureFlag entity.
+// If the FeatureFlag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *FeatureFlagMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+}
+
+// Where appends a list predicates to the FeatureFlagMutation builder.
+func (m *FeatureFlagMutation) Where(ps ...predicate.FeatureFlag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FeatureFlagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FeatureFlagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FeatureFlag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FeatureFlagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FeatureFlagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FeatureFlag).
+func (m *FeatureFlagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FeatureFlagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.key != nil {
+		fields = append(fields, featureflag.FieldKey)
+	}
+	if m.definition != nil {
+		fields = append(fields, featureflag.FieldDefinition)
+	}
+	if m.revision != nil {
+		fields = append(fields, featureflag.FieldRevision)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, featureflag.FieldUpdatedAt)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, featureflag.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FeatureFlagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case featureflag.FieldKey:
+		return m.Key()
+	case featureflag.FieldDefinition:
+		return m.Definition()
+	case featureflag.FieldRevision:
+		return m.Revision()
+	case featureflag.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case featureflag.FieldUpdatedBy:
+		return m.UpdatedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FeatureFlagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case featureflag.FieldKey:
+		return m.OldKey(ctx)
+	case featureflag.FieldDefinition:
+		return m.OldDefinition(ctx)
+	case featureflag.FieldRevision:
+		return m.OldRevision(ctx)
+	case featureflag.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case featureflag.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown FeatureFlag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeatureFlagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case featureflag.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case featureflag.FieldDefinition:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinition(v)
+		return nil
+	case featureflag.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case featureflag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case featureflag.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FeatureFlag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FeatureFlagMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, featureflag.FieldRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FeatureFlagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case featureflag.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeatureFlagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case featureflag.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FeatureFlag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FeatureFlagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FeatureFlagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FeatureFlagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FeatureFlag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FeatureFlagMutation) ResetField(name string) error {
+	switch name {
+	case featureflag.FieldKey:
+		m.ResetKey()
+		return nil
+	case featureflag.FieldDefinition:
+		m.ResetDefinition()
+		return nil
+	case featureflag.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case featureflag.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case featureflag.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown FeatureFlag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FeatureFlagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FeatureFlagMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FeatureFlagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FeatureFlagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FeatureFlagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FeatureFlagMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FeatureFlagMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FeatureFlag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FeatureFlagMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FeatureFlag edge %s", name)
+}
+
+// FeatureFlagHistoryMutation represents an operation that mutates the FeatureFlagHistory nodes in the graph.
+type FeatureFlagHistoryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	key            *string
+	old_definition *string
+	new_definition *string
+	action         *featureflaghistory.Action
+	actor          *string
+	request_id     *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*FeatureFlagHistory, error)
+	predicates     []predicate.FeatureFlagHistory
 }
 
-var _ ent.Mutation = (*ConfigitemMutation)(nil)
+var _ ent.Mutation = (*FeatureFlagHistoryMutation)(nil)
 
-// configitemOption allows management of the mutation configuration using functional options.
-type configitemOption func(*ConfigitemMutation)
+// featureflaghistoryOption allows management of the mutation configuration using functional options.
+type featureflaghistoryOption func(*FeatureFlagHistoryMutation)
 
-// newConfigitemMutation creates new mutation for the Configitem entity.
-func newConfigitemMutation(c config, op Op, opts ...configitemOption) *ConfigitemMutation {
-	m := &ConfigitemMutation{
+// newFeatureFlagHistoryMutation creates new mutation for the FeatureFlagHistory entity.
+func newFeatureFlagHistoryMutation(c config, op Op, opts ...featureflaghistoryOption) *FeatureFlagHistoryMutation {
+	m := &FeatureFlagHistoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConfigitem,
+		typ:           TypeFeatureFlagHistory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -64,20 +4087,20 @@ func newConfigitemMutation(c config, op Op, opts ...configitemOption) *Configite
 	return m
 }
 
-// withConfigitemID sets the ID field of the mutation.
-func withConfigitemID(id int) configitemOption {
-	return func(m *ConfigitemMutation) {
+// withFeatureFlagHistoryID sets the ID field of the mutation.
+func withFeatureFlagHistoryID(id int) featureflaghistoryOption {
+	return func(m *FeatureFlagHistoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Configitem
+			value *FeatureFlagHistory
 		)
-		m.oldValue = func(ctx context.Context) (*Configitem, error) {
+		m.oldValue = func(ctx context.Context) (*FeatureFlagHistory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Configitem.Get(ctx, id)
+					value, err = m.Client().FeatureFlagHistory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -86,10 +4109,10 @@ func withConfigitemID(id int) configitemOption {
 	}
 }
 
-// withConfigitem sets the old Configitem of the mutation.
-func withConfigitem(node *Configitem) configitemOption {
-	return func(m *ConfigitemMutation) {
-		m.oldValue = func(context.Context) (*Configitem, error) {
+// withFeatureFlagHistory sets the old FeatureFlagHistory of the mutation.
+func withFeatureFlagHistory(node *FeatureFlagHistory) featureflaghistoryOption {
+	return func(m *FeatureFlagHistoryMutation) {
+		m.oldValue = func(context.Context) (*FeatureFlagHistory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -98,7 +4121,7 @@ func withConfigitem(node *Configitem) configitemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConfigitemMutation) Client() *Client {
+func (m FeatureFlagHistoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -106,7 +4129,7 @@ func (m ConfigitemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConfigitemMutation) Tx() (*Tx, error) {
+func (m FeatureFlagHistoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -117,7 +4140,7 @@ func (m ConfigitemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConfigitemMutation) ID() (id int, exists bool) {
+func (m *FeatureFlagHistoryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -128,7 +4151,7 @@ func (m *ConfigitemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConfigitemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FeatureFlagHistoryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -137,19 +4160,19 @@ func (m *ConfigitemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Configitem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FeatureFlagHistory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetKey sets the "key" field.
-func (m *ConfigitemMutation) SetKey(s string) {
+func (m *FeatureFlagHistoryMutation) SetKey(s string) {
 	m.key = &s
 }
 
 // Key returns the value of the "key" field in the mutation.
-func (m *ConfigitemMutation) Key() (r string, exists bool) {
+func (m *FeatureFlagHistoryMutation) Key() (r string, exists bool) {
 	v := m.key
 	if v == nil {
 		return
@@ -157,10 +4180,10 @@ func (m *ConfigitemMutation) Key() (r string, exists bool) {
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Configitem entity.
-// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the FeatureFlagHistory entity.
+// If the FeatureFlagHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigitemMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *FeatureFlagHistoryMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
@@ -175,91 +4198,274 @@ func (m *ConfigitemMutation) OldKey(ctx context.Context) (v string, err error) {
 }
 
 // ResetKey resets all changes to the "key" field.
-func (m *ConfigitemMutation) ResetKey() {
+func (m *FeatureFlagHistoryMutation) ResetKey() {
 	m.key = nil
 }
 
-// SetValue sets the "value" field.
-func (m *ConfigitemMutation) SetValue(s string) {
-	m.value = &s
+// SetOldDefinition sets the "old_definition" field.
+func (m *FeatureFlagHistoryMutation) SetOldDefinition(s string) {
+	m.old_definition = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ConfigitemMutation) Value() (r string, exists bool) {
-	v := m.value
+// OldDefinition returns the value of the "old_definition" field in the mutation.
+func (m *FeatureFlagHistoryMutation) OldDefinition() (r string, exists bool) {
+	v := m.old_definition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Configitem entity.
-// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// OldOldDefinition returns the old "old_definition" field's value of the FeatureFlagHistory entity.
+// If the FeatureFlagHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigitemMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *FeatureFlagHistoryMutation) OldOldDefinition(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldOldDefinition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldOldDefinition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldOldDefinition: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.OldDefinition, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *ConfigitemMutation) ResetValue() {
-	m.value = nil
+// ClearOldDefinition clears the value of the "old_definition" field.
+func (m *FeatureFlagHistoryMutation) ClearOldDefinition() {
+	m.old_definition = nil
+	m.clearedFields[featureflaghistory.FieldOldDefinition] = struct{}{}
 }
 
-// SetIsDynamic sets the "is_dynamic" field.
-func (m *ConfigitemMutation) SetIsDynamic(b bool) {
-	m.is_dynamic = &b
+// OldDefinitionCleared returns if the "old_definition" field was cleared in this mutation.
+func (m *FeatureFlagHistoryMutation) OldDefinitionCleared() bool {
+	_, ok := m.clearedFields[featureflaghistory.FieldOldDefinition]
+	return ok
 }
 
-// IsDynamic returns the value of the "is_dynamic" field in the mutation.
-func (m *ConfigitemMutation) IsDynamic() (r bool, exists bool) {
-	v := m.is_dynamic
+// ResetOldDefinition resets all changes to the "old_definition" field.
+func (m *FeatureFlagHistoryMutation) ResetOldDefinition() {
+	m.old_definition = nil
+	delete(m.clearedFields, featureflaghistory.FieldOldDefinition)
+}
+
+// SetNewDefinition sets the "new_definition" field.
+func (m *FeatureFlagHistoryMutation) SetNewDefinition(s string) {
+	m.new_definition = &s
+}
+
+// NewDefinition returns the value of the "new_definition" field in the mutation.
+func (m *FeatureFlagHistoryMutation) NewDefinition() (r string, exists bool) {
+	v := m.new_definition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDynamic returns the old "is_dynamic" field's value of the Configitem entity.
-// If the Configitem object wasn't provided to the builder, the object is fetched from the database.
+// OldNewDefinition returns the old "new_definition" field's value of the FeatureFlagHistory entity.
+// If the FeatureFlagHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigitemMutation) OldIsDynamic(ctx context.Context) (v bool, err error) {
+func (m *FeatureFlagHistoryMutation) OldNewDefinition(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDynamic is only allowed on UpdateOne operations")
+		return v, errors.New("OldNewDefinition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDynamic requires an ID field in the mutation")
+		return v, errors.New("OldNewDefinition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDynamic: %w", err)
+		return v, fmt.Errorf("querying old value for OldNewDefinition: %w", err)
 	}
-	return oldValue.IsDynamic, nil
+	return oldValue.NewDefinition, nil
 }
 
-// ResetIsDynamic resets all changes to the "is_dynamic" field.
-func (m *ConfigitemMutation) ResetIsDynamic() {
-	m.is_dynamic = nil
+// ClearNewDefinition clears the value of the "new_definition" field.
+func (m *FeatureFlagHistoryMutation) ClearNewDefinition() {
+	m.new_definition = nil
+	m.clearedFields[featureflaghistory.FieldNewDefinition] = struct{}{}
 }
 
-// Where appends a list predicates to the ConfigitemMutation builder.
-func (m *ConfigitemMutation) Where(ps ...predicate.Configitem) {
+// NewDefinitionCleared returns if the "new_definition" field was cleared in this mutation.
+func (m *FeatureFlagHistoryMutation) NewDefinitionCleared() bool {
+	_, ok := m.clearedFields[featureflaghistory.FieldNewDefinition]
+	return ok
+}
+
+// ResetNewDefinition resets all changes to the "new_definition" field.
+func (m *FeatureFlagHistoryMutation) ResetNewDefinition() {
+	m.new_definition = nil
+	delete(m.clearedFields, featureflaghistory.FieldNewDefinition)
+}
+
+// SetAction sets the "action" field.
+func (m *FeatureFlagHistoryMutation) SetAction(f featureflaghistory.Action) {
+	m.action = &f
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *FeatureFlagHistoryMutation) Action() (r featureflaghistory.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the FeatureFlagHistory entity.
+// If the FeatureFlagHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagHistoryMutation) OldAction(ctx context.Context) (v featureflaghistory.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *FeatureFlagHistoryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *FeatureFlagHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *FeatureFlagHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the FeatureFlagHistory entity.
+// If the FeatureFlagHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *FeatureFlagHistoryMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetRequestID sets the "request_id" field.
+func (m *FeatureFlagHistoryMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *FeatureFlagHistoryMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestID returns the old "request_id" field's value of the FeatureFlagHistory entity.
+// If the FeatureFlagHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagHistoryMutation) OldRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (m *FeatureFlagHistoryMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[featureflaghistory.FieldRequestID] = struct{}{}
+}
+
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *FeatureFlagHistoryMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[featureflaghistory.FieldRequestID]
+	return ok
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *FeatureFlagHistoryMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, featureflaghistory.FieldRequestID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FeatureFlagHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FeatureFlagHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FeatureFlagHistory entity.
+// If the FeatureFlagHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureFlagHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FeatureFlagHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the FeatureFlagHistoryMutation builder.
+func (m *FeatureFlagHistoryMutation) Where(ps ...predicate.FeatureFlagHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConfigitemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FeatureFlagHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConfigitemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Configitem, len(ps))
+func (m *FeatureFlagHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FeatureFlagHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -267,33 +4473,45 @@ func (m *ConfigitemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConfigitemMutation) Op() Op {
+func (m *FeatureFlagHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConfigitemMutation) SetOp(op Op) {
+func (m *FeatureFlagHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Configitem).
-func (m *ConfigitemMutation) Type() string {
+// Type returns the node type of this mutation (FeatureFlagHistory).
+func (m *FeatureFlagHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConfigitemMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *FeatureFlagHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.key != nil {
-		fields = append(fields, configitem.FieldKey)
+		fields = append(fields, featureflaghistory.FieldKey)
 	}
-	if m.value != nil {
-		fields = append(fields, configitem.FieldValue)
+	if m.old_definition != nil {
+		fields = append(fields, featureflaghistory.FieldOldDefinition)
 	}
-	if m.is_dynamic != nil {
-		fields = append(fields, configitem.FieldIsDynamic)
+	if m.new_definition != nil {
+		fields = append(fields, featureflaghistory.FieldNewDefinition)
+	}
+	if m.action != nil {
+		fields = append(fields, featureflaghistory.FieldAction)
+	}
+	if m.actor != nil {
+		fields = append(fields, featureflaghistory.FieldActor)
+	}
+	if m.request_id != nil {
+		fields = append(fields, featureflaghistory.FieldRequestID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, featureflaghistory.FieldCreatedAt)
 	}
 	return fields
 }
@@ -301,14 +4519,22 @@ func (m *ConfigitemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConfigitemMutation) Field(name string) (ent.Value, bool) {
+func (m *FeatureFlagHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case configitem.FieldKey:
+	case featureflaghistory.FieldKey:
 		return m.Key()
-	case configitem.FieldValue:
-		return m.Value()
-	case configitem.FieldIsDynamic:
-		return m.IsDynamic()
+	case featureflaghistory.FieldOldDefinition:
+		return m.OldDefinition()
+	case featureflaghistory.FieldNewDefinition:
+		return m.NewDefinition()
+	case featureflaghistory.FieldAction:
+		return m.Action()
+	case featureflaghistory.FieldActor:
+		return m.Actor()
+	case featureflaghistory.FieldRequestID:
+		return m.RequestID()
+	case featureflaghistory.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -316,152 +4542,221 @@ func (m *ConfigitemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConfigitemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FeatureFlagHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case configitem.FieldKey:
+	case featureflaghistory.FieldKey:
 		return m.OldKey(ctx)
-	case configitem.FieldValue:
-		return m.OldValue(ctx)
-	case configitem.FieldIsDynamic:
-		return m.OldIsDynamic(ctx)
+	case featureflaghistory.FieldOldDefinition:
+		return m.OldOldDefinition(ctx)
+	case featureflaghistory.FieldNewDefinition:
+		return m.OldNewDefinition(ctx)
+	case featureflaghistory.FieldAction:
+		return m.OldAction(ctx)
+	case featureflaghistory.FieldActor:
+		return m.OldActor(ctx)
+	case featureflaghistory.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case featureflaghistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Configitem field %s", name)
+	return nil, fmt.Errorf("unknown FeatureFlagHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigitemMutation) SetField(name string, value ent.Value) error {
+func (m *FeatureFlagHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case configitem.FieldKey:
+	case featureflaghistory.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetKey(v)
 		return nil
-	case configitem.FieldValue:
+	case featureflaghistory.FieldOldDefinition:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetOldDefinition(v)
 		return nil
-	case configitem.FieldIsDynamic:
-		v, ok := value.(bool)
+	case featureflaghistory.FieldNewDefinition:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDynamic(v)
+		m.SetNewDefinition(v)
+		return nil
+	case featureflaghistory.FieldAction:
+		v, ok := value.(featureflaghistory.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case featureflaghistory.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case featureflaghistory.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case featureflaghistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Configitem field %s", name)
+	return fmt.Errorf("unknown FeatureFlagHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConfigitemMutation) AddedFields() []string {
+func (m *FeatureFlagHistoryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConfigitemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FeatureFlagHistoryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigitemMutation) AddField(name string, value ent.Value) error {
+func (m *FeatureFlagHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Configitem numeric field %s", name)
+	return fmt.Errorf("unknown FeatureFlagHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConfigitemMutation) ClearedFields() []string {
-	return nil
+func (m *FeatureFlagHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(featureflaghistory.FieldOldDefinition) {
+		fields = append(fields, featureflaghistory.FieldOldDefinition)
+	}
+	if m.FieldCleared(featureflaghistory.FieldNewDefinition) {
+		fields = append(fields, featureflaghistory.FieldNewDefinition)
+	}
+	if m.FieldCleared(featureflaghistory.FieldRequestID) {
+		fields = append(fields, featureflaghistory.FieldRequestID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConfigitemMutation) FieldCleared(name string) bool {
+func (m *FeatureFlagHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConfigitemMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Configitem nullable field %s", name)
+func (m *FeatureFlagHistoryMutation) ClearField(name string) error {
+	switch name {
+	case featureflaghistory.FieldOldDefinition:
+		m.ClearOldDefinition()
+		return nil
+	case featureflaghistory.FieldNewDefinition:
+		m.ClearNewDefinition()
+		return nil
+	case featureflaghistory.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	}
+	return fmt.Errorf("unknown FeatureFlagHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConfigitemMutation) ResetField(name string) error {
+func (m *FeatureFlagHistoryMutation) ResetField(name string) error {
 	switch name {
-	case configitem.FieldKey:
+	case featureflaghistory.FieldKey:
 		m.ResetKey()
 		return nil
-	case configitem.FieldValue:
-		m.ResetValue()
+	case featureflaghistory.FieldOldDefinition:
+		m.ResetOldDefinition()
 		return nil
-	case configitem.FieldIsDynamic:
-		m.ResetIsDynamic()
+	case featureflaghistory.FieldNewDefinition:
+		m.ResetNewDefinition()
+		return nil
+	case featureflaghistory.FieldAction:
+		m.ResetAction()
+		return nil
+	case featureflaghistory.FieldActor:
+		m.ResetActor()
+		return nil
+	case featureflaghistory.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case featureflaghistory.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Configitem field %s", name)
+	return fmt.Errorf("unknown FeatureFlagHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConfigitemMutation) AddedEdges() []string {
+func (m *FeatureFlagHistoryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConfigitemMutation) AddedIDs(name string) []ent.Value {
+func (m *FeatureFlagHistoryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConfigitemMutation) RemovedEdges() []string {
+func (m *FeatureFlagHistoryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConfigitemMutation) RemovedIDs(name string) []ent.Value {
+func (m *FeatureFlagHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConfigitemMutation) ClearedEdges() []string {
+func (m *FeatureFlagHistoryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConfigitemMutation) EdgeCleared(name string) bool {
+func (m *FeatureFlagHistoryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConfigitemMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Configitem unique edge %s", name)
+func (m *FeatureFlagHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FeatureFlagHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConfigitemMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Configitem edge %s", name)
+func (m *FeatureFlagHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FeatureFlagHistory edge %s", name)
 }
 
 // ServersMutation represents an operation that mutates the Servers nodes in the graph.
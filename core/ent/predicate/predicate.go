@@ -6,9 +6,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ConfigChangeRequest is the predicate function for configchangerequest builders.
+type ConfigChangeRequest func(*sql.Selector)
+
+// ConfigHistory is the predicate function for confighistory builders.
+type ConfigHistory func(*sql.Selector)
+
+// ConfigSchedule is the predicate function for configschedule builders.
+type ConfigSchedule func(*sql.Selector)
+
 // Configitem is the predicate function for configitem builders.
 type Configitem func(*sql.Selector)
 
+// FeatureFlag is the predicate function for featureflag builders.
+type FeatureFlag func(*sql.Selector)
+
+// FeatureFlagHistory is the predicate function for featureflaghistory builders.
+type FeatureFlagHistory func(*sql.Selector)
+
 // Servers is the predicate function for servers builders.
 type Servers func(*sql.Selector)
 
@@ -3,26 +3,156 @@
 package ent
 
 import (
+	"apprun/ent/configchangerequest"
+	"apprun/ent/confighistory"
 	"apprun/ent/configitem"
+	"apprun/ent/configschedule"
+	"apprun/ent/featureflag"
+	"apprun/ent/featureflaghistory"
 	"apprun/ent/schema"
 	"apprun/ent/servers"
 	"apprun/ent/users"
+	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	configchangerequestFields := schema.ConfigChangeRequest{}.Fields()
+	_ = configchangerequestFields
+	// configchangerequestDescKey is the schema descriptor for key field.
+	configchangerequestDescKey := configchangerequestFields[0].Descriptor()
+	// configchangerequest.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	configchangerequest.KeyValidator = configchangerequestDescKey.Validators[0].(func(string) error)
+	// configchangerequestDescScope is the schema descriptor for scope field.
+	configchangerequestDescScope := configchangerequestFields[1].Descriptor()
+	// configchangerequest.DefaultScope holds the default value on creation for the scope field.
+	configchangerequest.DefaultScope = configchangerequestDescScope.Default.(string)
+	// configchangerequest.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	configchangerequest.ScopeValidator = configchangerequestDescScope.Validators[0].(func(string) error)
+	// configchangerequestDescRequestedBy is the schema descriptor for requested_by field.
+	configchangerequestDescRequestedBy := configchangerequestFields[4].Descriptor()
+	// configchangerequest.RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	configchangerequest.RequestedByValidator = configchangerequestDescRequestedBy.Validators[0].(func(string) error)
+	// configchangerequestDescCreatedAt is the schema descriptor for created_at field.
+	configchangerequestDescCreatedAt := configchangerequestFields[10].Descriptor()
+	// configchangerequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	configchangerequest.DefaultCreatedAt = configchangerequestDescCreatedAt.Default.(func() time.Time)
+	// configchangerequestDescUpdatedAt is the schema descriptor for updated_at field.
+	configchangerequestDescUpdatedAt := configchangerequestFields[11].Descriptor()
+	// configchangerequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	configchangerequest.DefaultUpdatedAt = configchangerequestDescUpdatedAt.Default.(func() time.Time)
+	// configchangerequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	configchangerequest.UpdateDefaultUpdatedAt = configchangerequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	confighistoryFields := schema.ConfigHistory{}.Fields()
+	_ = confighistoryFields
+	// confighistoryDescKey is the schema descriptor for key field.
+	confighistoryDescKey := confighistoryFields[0].Descriptor()
+	// confighistory.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	confighistory.KeyValidator = confighistoryDescKey.Validators[0].(func(string) error)
+	// confighistoryDescScope is the schema descriptor for scope field.
+	confighistoryDescScope := confighistoryFields[1].Descriptor()
+	// confighistory.DefaultScope holds the default value on creation for the scope field.
+	confighistory.DefaultScope = confighistoryDescScope.Default.(string)
+	// confighistory.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	confighistory.ScopeValidator = confighistoryDescScope.Validators[0].(func(string) error)
+	// confighistoryDescActor is the schema descriptor for actor field.
+	confighistoryDescActor := confighistoryFields[5].Descriptor()
+	// confighistory.DefaultActor holds the default value on creation for the actor field.
+	confighistory.DefaultActor = confighistoryDescActor.Default.(string)
+	// confighistoryDescCreatedAt is the schema descriptor for created_at field.
+	confighistoryDescCreatedAt := confighistoryFields[7].Descriptor()
+	// confighistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	confighistory.DefaultCreatedAt = confighistoryDescCreatedAt.Default.(func() time.Time)
+	configscheduleFields := schema.ConfigSchedule{}.Fields()
+	_ = configscheduleFields
+	// configscheduleDescKey is the schema descriptor for key field.
+	configscheduleDescKey := configscheduleFields[0].Descriptor()
+	// configschedule.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	configschedule.KeyValidator = configscheduleDescKey.Validators[0].(func(string) error)
+	// configscheduleDescScope is the schema descriptor for scope field.
+	configscheduleDescScope := configscheduleFields[1].Descriptor()
+	// configschedule.DefaultScope holds the default value on creation for the scope field.
+	configschedule.DefaultScope = configscheduleDescScope.Default.(string)
+	// configschedule.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	configschedule.ScopeValidator = configscheduleDescScope.Validators[0].(func(string) error)
+	// configscheduleDescAppliedRevision is the schema descriptor for applied_revision field.
+	configscheduleDescAppliedRevision := configscheduleFields[4].Descriptor()
+	// configschedule.DefaultAppliedRevision holds the default value on creation for the applied_revision field.
+	configschedule.DefaultAppliedRevision = configscheduleDescAppliedRevision.Default.(int)
+	// configscheduleDescActor is the schema descriptor for actor field.
+	configscheduleDescActor := configscheduleFields[9].Descriptor()
+	// configschedule.DefaultActor holds the default value on creation for the actor field.
+	configschedule.DefaultActor = configscheduleDescActor.Default.(string)
+	// configscheduleDescCreatedAt is the schema descriptor for created_at field.
+	configscheduleDescCreatedAt := configscheduleFields[11].Descriptor()
+	// configschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	configschedule.DefaultCreatedAt = configscheduleDescCreatedAt.Default.(func() time.Time)
+	// configscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	configscheduleDescUpdatedAt := configscheduleFields[12].Descriptor()
+	// configschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	configschedule.DefaultUpdatedAt = configscheduleDescUpdatedAt.Default.(func() time.Time)
+	// configschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	configschedule.UpdateDefaultUpdatedAt = configscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
 	configitemFields := schema.Configitem{}.Fields()
 	_ = configitemFields
 	// configitemDescKey is the schema descriptor for key field.
 	configitemDescKey := configitemFields[0].Descriptor()
 	// configitem.KeyValidator is a validator for the "key" field. It is called by the builders before save.
 	configitem.KeyValidator = configitemDescKey.Validators[0].(func(string) error)
+	// configitemDescScope is the schema descriptor for scope field.
+	configitemDescScope := configitemFields[1].Descriptor()
+	// configitem.DefaultScope holds the default value on creation for the scope field.
+	configitem.DefaultScope = configitemDescScope.Default.(string)
+	// configitem.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	configitem.ScopeValidator = configitemDescScope.Validators[0].(func(string) error)
 	// configitemDescIsDynamic is the schema descriptor for is_dynamic field.
-	configitemDescIsDynamic := configitemFields[2].Descriptor()
+	configitemDescIsDynamic := configitemFields[3].Descriptor()
 	// configitem.DefaultIsDynamic holds the default value on creation for the is_dynamic field.
 	configitem.DefaultIsDynamic = configitemDescIsDynamic.Default.(bool)
+	// configitemDescRevision is the schema descriptor for revision field.
+	configitemDescRevision := configitemFields[4].Descriptor()
+	// configitem.DefaultRevision holds the default value on creation for the revision field.
+	configitem.DefaultRevision = configitemDescRevision.Default.(int)
+	// configitem.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	configitem.RevisionValidator = configitemDescRevision.Validators[0].(func(int) error)
+	featureflagFields := schema.FeatureFlag{}.Fields()
+	_ = featureflagFields
+	// featureflagDescKey is the schema descriptor for key field.
+	featureflagDescKey := featureflagFields[0].Descriptor()
+	// featureflag.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	featureflag.KeyValidator = featureflagDescKey.Validators[0].(func(string) error)
+	// featureflagDescRevision is the schema descriptor for revision field.
+	featureflagDescRevision := featureflagFields[2].Descriptor()
+	// featureflag.DefaultRevision holds the default value on creation for the revision field.
+	featureflag.DefaultRevision = featureflagDescRevision.Default.(int)
+	// featureflag.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	featureflag.RevisionValidator = featureflagDescRevision.Validators[0].(func(int) error)
+	// featureflagDescUpdatedAt is the schema descriptor for updated_at field.
+	featureflagDescUpdatedAt := featureflagFields[3].Descriptor()
+	// featureflag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	featureflag.DefaultUpdatedAt = featureflagDescUpdatedAt.Default.(func() time.Time)
+	// featureflag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	featureflag.UpdateDefaultUpdatedAt = featureflagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// featureflagDescUpdatedBy is the schema descriptor for updated_by field.
+	featureflagDescUpdatedBy := featureflagFields[4].Descriptor()
+	// featureflag.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	featureflag.DefaultUpdatedBy = featureflagDescUpdatedBy.Default.(string)
+	featureflaghistoryFields := schema.FeatureFlagHistory{}.Fields()
+	_ = featureflaghistoryFields
+	// featureflaghistoryDescKey is the schema descriptor for key field.
+	featureflaghistoryDescKey := featureflaghistoryFields[0].Descriptor()
+	// featureflaghistory.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	featureflaghistory.KeyValidator = featureflaghistoryDescKey.Validators[0].(func(string) error)
+	// featureflaghistoryDescActor is the schema descriptor for actor field.
+	featureflaghistoryDescActor := featureflaghistoryFields[4].Descriptor()
+	// featureflaghistory.DefaultActor holds the default value on creation for the actor field.
+	featureflaghistory.DefaultActor = featureflaghistoryDescActor.Default.(string)
+	// featureflaghistoryDescCreatedAt is the schema descriptor for created_at field.
+	featureflaghistoryDescCreatedAt := featureflaghistoryFields[6].Descriptor()
+	// featureflaghistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	featureflaghistory.DefaultCreatedAt = featureflaghistoryDescCreatedAt.Default.(func() time.Time)
 	serversFields := schema.Servers{}.Fields()
 	_ = serversFields
 	// serversDescName is the schema descriptor for name field.
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConfigChangeRequest holds the schema definition for the ConfigChangeRequest entity.
+// Each row is an update of a key tagged approval:"required" that waits for a
+// second operator to approve or reject it before it is applied.
+type ConfigChangeRequest struct {
+	ent.Schema
+}
+
+// Fields of the ConfigChangeRequest.
+func (ConfigChangeRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Comment("配置项的键，如 poc.database"),
+		field.String("scope").
+			Default("global").
+			NotEmpty().
+			Immutable().
+			Comment("配置项的作用域"),
+		field.String("value").
+			Immutable().
+			Comment("申请写入的值"),
+		field.Enum("status").
+			Values("pending", "approved", "rejected", "expired", "failed").
+			Default("pending").
+			Comment("申请状态"),
+		field.String("requested_by").
+			NotEmpty().
+			Immutable().
+			Comment("申请人"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("创建申请的请求 ID"),
+		field.String("reviewed_by").
+			Optional().
+			Comment("审批人"),
+		field.String("comment").
+			Optional().
+			Comment("审批意见"),
+		field.String("message").
+			Optional().
+			Comment("批准后写入失败的原因"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("过期时间，过期后不能再审批"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("状态更新时间"),
+	}
+}
+
+// Edges of the ConfigChangeRequest.
+func (ConfigChangeRequest) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConfigChangeRequest.
+func (ConfigChangeRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConfigHistory holds the schema definition for the ConfigHistory entity.
+// Each row records one change of a dynamic config item; the auto-increment
+// id doubles as the revision number of the change.
+type ConfigHistory struct {
+	ent.Schema
+}
+
+// Fields of the ConfigHistory.
+func (ConfigHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Comment("配置项的键，如 poc.enabled"),
+		field.String("scope").
+			Default("global").
+			NotEmpty().
+			Immutable().
+			Comment("配置项的作用域"),
+		// 旧值与新值可以改写：重新加密敏感值时以当前主密钥重写
+		field.String("old_value").
+			Optional().
+			Nillable().
+			Comment("变更前的值（为空表示变更前不存在）"),
+		field.String("new_value").
+			Optional().
+			Nillable().
+			Comment("变更后的值（为空表示已删除）"),
+		field.Enum("action").
+			Values("set", "delete", "rollback", "reencrypt", "migrate", "revert").
+			Immutable().
+			Comment("变更类型"),
+		field.String("actor").
+			Default("system").
+			Immutable().
+			Comment("变更操作人"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("触发变更的请求 ID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("变更时间"),
+	}
+}
+
+// Edges of the ConfigHistory.
+func (ConfigHistory) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConfigHistory.
+func (ConfigHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key"),
+	}
+}
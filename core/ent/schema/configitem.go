@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Configitem holds the schema definition for the Configitem entity.
@@ -14,14 +15,21 @@ type Configitem struct {
 func (Configitem) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("key").
-			Unique().
 			NotEmpty().
 			Comment("配置项的键，如 poc.enabled"),
+		field.String("scope").
+			Default("global").
+			NotEmpty().
+			Comment("作用域：global、project:<id> 或 project:<id>/user:<id>（已有数据为 global）"),
 		field.String("value").
 			Comment("配置项的值（JSON字符串）"),
 		field.Bool("is_dynamic").
 			Default(false).
 			Comment("是否为动态配置（db:true）"),
+		field.Int("revision").
+			Default(1).
+			Positive().
+			Comment("修订号，每次更新加 1，用于乐观并发控制（ETag）"),
 	}
 }
 
@@ -29,3 +37,10 @@ func (Configitem) Fields() []ent.Field {
 func (Configitem) Edges() []ent.Edge {
 	return nil
 }
+
+// Indexes of the Configitem.
+func (Configitem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "scope").Unique(),
+	}
+}
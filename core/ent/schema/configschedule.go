@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConfigSchedule holds the schema definition for the ConfigSchedule entity.
+// Each row is a dynamic config change applied at effective_at and, when
+// expires_at is set, reverted to the prior value at expiry.
+type ConfigSchedule struct {
+	ent.Schema
+}
+
+// Fields of the ConfigSchedule.
+func (ConfigSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Comment("配置项的键，如 logger.level"),
+		field.String("scope").
+			Default("global").
+			NotEmpty().
+			Immutable().
+			Comment("配置项的作用域"),
+		field.String("value").
+			Immutable().
+			Comment("计划写入的值"),
+		field.String("prior_value").
+			Optional().
+			Nillable().
+			Comment("生效前作用域中存储的值（为空表示生效前不存在），到期时恢复"),
+		field.Int("applied_revision").
+			Default(0).
+			Comment("生效时写入后的配置项修订号，到期恢复时用于检测之后的修改"),
+		field.Time("effective_at").
+			Immutable().
+			Comment("生效时间"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("到期时间（为空表示生效后不恢复）"),
+		field.Enum("status").
+			Values("pending", "active", "completed", "cancelled", "failed").
+			Default("pending").
+			Comment("计划状态"),
+		field.String("message").
+			Optional().
+			Comment("失败原因或未恢复的说明"),
+		field.String("actor").
+			Default("system").
+			Immutable().
+			Comment("创建计划的操作人"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("创建计划的请求 ID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("状态更新时间"),
+	}
+}
+
+// Edges of the ConfigSchedule.
+func (ConfigSchedule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConfigSchedule.
+func (ConfigSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
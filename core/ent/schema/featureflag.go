@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// FeatureFlag holds the schema definition for the FeatureFlag entity.
+type FeatureFlag struct {
+	ent.Schema
+}
+
+// Fields of the FeatureFlag.
+func (FeatureFlag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Comment("Flag 的键，如 checkout.new_flow"),
+		field.Text("definition").
+			Comment("Flag 定义（变体、定向规则、灰度比例）的 JSON"),
+		field.Int("revision").
+			Default(1).
+			Positive().
+			Comment("修订号，每次更新加 1"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("最后修改时间"),
+		field.String("updated_by").
+			Default("system").
+			Comment("最后修改人"),
+	}
+}
+
+// Edges of the FeatureFlag.
+func (FeatureFlag) Edges() []ent.Edge {
+	return nil
+}
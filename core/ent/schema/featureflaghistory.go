@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FeatureFlagHistory holds the schema definition for the FeatureFlagHistory entity.
+// Each row records one change of a feature flag definition.
+type FeatureFlagHistory struct {
+	ent.Schema
+}
+
+// Fields of the FeatureFlagHistory.
+func (FeatureFlagHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Comment("Flag 的键"),
+		field.Text("old_definition").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("变更前的定义（为空表示变更前不存在）"),
+		field.Text("new_definition").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("变更后的定义（为空表示已删除）"),
+		field.Enum("action").
+			Values("set", "delete").
+			Immutable().
+			Comment("变更类型"),
+		field.String("actor").
+			Default("system").
+			Immutable().
+			Comment("变更操作人"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("触发变更的请求 ID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("变更时间"),
+	}
+}
+
+// Edges of the FeatureFlagHistory.
+func (FeatureFlagHistory) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the FeatureFlagHistory.
+func (FeatureFlagHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key"),
+	}
+}
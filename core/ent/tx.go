@@ -12,8 +12,18 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ConfigChangeRequest is the client for interacting with the ConfigChangeRequest builders.
+	ConfigChangeRequest *ConfigChangeRequestClient
+	// ConfigHistory is the client for interacting with the ConfigHistory builders.
+	ConfigHistory *ConfigHistoryClient
+	// ConfigSchedule is the client for interacting with the ConfigSchedule builders.
+	ConfigSchedule *ConfigScheduleClient
 	// Configitem is the client for interacting with the Configitem builders.
 	Configitem *ConfigitemClient
+	// FeatureFlag is the client for interacting with the FeatureFlag builders.
+	FeatureFlag *FeatureFlagClient
+	// FeatureFlagHistory is the client for interacting with the FeatureFlagHistory builders.
+	FeatureFlagHistory *FeatureFlagHistoryClient
 	// Servers is the client for interacting with the Servers builders.
 	Servers *ServersClient
 	// Users is the client for interacting with the Users builders.
@@ -149,7 +159,12 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ConfigChangeRequest = NewConfigChangeRequestClient(tx.config)
+	tx.ConfigHistory = NewConfigHistoryClient(tx.config)
+	tx.ConfigSchedule = NewConfigScheduleClient(tx.config)
 	tx.Configitem = NewConfigitemClient(tx.config)
+	tx.FeatureFlag = NewFeatureFlagClient(tx.config)
+	tx.FeatureFlagHistory = NewFeatureFlagHistoryClient(tx.config)
 	tx.Servers = NewServersClient(tx.config)
 	tx.Users = NewUsersClient(tx.config)
 }
@@ -161,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Configitem.QueryXXX(), the query will be executed
+// applies a query, for example: ConfigChangeRequest.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,209 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RequiresApproval 判断配置项的修改是否需要他人审批（approval:"required"）
+func (s *Service) RequiresApproval(key string) bool {
+	return s.loader.RequiresApproval(key)
+}
+
+// DefaultApprovalTTL 配置尚未加载时变更申请的有效期（与 approval.ttl 的默认值一致）
+const DefaultApprovalTTL = 24 * time.Hour
+
+// checkApproval 需要审批的配置项只能通过已批准的变更申请（ApproveChange）写入，
+// 其他写入（单项、批量、导入、计划、删除、回滚）返回 *ApprovalRequiredError
+func (s *Service) checkApproval(ctx context.Context, key string) error {
+	if s.RequiresApproval(key) && !isApprovedChange(ctx) {
+		return &ApprovalRequiredError{Key: key}
+	}
+	return nil
+}
+
+// approvalTTL 返回 approval.ttl，配置尚未加载时使用 DefaultApprovalTTL
+func (s *Service) approvalTTL() time.Duration {
+	if cfg := s.GetConfig(); cfg != nil && cfg.Approval.TTL > 0 {
+		return cfg.Approval.TTL
+	}
+	return DefaultApprovalTTL
+}
+
+// RequestChange 为需要审批的配置项创建变更申请，批准后才写入
+// 键与值立即按 UpdateConfig 的规则校验；申请人取自 ctx（必须指定），
+// 申请在 approval.ttl 后过期
+// 申请人与审批人均取自 ctx 中的操作人（HTTP 接口为 X-Actor 头），服务本身不认证该头，
+// 四眼原则依赖前置的认证代理覆盖 X-Actor 为已认证的用户
+func (s *Service) RequestChange(ctx context.Context, key string, value string) (*ChangeRequest, error) {
+	if !s.RequiresApproval(key) {
+		return nil, fmt.Errorf("config key %s does not require approval", key)
+	}
+	if ActorFromContext(ctx) == DefaultActor {
+		return nil, fmt.Errorf("a change request requires an operator (%s header)", ActorHeader)
+	}
+
+	value, err := s.normalizeDynamicValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &ChangeRequest{
+		Key:       key,
+		Value:     value,
+		ExpiresAt: time.Now().Add(s.approvalTTL()),
+	}
+	if err := s.provider.CreateChangeRequest(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to create config change request: %w", err)
+	}
+	return request, nil
+}
+
+// ListChangeRequests 列出状态属于 statuses 的变更申请（全部作用域，statuses 为空时列出全部）
+// 已过期的待审批申请先标记为 expired
+func (s *Service) ListChangeRequests(ctx context.Context, statuses ...string) ([]ChangeRequest, error) {
+	if err := s.expireChangeRequests(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	requests, err := s.provider.ListChangeRequests(ctx, statuses...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config change requests: %w", err)
+	}
+	return requests, nil
+}
+
+// GetChangeRequest 根据 ID 获取变更申请，已过期的待审批申请先标记为 expired
+func (s *Service) GetChangeRequest(ctx context.Context, id int) (*ChangeRequest, error) {
+	request, err := s.provider.GetChangeRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.expireChangeRequest(ctx, request, time.Now()); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// ApproveChange 批准变更申请并通过 UpdateConfig 写入（作用域为申请的作用域，历史中的操作人为申请人，不受 checkApproval 限制）
+// 审批人取自 ctx，不能是申请人本人；申请先以条件更新认领，同一申请只会被写入一次
+// 写入时验证失败（如申请后其他配置已变化）标记为 failed 并返回错误
+func (s *Service) ApproveChange(ctx context.Context, id int, comment string) (*ChangeRequest, error) {
+	request, err := s.reviewChangeRequest(ctx, id, ChangeRequestStatusApproved, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	applyErr := s.UpdateConfig(withApprovedChange(changeRequestContext(ctx, request)), request.Key, request.Value)
+	if applyErr == nil {
+		return request, nil
+	}
+
+	next := *request
+	next.Status = ChangeRequestStatusFailed
+	next.Message = applyErr.Error()
+	if err := s.provider.UpdateChangeRequest(ctx, &next, ChangeRequestStatusApproved); err != nil {
+		return nil, err
+	}
+	return &next, fmt.Errorf("failed to apply approved change: %w", applyErr)
+}
+
+// RejectChange 拒绝变更申请，审批人规则同 ApproveChange
+func (s *Service) RejectChange(ctx context.Context, id int, comment string) (*ChangeRequest, error) {
+	return s.reviewChangeRequest(ctx, id, ChangeRequestStatusRejected, comment)
+}
+
+// reviewChangeRequest 校验审批人并将待审批的申请转换为 status
+// 申请已被审批或已过期时返回 *ChangeRequestConflictError
+func (s *Service) reviewChangeRequest(ctx context.Context, id int, status string, comment string) (*ChangeRequest, error) {
+	request, err := s.GetChangeRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer := ActorFromContext(ctx)
+	if reviewer == DefaultActor {
+		return nil, &ReviewForbiddenError{ID: id, Reason: fmt.Sprintf("the reviewer must be identified (%s header)", ActorHeader)}
+	}
+	if reviewer == request.RequestedBy {
+		return nil, &ReviewForbiddenError{ID: id, Reason: "a change must be reviewed by someone other than its requester"}
+	}
+	if request.Status != ChangeRequestStatusPending {
+		return nil, &ChangeRequestConflictError{ID: id, Expected: ChangeRequestStatusPending}
+	}
+
+	reviewed := *request
+	reviewed.Status = status
+	reviewed.ReviewedBy = reviewer
+	reviewed.Comment = comment
+	if err := s.provider.UpdateChangeRequest(ctx, &reviewed, ChangeRequestStatusPending); err != nil {
+		return nil, err
+	}
+	return &reviewed, nil
+}
+
+// expireChangeRequests 将 now 时已过期的待审批申请标记为 expired
+func (s *Service) expireChangeRequests(ctx context.Context, now time.Time) error {
+	pending, err := s.provider.ListChangeRequests(ctx, ChangeRequestStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to list config change requests: %w", err)
+	}
+	for i := range pending {
+		if err := s.expireChangeRequest(ctx, &pending[i], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expireChangeRequest 若待审批的申请在 now 时已过期，将其标记为 expired（request 同步更新）
+// 条件更新冲突说明申请已被他人审批，重新读取当前状态
+func (s *Service) expireChangeRequest(ctx context.Context, request *ChangeRequest, now time.Time) error {
+	if request.Status != ChangeRequestStatusPending || request.ExpiresAt.After(now) {
+		return nil
+	}
+
+	expired := *request
+	expired.Status = ChangeRequestStatusExpired
+	err := s.provider.UpdateChangeRequest(ctx, &expired, ChangeRequestStatusPending)
+	if err == nil {
+		*request = expired
+		return nil
+	}
+	var conflict *ChangeRequestConflictError
+	if !errors.As(err, &conflict) {
+		return fmt.Errorf("failed to expire config change request: %w", err)
+	}
+
+	current, err := s.provider.GetChangeRequest(ctx, request.ID)
+	if err != nil {
+		return err
+	}
+	*request = *current
+	return nil
+}
+
+// changeRequestContext 返回申请作用域中的 ctx，变更历史中的操作人记为申请人
+func changeRequestContext(ctx context.Context, request *ChangeRequest) context.Context {
+	if scope, err := ParseScope(request.Scope); err == nil {
+		ctx = WithScope(ctx, scope)
+	}
+	return WithActor(ctx, request.RequestedBy)
+}
+
+// MaskChangeRequests 返回对敏感配置项脱敏后的变更申请副本
+func (s *Service) MaskChangeRequests(requests []ChangeRequest) []ChangeRequest {
+	masked := make([]ChangeRequest, len(requests))
+	for i, request := range requests {
+		masked[i] = s.MaskChangeRequest(request)
+	}
+	return masked
+}
+
+// MaskChangeRequest 返回对敏感配置项脱敏后的变更申请
+func (s *Service) MaskChangeRequest(request ChangeRequest) ChangeRequest {
+	request.Value = s.MaskValue(request.Key, request.Value)
+	return request
+}
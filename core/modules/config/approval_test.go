@@ -0,0 +1,258 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type approvalStaticConfig struct {
+	Token string `yaml:"token" db:"false" approval:"required"`
+}
+
+type approvalInvalidConfig struct {
+	Token string `yaml:"token" db:"true" approval:"yes"`
+}
+
+// TestLoader_ApprovalTag 测试 approval 标签解析
+func TestLoader_ApprovalTag(t *testing.T) {
+	loader, err := NewLoader(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	assert.True(t, loader.RequiresApproval("poc.database"))
+	assert.False(t, loader.RequiresApproval("poc.api_key"))
+	assert.False(t, loader.RequiresApproval("unknown.key"))
+
+	for name, module := range map[string]interface{}{
+		"static":  &approvalStaticConfig{},
+		"invalid": &approvalInvalidConfig{},
+	} {
+		registry := NewRegistry()
+		require.NoError(t, registry.Register(name, module))
+		_, err := NewLoaderWithRegistry(t.TempDir(), nil, registry)
+		assert.Error(t, err, name)
+	}
+}
+
+// TestService_ApproveChange 测试变更申请须由他人批准后才写入
+func TestService_ApproveChange(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	alice := WithActor(context.Background(), "alice")
+	bob := WithActor(context.Background(), "bob")
+	const dsn = "postgres://admin:pw@db-new:5432/poc"
+
+	_, err := service.RequestChange(context.Background(), "poc.database", dsn)
+	assert.Error(t, err, "requester must be identified")
+	_, err = service.RequestChange(alice, "app.name", "no-approval")
+	assert.Error(t, err)
+	_, err = service.RequestChange(alice, "poc.database", "not a url")
+	assert.Error(t, err)
+
+	request, err := service.RequestChange(alice, "poc.database", dsn)
+	require.NoError(t, err)
+	assert.Equal(t, ChangeRequestStatusPending, request.Status)
+	assert.Equal(t, "alice", request.RequestedBy)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), request.ExpiresAt, time.Minute)
+	assert.NotContains(t, mockProvider.configs, "poc.database")
+
+	var forbidden *ReviewForbiddenError
+	_, err = service.ApproveChange(alice, request.ID, "")
+	assert.ErrorAs(t, err, &forbidden)
+	_, err = service.ApproveChange(context.Background(), request.ID, "")
+	assert.ErrorAs(t, err, &forbidden)
+
+	approved, err := service.ApproveChange(bob, request.ID, "checked")
+	require.NoError(t, err)
+	assert.Equal(t, ChangeRequestStatusApproved, approved.Status)
+	assert.Equal(t, "bob", approved.ReviewedBy)
+	assert.Equal(t, "checked", approved.Comment)
+	assert.Equal(t, dsn, service.GetConfig().POC.Database)
+
+	history, err := service.ListConfigHistory(alice, "poc.database", 1)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "alice", history[0].Actor)
+
+	var conflict *ChangeRequestConflictError
+	_, err = service.ApproveChange(WithActor(context.Background(), "carol"), request.ID, "")
+	assert.ErrorAs(t, err, &conflict)
+
+	pending, err := service.ListChangeRequests(alice, ChangeRequestStatusPending)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+// TestService_ApprovalEnforced 测试需要审批的配置项在服务层拒绝所有直接写入，只能通过批准的申请写入
+func TestService_ApprovalEnforced(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	alice := WithActor(context.Background(), "alice")
+	const dsn = "postgres://admin:pw@db-new:5432/poc"
+
+	request, err := service.RequestChange(alice, "poc.database", dsn)
+	require.NoError(t, err)
+	_, err = service.ApproveChange(WithActor(context.Background(), "bob"), request.ID, "")
+	require.NoError(t, err)
+	history, err := service.ListConfigHistory(alice, "poc.database", 1)
+	require.NoError(t, err)
+
+	var required *ApprovalRequiredError
+	assert.ErrorAs(t, service.UpdateConfig(alice, "poc.database", "postgres://admin:pw@db-a:5432/poc"), &required)
+	assert.ErrorAs(t, service.UpdateConfigs(alice, map[string]string{"app.name": "batch", "poc.database": "postgres://admin:pw@db-b:5432/poc"}), &required)
+	assert.ErrorAs(t, service.DeleteDynamicConfig(alice, "poc.database"), &required)
+	_, err = service.RollbackConfig(alice, "poc.database", history[0].Revision)
+	assert.ErrorAs(t, err, &required)
+	_, err = service.ScheduleConfig(alice, "poc.database", "postgres://admin:pw@db-c:5432/poc", time.Now(), nil)
+	assert.ErrorAs(t, err, &required)
+
+	// 标签变化前创建的计划由调度器生效时同样被拒绝
+	require.NoError(t, mockProvider.CreateSchedule(alice, &Schedule{Key: "poc.database", Value: "postgres://admin:pw@db-d:5432/poc", EffectiveAt: time.Now()}))
+	assert.Equal(t, 0, service.runDueSchedules(context.Background(), time.Now()))
+	assert.Equal(t, ScheduleStatusFailed, mockProvider.schedules[0].Status)
+
+	assert.Equal(t, dsn, mockProvider.configs["poc.database"])
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+}
+
+// TestService_RequestChange_NotLoaded 测试配置尚未加载时申请使用默认有效期
+func TestService_RequestChange_NotLoaded(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+	mockProvider := newMockProvider()
+	loader, err := NewLoader(tmpDir, mockProvider)
+	require.NoError(t, err)
+	service := NewService(loader, mockProvider)
+
+	request, err := service.RequestChange(WithActor(context.Background(), "alice"), "poc.database", "postgres://admin:pw@db:5432/poc")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(DefaultApprovalTTL), request.ExpiresAt, time.Minute)
+}
+
+// TestService_RejectChange 测试拒绝与过期的变更申请不会写入
+func TestService_RejectChange(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	alice := WithActor(context.Background(), "alice")
+	bob := WithActor(context.Background(), "bob")
+
+	rejected, err := service.RequestChange(alice, "poc.database", "postgres://admin:pw@db-a:5432/poc")
+	require.NoError(t, err)
+	rejected, err = service.RejectChange(bob, rejected.ID, "wrong host")
+	require.NoError(t, err)
+	assert.Equal(t, ChangeRequestStatusRejected, rejected.Status)
+
+	expired, err := service.RequestChange(alice, "poc.database", "postgres://admin:pw@db-b:5432/poc")
+	require.NoError(t, err)
+	mockProvider.changes[expired.ID-1].ExpiresAt = time.Now().Add(-time.Second)
+
+	var conflict *ChangeRequestConflictError
+	_, err = service.ApproveChange(bob, expired.ID, "")
+	assert.ErrorAs(t, err, &conflict)
+
+	all, err := service.ListChangeRequests(alice)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, ChangeRequestStatusRejected, all[0].Status)
+	assert.Equal(t, ChangeRequestStatusExpired, all[1].Status)
+	assert.NotContains(t, mockProvider.configs, "poc.database")
+}
+
+// TestService_ChangeRequest_Secret 测试变更申请中的敏感值加密保存，批准后解密写入
+func TestService_ChangeRequest_Secret(t *testing.T) {
+	cipher, err := NewSecretCipher(newMasterKey(t))
+	require.NoError(t, err)
+	service, mockProvider := newSecretTestService(t, cipher)
+
+	const dsn = "postgres://admin:hunter2@db:5432/poc"
+	request, err := service.RequestChange(WithActor(context.Background(), "alice"), "poc.database", dsn)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(mockProvider.changes[0].Value, encryptedPrefix))
+	assert.Equal(t, SecretMask, service.MaskChangeRequest(*request).Value)
+
+	_, err = service.ApproveChange(WithActor(context.Background(), "bob"), request.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, dsn, service.GetConfig().POC.Database)
+}
+
+// TestService_ImportSkipsApproval 测试导入时跳过需要审批的配置项
+func TestService_ImportSkipsApproval(t *testing.T) {
+	service, mockProvider := newTestService(t)
+
+	resp, err := service.ImportConfig(context.Background(), []byte("poc:\n  database: postgres://admin:pw@db-new:5432/poc\napp:\n  name: imported\n"), false)
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 2)
+	assert.Equal(t, ImportActionUpdate, resp.Changes[0].Action)
+	assert.Equal(t, ImportActionSkipped, resp.Changes[1].Action)
+	assert.Equal(t, "approval:required", resp.Changes[1].Reason)
+	assert.Equal(t, 1, resp.Applied)
+	assert.NotContains(t, mockProvider.configs, "poc.database")
+}
+
+// TestHandler_ChangeRequests 测试需要审批的配置项通过 /config/changes 审批
+func TestHandler_ChangeRequests(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	r := chi.NewRouter()
+	NewHandler(service).RegisterRoutes(r)
+
+	send := func(method, url, body, actor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		if actor != "" {
+			req.Header.Set(ActorHeader, actor)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	const dsn = "postgres://admin:pw@db-new:5432/poc"
+	body := `{"key":"poc.database","value":"` + dsn + `"}`
+
+	assert.Equal(t, http.StatusUnprocessableEntity, send(http.MethodPut, "/config", body, "").Code)
+
+	w := send(http.MethodPut, "/config", body, "alice")
+	require.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, "/api/config/changes/1", w.Header().Get("Location"))
+	var request ChangeRequest
+	decodeData(t, w, &request)
+	assert.Equal(t, ChangeRequestStatusPending, request.Status)
+	assert.NotContains(t, mockProvider.configs, "poc.database")
+
+	// 需要审批的配置项不能绕过申请直接写入
+	for _, bypass := range []struct{ method, url, body string }{
+		{http.MethodPut, "/config/batch", `{"items":[{"key":"poc.database","value":"` + dsn + `"}]}`},
+		{http.MethodPut, "/config", `{"key":"poc.database","value":"` + dsn + `","expires_at":"2099-01-01T00:00:00Z"}`},
+		{http.MethodDelete, "/config?key=poc.database", ""},
+		{http.MethodPost, "/config/history/rollback", `{"key":"poc.database","revision":1}`},
+	} {
+		assert.Equal(t, http.StatusForbidden, send(bypass.method, bypass.url, bypass.body, "alice").Code, bypass.url)
+	}
+
+	var list ListChangeRequestsResponse
+	decodeData(t, send(http.MethodGet, "/config/changes", "", ""), &list)
+	require.Equal(t, 1, list.Count)
+	assert.Equal(t, "alice", list.Changes[0].RequestedBy)
+
+	assert.Equal(t, http.StatusOK, send(http.MethodGet, "/config/changes/1", "", "").Code)
+	assert.Equal(t, http.StatusNotFound, send(http.MethodGet, "/config/changes/9", "", "").Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, send(http.MethodGet, "/config/changes?status=unknown", "", "").Code)
+
+	assert.Equal(t, http.StatusForbidden, send(http.MethodPost, "/config/changes/1/approve", "", "alice").Code)
+	assert.Equal(t, http.StatusForbidden, send(http.MethodPost, "/config/changes/1/approve", "", "").Code)
+
+	w = send(http.MethodPost, "/config/changes/1/approve", `{"comment":"checked"}`, "bob")
+	require.Equal(t, http.StatusOK, w.Code)
+	decodeData(t, w, &request)
+	assert.Equal(t, ChangeRequestStatusApproved, request.Status)
+	assert.Equal(t, "checked", request.Comment)
+	assert.Equal(t, dsn, service.GetConfig().POC.Database)
+
+	assert.Equal(t, http.StatusConflict, send(http.MethodPost, "/config/changes/1/reject", "", "carol").Code)
+	assert.Equal(t, http.StatusNotFound, send(http.MethodPost, "/config/changes/9/approve", "", "bob").Code)
+}
@@ -8,12 +8,29 @@ import (
 
 	internalConfig "apprun/internal/config"
 	"apprun/pkg/database"
+	"apprun/pkg/server"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Infrastructure config sections, bound from the top-level keys of the same name
+const (
+	InfraServerNamespace   = "server"
+	InfraDatabaseNamespace = "database"
 )
 
+// InfraConfig 基础设施配置（HTTP 服务、数据库连接与动态配置存储），启动时在连接数据库之前加载
+type InfraConfig struct {
+	Server   *server.Config
+	Database *database.Config
+	Store    *StoreConfig
+}
+
 // Bootstrap 配置引导器，统一管理配置初始化流程
 type Bootstrap struct {
 	configDir string
 	registry  *ConfigRegistry // 模块配置注册表
+	cipher    *SecretCipher   // 敏感配置加密器（可选）
 }
 
 // NewBootstrap 创建配置引导器
@@ -33,6 +50,13 @@ func NewBootstrapWithRegistry(configDir string, registry *ConfigRegistry) *Boots
 	}
 }
 
+// WithSecretCipher 设置敏感配置（secret:"true"）的加密器
+// 未设置时敏感值以明文存储，但 API 响应仍会脱敏
+func (b *Bootstrap) WithSecretCipher(cipher *SecretCipher) *Bootstrap {
+	b.cipher = cipher
+	return b
+}
+
 // LoadInitialConfig 加载初始配置（不依赖数据库）
 // 这是启动流程的第一步，用于获取数据库连接信息
 func (b *Bootstrap) LoadInitialConfig(ctx context.Context) (*internalConfig.Config, error) {
@@ -51,23 +75,80 @@ func (b *Bootstrap) LoadInitialConfig(ctx context.Context) (*internalConfig.Conf
 	return cfg, nil
 }
 
+// LoadInfraConfig 以 pre-DB 模式加载并校验基础设施配置（server、database、config_store 段）
+// 与业务配置使用同一套标签驱动的加载器和键名：标签默认值 < default.yaml < default.<profile>.yaml
+// < 专用文件 < conf_d < 环境变量（如 database.db_name / DATABASE_DB_NAME）
+// 校验失败时返回 ConfigErrors，一次报告全部问题；使用 file 存储时不要求 database 段完整
+func (b *Bootstrap) LoadInfraConfig(ctx context.Context) (*InfraConfig, error) {
+	registry := NewRegistry()
+	if err := registry.Register(InfraServerNamespace, &server.Config{}); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(InfraDatabaseNamespace, &database.Config{}); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(InfraStoreNamespace, &StoreConfig{}); err != nil {
+		return nil, err
+	}
+
+	loader, err := NewLoaderWithRegistry(b.configDir, nil, registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config loader: %w", err)
+	}
+
+	_, modules, err := loader.loadAll(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load infrastructure config: %w", err)
+	}
+
+	// 只校验基础设施段；业务配置在 CreateService 中随数据库层一起校验
+	store := modules[InfraStoreNamespace].(*StoreConfig)
+	namespaces := []string{InfraServerNamespace, InfraStoreNamespace}
+	if store.Provider != StoreProviderFile {
+		namespaces = append(namespaces, InfraDatabaseNamespace)
+	}
+
+	validate := validator.New()
+	var problems ConfigErrors
+	for _, namespace := range namespaces {
+		problems = append(problems, fieldErrors(loader, validate, namespace, modules[namespace])...)
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+
+	return &InfraConfig{
+		Server:   modules[InfraServerNamespace].(*server.Config),
+		Database: modules[InfraDatabaseNamespace].(*database.Config),
+		Store:    store,
+	}, nil
+}
+
 // CreateService 创建配置服务（接收外部数据库客户端）
 // 数据库连接由调用方负责（通过 pkg/database）
 func (b *Bootstrap) CreateService(ctx context.Context, dbClient database.Client) (*Service, error) {
-	// 获取底层 Ent client
-	entClient := dbClient.GetEntClient()
-
-	// 创建配置仓储
-	repo := NewRepository(entClient)
+	// 创建配置仓储（写操作使用 dbClient 的事务）
+	return b.CreateServiceWithProvider(ctx, NewRepository(dbClient))
+}
 
-	// 创建配置加载器（带数据库支持和注册表）
-	loader, err := NewLoaderWithRegistry(b.configDir, repo, b.registry)
+// CreateServiceWithProvider 使用指定的动态配置存储创建配置服务（如 OpenProvider 返回的 FileProvider）
+func (b *Bootstrap) CreateServiceWithProvider(ctx context.Context, store ConfigProvider) (*Service, error) {
+	// 创建配置加载器（带注册表，数据库支持在下方接入）
+	loader, err := NewLoaderWithRegistry(b.configDir, nil, b.registry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create config loader with DB support: %w", err)
 	}
 
+	// 敏感配置在写入仓储前加密、读取后解密
+	provider := newSecretProvider(store, b.cipher, loader.IsSecret)
+	loader.provider = provider
+
 	// 创建配置服务
-	service := NewService(loader, repo)
+	service := NewService(loader, provider)
+	if _, ok := store.(*FileProvider); ok {
+		// 文件存储不依赖数据库，与 LoadInfraConfig 一致跳过 database 段的校验
+		service.except = []string{"Database"}
+	}
 
 	// 重新加载配置（现在包含数据库层）
 	_, err = service.LoadConfig(ctx)
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBootstrap_LoadInfraConfig 测试基础设施配置与业务配置使用同一加载器与键名
+func TestBootstrap_LoadInfraConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(`
+server:
+  http_port: "9090"
+  shutdown_timeout: "45s"
+  enable_http_with_https: false
+database:
+  host: file-host
+  password: "file-password"
+  db_name: file_db
+`), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "conf_d"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "conf_d", "10-db.yaml"), []byte(`
+database:
+  host: confd-host
+`), 0644))
+	t.Setenv("DATABASE_DB_NAME", "env_db")
+	t.Setenv("SERVER_HTTPS_PORT", "9443")
+
+	infra, err := NewBootstrap(tmpDir).LoadInfraConfig(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "9090", infra.Server.HTTPPort)
+	assert.Equal(t, "9443", infra.Server.HTTPSPort)
+	assert.Equal(t, 45*time.Second, infra.Server.ShutdownTimeout)
+	assert.False(t, infra.Server.EnableHTTPWithHTTPS)
+
+	// conf_d 覆盖 default.yaml，环境变量覆盖文件；db_name 在文件与环境变量中使用同一键名
+	assert.Equal(t, "confd-host", infra.Database.Host)
+	assert.Equal(t, "env_db", infra.Database.DBName)
+	assert.Equal(t, "file-password", infra.Database.Password)
+	assert.Equal(t, 5432, infra.Database.Port)
+	assert.Equal(t, "postgres", infra.Database.Driver)
+}
+
+// TestBootstrap_LoadInfraConfig_AllErrors 测试一次报告全部基础设施配置问题
+func TestBootstrap_LoadInfraConfig_AllErrors(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(`
+server:
+  http_port: "808080"
+database:
+  driver: sqlite
+  port: 70000
+`), 0644))
+	t.Setenv("DATABASE_PASSWORD", "short")
+
+	_, err := NewBootstrap(tmpDir).LoadInfraConfig(context.Background())
+	require.Error(t, err)
+
+	var problems ConfigErrors
+	require.True(t, errors.As(err, &problems))
+
+	keys := make([]string, 0, len(problems))
+	for _, fe := range problems {
+		keys = append(keys, fe.Key)
+	}
+	assert.ElementsMatch(t, []string{"server.http_port", "database.driver", "database.port", "database.password"}, keys)
+	assert.Contains(t, err.Error(), "database.password (env DATABASE_PASSWORD): failed 'min=8' validation")
+	assert.NotContains(t, err.Error(), "short")
+}
+
+// TestBootstrap_LoadInfraConfig_FileStore 测试使用 file 存储时不要求数据库配置
+func TestBootstrap_LoadInfraConfig_FileStore(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte("config_store:\n  provider: file\n"), 0644))
+	t.Setenv("CONFIG_STORE_FILE", "/var/lib/apprun/config.json")
+
+	infra, err := NewBootstrap(tmpDir).LoadInfraConfig(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, StoreProviderFile, infra.Store.Provider)
+	assert.Equal(t, "/var/lib/apprun/config.json", infra.Store.File)
+
+	// 默认使用数据库存储，数据库配置必须完整
+	t.Setenv("CONFIG_STORE_PROVIDER", StoreProviderDatabase)
+	_, err = NewBootstrap(tmpDir).LoadInfraConfig(context.Background())
+	assert.ErrorContains(t, err, "database.password")
+
+	t.Setenv("CONFIG_STORE_PROVIDER", "bolt")
+	_, err = NewBootstrap(tmpDir).LoadInfraConfig(context.Background())
+	assert.ErrorContains(t, err, "config_store.provider")
+}
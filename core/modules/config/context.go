@@ -0,0 +1,67 @@
+package config
+
+import (
+	"context"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// DefaultActor is recorded when a change carries no actor (e.g. startup or internal jobs)
+const DefaultActor = "system"
+
+// ActorHeader is the HTTP header used to identify who makes a config change
+// The server does not authenticate it; approvals rely on a fronting proxy that
+// authenticates users and overwrites the header
+const ActorHeader = "X-Actor"
+
+type actorContextKey struct{}
+
+type changeActionContextKey struct{}
+
+type approvedChangeContextKey struct{}
+
+// WithActor returns a context that attributes config changes to actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	if actor == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored in ctx, or DefaultActor
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return DefaultActor
+}
+
+// RequestIDFromContext returns the request ID injected by chi's RequestID middleware
+func RequestIDFromContext(ctx context.Context) string {
+	return middleware.GetReqID(ctx)
+}
+
+// withChangeAction overrides the action recorded in history for changes made with ctx
+func withChangeAction(ctx context.Context, action string) context.Context {
+	return context.WithValue(ctx, changeActionContextKey{}, action)
+}
+
+// changeActionFromContext returns the overridden action, or fallback if none is set
+func changeActionFromContext(ctx context.Context, fallback string) string {
+	if action, ok := ctx.Value(changeActionContextKey{}).(string); ok && action != "" {
+		return action
+	}
+	return fallback
+}
+
+// withApprovedChange marks changes made with ctx as applying an approved change
+// request, so keys that require approval may be written
+func withApprovedChange(ctx context.Context) context.Context {
+	return context.WithValue(ctx, approvedChangeContextKey{}, true)
+}
+
+// isApprovedChange reports whether ctx applies an approved change request
+func isApprovedChange(ctx context.Context) bool {
+	approved, _ := ctx.Value(approvedChangeContextKey{}).(bool)
+	return approved
+}
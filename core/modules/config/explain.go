@@ -0,0 +1,245 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// Layer names reported by ExplainConfig, in precedence order (lowest first)
+const (
+	LayerTagDefault      = "tag_default"
+	LayerDefaultYAML     = "default_yaml"
+	LayerSpecializedFile = "specialized_file"
+	LayerConfD           = "conf_d"
+	LayerDatabase        = "database"
+	LayerEnv             = "env"
+)
+
+// explainLayers reads the value every layer contributes for key, without
+// touching the loader's merged state. Values are text for tag defaults,
+// database and env vars, and decoded YAML for files, with ${...} expressions
+// resolved as the loader does (the expression is kept in RawValue).
+// The caller must hold the service's writeMu, since references to other keys
+// are read from the loader's merged state.
+func (l *Loader) explainLayers(ctx context.Context, key string) ([]ExplainLayer, error) {
+	meta, exists := l.metadata[key]
+	if !exists {
+		return nil, fmt.Errorf("unknown config key: %s", key)
+	}
+
+	// Layer 1: tag default
+	layers := []ExplainLayer{{
+		Layer:  1,
+		Name:   LayerTagDefault,
+		Source: "default tag",
+		Set:    meta.DefaultVal != "",
+		Value:  textOrNil(meta.DefaultVal, meta.DefaultVal != ""),
+	}}
+
+	// Layer 2: default.yaml, then default.<profile>.yaml
+	for _, name := range []string{"default.yaml", l.profileDefaultFile()} {
+		if name == "" {
+			continue
+		}
+		layer, err := l.explainFile(2, LayerDefaultYAML, name, key)
+		if err != nil {
+			return nil, err
+		}
+		if layer != nil {
+			layers = append(layers, *layer)
+		}
+	}
+
+	// Layer 3: specialized files
+	for _, name := range specializedFiles {
+		layer, err := l.explainFile(3, LayerSpecializedFile, name, key)
+		if err != nil {
+			return nil, err
+		}
+		if layer != nil {
+			layers = append(layers, *layer)
+		}
+	}
+
+	// Layer 4: every conf_d file and key-per-file dir by name, then conf_d/<profile>/
+	confD, err := l.confDFiles()
+	if err != nil {
+		return nil, err
+	}
+	profileConfD, err := l.profileConfDFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range append(confD, profileConfD...) {
+		layer, err := l.explainFile(4, LayerConfD, name, key)
+		if err != nil {
+			return nil, err
+		}
+		if layer != nil {
+			layers = append(layers, *layer)
+		}
+	}
+
+	// Layer 5: database (only applied to db:true keys), one entry per scope
+	// from global to the scope in ctx; the most specific stored value wins
+	if l.provider != nil {
+		for _, scope := range ScopeFromContext(ctx).Chain() {
+			value, found, err := l.provider.GetConfig(WithScope(ctx, scope), key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read database layer: %w", err)
+			}
+			source := "configitems"
+			if !scope.IsGlobal() {
+				source += "@" + scope.String()
+			}
+			layer := ExplainLayer{
+				Layer:  5,
+				Name:   LayerDatabase,
+				Source: source,
+				Set:    found,
+				Value:  textOrNil(value, found),
+			}
+			if found && !meta.AllowDB {
+				layer.Ignored = true
+				layer.IgnoredReason = "db:false"
+			}
+			layers = append(layers, layer)
+		}
+	}
+
+	// Layer 6: environment variable
+	envName := envVarName(key)
+	envValue, found := os.LookupEnv(envName)
+	layers = append(layers, ExplainLayer{
+		Layer:  6,
+		Name:   LayerEnv,
+		Source: envName,
+		Set:    found,
+		Value:  textOrNil(envValue, found),
+	})
+
+	return layers, nil
+}
+
+// explainFile reports the value a config source (relative to configDir) sets
+// for key, or nil when the source does not exist
+func (l *Loader) explainFile(layerNo int, name, file, key string) (*ExplainLayer, error) {
+	v, err := l.readSource(file)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	layer := &ExplainLayer{Layer: layerNo, Name: name, Source: sourceFileName(file, key)}
+	if v.IsSet(key) {
+		layer.Set = true
+		layer.Value = v.Get(key)
+		if resolved, ok := l.resolveLayerValue(file, key, layer.Value); ok {
+			layer.RawValue, layer.Value = layer.Value, resolved
+		}
+	}
+	return layer, nil
+}
+
+// resolveLayerValue resolves the ${...} expressions in a file layer value,
+// reporting false when the value has none or they cannot be resolved.
+// References to other keys use their merged, already resolved values.
+func (l *Loader) resolveLayerValue(file, key string, value interface{}) (interface{}, bool) {
+	if l.viper == nil {
+		return value, false
+	}
+	// No file sources: referenced keys are taken from viper as they are
+	in := &interpolator{
+		loader:   l,
+		sources:  map[string]fileSource{},
+		resolved: make(map[string]interface{}),
+	}
+	source := fileSource{File: file}
+
+	switch v := value.(type) {
+	case string:
+		expanded, err := in.expand(source, key, v)
+		if err != nil || expanded == v {
+			return value, false
+		}
+		return expanded, true
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		changed := false
+		for i, item := range v {
+			items[i] = item
+			text, ok := item.(string)
+			if !ok {
+				continue
+			}
+			expanded, err := in.expand(source, key, text)
+			if err != nil {
+				return value, false
+			}
+			items[i] = expanded
+			changed = changed || expanded != text
+		}
+		return items, changed
+	}
+	return value, false
+}
+
+func textOrNil(value string, set bool) interface{} {
+	if !set {
+		return nil
+	}
+	return value
+}
+
+// ExplainConfig 列出每一层为配置项提供的值，并标记最终生效的层
+// 文本层（标签默认值、数据库、环境变量）按字段类型解析，敏感值脱敏
+// 文件层的值为解析 ${...} 表达式之后的值，原始表达式在 RawValue 中
+func (s *Service) ExplainConfig(ctx context.Context, key string) (*ExplainConfigResponse, error) {
+	s.writeMu.Lock()
+	layers, err := s.loader.explainLayers(ctx, key)
+	s.writeMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	effective := -1
+	for i := range layers {
+		layer := &layers[i]
+		if layer.Set && !layer.Ignored {
+			effective = i
+		}
+		layer.Value = s.explainValue(key, layer)
+		if layer.RawValue != nil && s.IsSecret(key) {
+			layer.RawValue = SecretMask
+		}
+	}
+
+	resp := &ExplainConfigResponse{
+		Key:       key,
+		Profile:   s.loader.Profile(),
+		IsDynamic: s.loader.AllowDatabaseStorage(key),
+		Secret:    s.IsSecret(key),
+		Approval:  s.loader.RequiresApproval(key),
+		Layers:    layers,
+	}
+	if effective >= 0 {
+		layers[effective].Effective = true
+		resp.Value = layers[effective].Value
+		resp.Source = layers[effective].Name
+	}
+	return resp, nil
+}
+
+// explainValue converts a raw layer value for display
+func (s *Service) explainValue(key string, layer *ExplainLayer) interface{} {
+	if !layer.Set {
+		return nil
+	}
+	if text, ok := layer.Value.(string); ok {
+		return s.DisplayValue(key, text)
+	}
+	if s.IsSecret(key) {
+		return SecretMask
+	}
+	return layer.Value
+}
@@ -0,0 +1,218 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newExplainTestService 创建包含专用配置文件和 conf_d 的配置服务
+func newExplainTestService(t *testing.T) (*Service, *mockConfigProvider) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "database.yaml"), []byte("database:\n  host: db-primary\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "conf_d"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "conf_d", "10-base.yaml"), []byte("app:\n  name: from-base\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "conf_d", "20-site.yaml"), []byte("app:\n  name: from-site\n"), 0644))
+
+	mockProvider := newMockProvider()
+	loader, err := NewLoader(tmpDir, mockProvider)
+	require.NoError(t, err)
+
+	service := NewService(loader, mockProvider)
+	_, err = service.LoadConfig(context.Background())
+	require.NoError(t, err)
+
+	return service, mockProvider
+}
+
+// layerBySource 按来源查找解释结果中的层
+func layerBySource(t *testing.T, resp *ExplainConfigResponse, source string) ExplainLayer {
+	t.Helper()
+
+	for _, layer := range resp.Layers {
+		if layer.Source == source {
+			return layer
+		}
+	}
+	t.Fatalf("layer %q not found", source)
+	return ExplainLayer{}
+}
+
+// TestService_ExplainConfig 测试逐层列出配置来源
+func TestService_ExplainConfig(t *testing.T) {
+	service, mockProvider := newExplainTestService(t)
+	ctx := context.Background()
+
+	// conf_d 中排序靠后的文件生效
+	resp, err := service.ExplainConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "from-site", resp.Value)
+	assert.Equal(t, LayerConfD, resp.Source)
+	assert.True(t, resp.IsDynamic)
+	assert.Equal(t, "apprun", layerBySource(t, resp, "default tag").Value)
+	assert.Equal(t, "test-app", layerBySource(t, resp, "default.yaml").Value)
+	assert.Equal(t, "from-base", layerBySource(t, resp, "conf_d/10-base.yaml").Value)
+	assert.False(t, layerBySource(t, resp, "conf_d/10-base.yaml").Effective)
+	assert.True(t, layerBySource(t, resp, "conf_d/20-site.yaml").Effective)
+	assert.False(t, layerBySource(t, resp, "database.yaml").Set)
+	assert.Equal(t, "APP_NAME", resp.Layers[len(resp.Layers)-1].Source)
+
+	// 数据库覆盖文件，环境变量覆盖数据库
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "from-db"))
+	resp, err = service.ExplainConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "from-db", resp.Value)
+	assert.Equal(t, LayerDatabase, resp.Source)
+
+	t.Setenv("APP_NAME", "from-env")
+	resp, err = service.ExplainConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", resp.Value)
+	assert.Equal(t, LayerEnv, resp.Source)
+	assert.Equal(t, "from-db", layerBySource(t, resp, "configitems").Value)
+	assert.False(t, layerBySource(t, resp, "configitems").Effective)
+
+	// 专用配置文件
+	resp, err = service.ExplainConfig(ctx, "database.host")
+	require.NoError(t, err)
+	assert.Equal(t, "db-primary", resp.Value)
+	assert.Equal(t, LayerSpecializedFile, resp.Source)
+
+	// db:false 的数据库值被忽略
+	mockProvider.configs["app.version"] = "9.9.9"
+	resp, err = service.ExplainConfig(ctx, "app.version")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", resp.Value)
+	dbLayer := layerBySource(t, resp, "configitems")
+	assert.True(t, dbLayer.Ignored)
+	assert.Equal(t, "db:false", dbLayer.IgnoredReason)
+	assert.False(t, dbLayer.Effective)
+
+	// 类型化值与敏感值脱敏
+	resp, err = service.ExplainConfig(ctx, "database.port")
+	require.NoError(t, err)
+	assert.Equal(t, 5432, resp.Value)
+	assert.Equal(t, 5432, layerBySource(t, resp, "default tag").Value)
+
+	resp, err = service.ExplainConfig(ctx, "poc.database")
+	require.NoError(t, err)
+	assert.True(t, resp.Secret)
+	assert.True(t, resp.Approval)
+	assert.Equal(t, SecretMask, resp.Value)
+	for _, layer := range resp.Layers {
+		if layer.Set {
+			assert.Equal(t, SecretMask, layer.Value, layer.Source)
+		}
+	}
+
+	_, err = service.ExplainConfig(ctx, "unknown.key")
+	assert.Error(t, err)
+}
+
+// TestService_ExplainConfig_Interpolated 测试文件层报告解析表达式之后的值，原始表达式在 RawValue 中
+func TestService_ExplainConfig_Interpolated(t *testing.T) {
+	service, _ := newExplainTestService(t)
+	ctx := context.Background()
+	configDir := service.loader.configDir
+
+	t.Setenv("EXPLAIN_DB_HOST", "db-from-env")
+	t.Setenv("EXPLAIN_DSN", "postgres://admin:hunter2@db:5432/poc")
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "conf_d", "30-interp.yaml"),
+		[]byte("database:\n  host: ${EXPLAIN_DB_HOST}\napp:\n  name: ${database.host}-app\npoc:\n  database: ${EXPLAIN_DSN}\n"), 0644))
+	require.NoError(t, service.Reload(ctx))
+
+	resp, err := service.ExplainConfig(ctx, "database.host")
+	require.NoError(t, err)
+	assert.Equal(t, "db-from-env", resp.Value)
+	layer := layerBySource(t, resp, "conf_d/30-interp.yaml")
+	assert.Equal(t, "db-from-env", layer.Value)
+	assert.Equal(t, "${EXPLAIN_DB_HOST}", layer.RawValue)
+	assert.Nil(t, layerBySource(t, resp, "database.yaml").RawValue)
+
+	// 引用其他配置项时使用其解析后的值
+	resp, err = service.ExplainConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "db-from-env-app", resp.Value)
+	assert.Equal(t, "${database.host}-app", layerBySource(t, resp, "conf_d/30-interp.yaml").RawValue)
+
+	// 敏感值的原始表达式同样脱敏
+	resp, err = service.ExplainConfig(ctx, "poc.database")
+	require.NoError(t, err)
+	layer = layerBySource(t, resp, "conf_d/30-interp.yaml")
+	assert.Equal(t, SecretMask, layer.Value)
+	assert.Equal(t, SecretMask, layer.RawValue)
+}
+
+// TestHandler_ExplainConfig 测试 GET /config/explain
+func TestHandler_ExplainConfig(t *testing.T) {
+	service, _ := newExplainTestService(t)
+	handler := NewHandler(service)
+
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+
+	do := func(url string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do("/config/explain?key=app.name")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp ExplainConfigResponse
+	decodeData(t, w, &resp)
+	assert.Equal(t, "from-site", resp.Value)
+	assert.Equal(t, LayerConfD, resp.Source)
+	assert.NotEmpty(t, resp.Layers)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, do("/config/explain").Code)
+	assert.Equal(t, http.StatusNotFound, do("/config/explain?key=unknown.key").Code)
+}
+
+// TestService_ProfileSource 测试取自 profile 文件的配置项来源
+func TestService_ProfileSource(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv(ProfileEnv, "staging")
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.staging.yaml"), []byte("app:\n  name: staging-app\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "conf_d", "staging"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "conf_d", "staging", "10-poc.yaml"), []byte("poc:\n  enabled: true\n"), 0644))
+
+	mockProvider := newMockProvider()
+	loader, err := NewLoader(tmpDir, mockProvider)
+	require.NoError(t, err)
+	service := NewService(loader, mockProvider)
+	_, err = service.LoadConfig(context.Background())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	value, source, err := service.GetConfigValue(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "staging-app", value)
+	assert.Equal(t, "profile:staging", source)
+
+	_, source, err = service.GetConfigValue(ctx, "app.version")
+	require.NoError(t, err)
+	assert.Equal(t, "file", source)
+
+	// explain 列出 profile 文件
+	resp, err := service.ExplainConfig(ctx, "poc.enabled")
+	require.NoError(t, err)
+	assert.Equal(t, "staging", resp.Profile)
+	assert.Equal(t, true, resp.Value)
+	assert.True(t, layerBySource(t, resp, "conf_d/staging/10-poc.yaml").Effective)
+	resp, err = service.ExplainConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.True(t, layerBySource(t, resp, "default.staging.yaml").Effective)
+}
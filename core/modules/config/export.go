@@ -0,0 +1,206 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Export formats
+const (
+	ExportFormatYAML = "yaml"
+	ExportFormatJSON = "json"
+)
+
+// Import actions reported per key
+const (
+	ImportActionUpdate    = "update"    // db:true key whose value changes
+	ImportActionUnchanged = "unchanged" // value equals the effective value
+	ImportActionSkipped   = "skipped"   // not applied, see Reason
+	ImportActionInvalid   = "invalid"   // rejected by validation, see Reason
+)
+
+// ExportConfig 导出当前生效的完整配置（内部 Config 与全部注册模块），敏感值脱敏
+// 结果为按键路径嵌套的文档，可直接用于 ImportConfig
+func (s *Service) ExportConfig(format string) ([]byte, error) {
+	snap := s.snapshot.Load()
+	if snap == nil {
+		return nil, fmt.Errorf("config not loaded")
+	}
+
+	doc := map[string]interface{}{}
+	for _, key := range s.loader.sortedKeys() {
+		setNested(doc, key, s.DisplayValue(key, snap.Value(key)))
+	}
+
+	switch format {
+	case "", ExportFormatYAML:
+		data, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal config to YAML: %w", err)
+		}
+		return data, nil
+	case ExportFormatJSON:
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal config to JSON: %w", err)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ImportConfig 对导入文档（YAML 或 JSON）与当前生效配置做差异比较
+// 仅 db:true 的键会被应用，且与 UpdateConfig 使用相同的校验；dryRun 时只返回差异
+// 存在无效键时不应用任何变更，返回的错误附带逐键结果
+func (s *Service) ImportConfig(ctx context.Context, data []byte, dryRun bool) (*ImportConfigResponse, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid import document: %w", err)
+	}
+	if len(doc) == 0 {
+		return nil, fmt.Errorf("import document is empty")
+	}
+
+	values := map[string]interface{}{}
+	s.flattenImport("", doc, values)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resp := &ImportConfigResponse{DryRun: dryRun, Changes: make([]ImportChange, 0, len(keys))}
+	updates := map[string]string{}
+	for _, key := range keys {
+		change := s.diffImport(ctx, key, values[key])
+		if change.Action == ImportActionUpdate {
+			text, _ := valueText(values[key])
+			updates[key] = text
+		}
+		resp.Changes = append(resp.Changes, change)
+	}
+
+	invalid := 0
+	for _, change := range resp.Changes {
+		if change.Action == ImportActionInvalid {
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		if dryRun {
+			return resp, nil
+		}
+		return resp, fmt.Errorf("import rejected, %d invalid key(s), nothing applied", invalid)
+	}
+
+	if dryRun || len(updates) == 0 {
+		return resp, nil
+	}
+	if err := s.UpdateConfigs(ctx, updates); err != nil {
+		return resp, err
+	}
+	resp.Applied = len(updates)
+	return resp, nil
+}
+
+// diffImport compares one imported value with the effective value of key
+func (s *Service) diffImport(ctx context.Context, key string, value interface{}) ImportChange {
+	change := ImportChange{Key: key}
+
+	meta, exists := s.loader.GetMetadata(key)
+	if !exists {
+		change.Action = ImportActionSkipped
+		change.Reason = "unknown key"
+		return change
+	}
+
+	current, _, _ := s.GetConfigValue(ctx, key)
+	change.OldValue = s.DisplayValue(key, current)
+
+	text, err := valueText(value)
+	if err != nil {
+		change.Action = ImportActionInvalid
+		change.Reason = err.Error()
+		return change
+	}
+
+	// 导出时已脱敏的敏感值无法导入
+	if meta.Secret && text == SecretMask {
+		change.Action = ImportActionSkipped
+		change.Reason = "redacted secret"
+		return change
+	}
+
+	if !meta.AllowDB {
+		if normalized, _, err := normalizeValue(meta.Type, text); err == nil && normalized == current {
+			change.Action = ImportActionUnchanged
+			return change
+		}
+		change.NewValue = s.DisplayValue(key, text)
+		change.Action = ImportActionSkipped
+		change.Reason = "db:false"
+		return change
+	}
+
+	normalized, err := s.normalizeDynamicValue(key, text)
+	if err == nil && normalized != current && meta.Approval {
+		change.NewValue = s.DisplayValue(key, normalized)
+		change.Action = ImportActionSkipped
+		change.Reason = "approval:required"
+		return change
+	}
+	if err != nil {
+		change.NewValue = s.DisplayValue(key, text)
+		change.Action = ImportActionInvalid
+		change.Reason = err.Error()
+		return change
+	}
+
+	change.NewValue = s.DisplayValue(key, normalized)
+	if normalized == current {
+		change.Action = ImportActionUnchanged
+	} else {
+		change.Action = ImportActionUpdate
+	}
+	return change
+}
+
+// flattenImport converts a nested document into dotted keys; nesting stops at
+// known keys so map-typed fields are imported as a whole
+func (s *Service) flattenImport(prefix string, node map[string]interface{}, out map[string]interface{}) {
+	for name, value := range node {
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+
+		if _, known := s.loader.GetMetadata(key); !known {
+			if child, ok := value.(map[string]interface{}); ok {
+				s.flattenImport(key, child, out)
+				continue
+			}
+		}
+		out[key] = value
+	}
+}
+
+// setNested sets value at the dotted path key, creating intermediate maps
+func setNested(doc map[string]interface{}, key string, value interface{}) {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := doc[part].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			doc[part] = child
+		}
+		doc = child
+	}
+	doc[parts[len(parts)-1]] = value
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestService_ExportConfig 测试导出合并后的完整配置（含注册模块，敏感值脱敏）
+func TestService_ExportConfig(t *testing.T) {
+	service, _ := newRegistryTestService(t)
+	require.NoError(t, service.UpdateConfig(context.Background(), "app.name", "exported-app"))
+
+	data, err := service.ExportConfig(ExportFormatYAML)
+	require.NoError(t, err)
+
+	var doc map[string]map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(data, &doc))
+	assert.Equal(t, "exported-app", doc["app"]["name"])
+	assert.Equal(t, 5432, doc["database"]["port"])
+	assert.Equal(t, SecretMask, doc["poc"]["database"])
+	assert.Equal(t, SecretMask, doc["poc"]["api_key"])
+	assert.Contains(t, doc, "logger")
+	assert.Equal(t, SecretMask, doc["database"]["password"])
+	assert.NotContains(t, string(data), "testpassword123")
+
+	data, err = service.ExportConfig(ExportFormatJSON)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "exported-app", doc["app"]["name"])
+	assert.Equal(t, []interface{}{"stdout"}, doc["logger"]["output"].(map[string]interface{})["targets"])
+
+	_, err = service.ExportConfig("xml")
+	assert.Error(t, err)
+}
+
+// TestService_ImportConfig 测试导入的差异预览与应用
+func TestService_ImportConfig(t *testing.T) {
+	service, mockProvider := newRegistryTestService(t)
+	ctx := context.Background()
+
+	const doc = `
+app:
+  name: imported-app
+  version: 2.0.0
+  timezone: Asia/Shanghai
+poc:
+  enabled: true
+  database: "******"
+logger:
+  output:
+    targets: [stdout, "file:/var/log/app.log"]
+unknown:
+  key: 1
+`
+	changes := func(resp *ImportConfigResponse) map[string]ImportChange {
+		byKey := map[string]ImportChange{}
+		for _, change := range resp.Changes {
+			byKey[change.Key] = change
+		}
+		return byKey
+	}
+
+	// 预览不写入
+	resp, err := service.ImportConfig(ctx, []byte(doc), true)
+	require.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Zero(t, resp.Applied)
+	assert.Empty(t, mockProvider.configs)
+
+	byKey := changes(resp)
+	assert.Equal(t, ImportActionUpdate, byKey["app.name"].Action)
+	assert.Equal(t, "test-app", byKey["app.name"].OldValue)
+	assert.Equal(t, "imported-app", byKey["app.name"].NewValue)
+	assert.Equal(t, ImportActionSkipped, byKey["app.version"].Action)
+	assert.Equal(t, "db:false", byKey["app.version"].Reason)
+	assert.Equal(t, ImportActionUnchanged, byKey["app.timezone"].Action)
+	assert.Equal(t, ImportActionSkipped, byKey["poc.database"].Action)
+	assert.Equal(t, "redacted secret", byKey["poc.database"].Reason)
+	assert.Equal(t, ImportActionUpdate, byKey["logger.output.targets"].Action)
+	assert.Equal(t, ImportActionSkipped, byKey["unknown.key"].Action)
+
+	// 应用：仅写入 db:true 且有变化的键
+	resp, err = service.ImportConfig(ctx, []byte(doc), false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Applied)
+	assert.Equal(t, "imported-app", service.GetConfig().App.Name)
+	assert.True(t, service.GetConfig().POC.Enabled)
+	assert.Equal(t, `["stdout","file:/var/log/app.log"]`, mockProvider.configs["logger.output.targets"])
+	assert.NotContains(t, mockProvider.configs, "app.version")
+	assert.NotContains(t, mockProvider.configs, "poc.database")
+
+	// 任一键无效则不应用
+	resp, err = service.ImportConfig(ctx, []byte(`{"app":{"name":"json-app"},"poc":{"enabled":"maybe"}}`), false)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, ImportActionInvalid, changes(resp)["poc.enabled"].Action)
+	assert.Equal(t, "imported-app", service.GetConfig().App.Name)
+
+	_, err = service.ImportConfig(ctx, []byte("not: [valid"), false)
+	assert.Error(t, err)
+}
+
+// TestHandler_ExportImport 测试导出文档可直接导入
+func TestHandler_ExportImport(t *testing.T) {
+	service, _ := newRegistryTestService(t)
+	handler := NewHandler(service)
+
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodGet, "/config/export", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-yaml", w.Header().Get("Content-Type"))
+	exported := w.Body.String()
+	assert.Contains(t, exported, "name: test-app")
+
+	w = do(http.MethodGet, "/config/export?format=json", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, http.StatusUnprocessableEntity, do(http.MethodGet, "/config/export?format=xml", "").Code)
+
+	// 原样导入：无变更
+	w = do(http.MethodPost, "/config/import", exported)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp ImportConfigResponse
+	decodeData(t, w, &resp)
+	assert.Zero(t, resp.Applied)
+	for _, change := range resp.Changes {
+		assert.NotEqual(t, ImportActionUpdate, change.Action, change.Key)
+		assert.NotEqual(t, ImportActionInvalid, change.Action, change.Key)
+	}
+
+	modified := strings.Replace(exported, "name: test-app", "name: other-app", 1)
+	w = do(http.MethodPost, "/config/import?dry_run=true", modified)
+	require.Equal(t, http.StatusOK, w.Code)
+	decodeData(t, w, &resp)
+	assert.True(t, resp.DryRun)
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+
+	w = do(http.MethodPost, "/config/import", modified)
+	require.Equal(t, http.StatusOK, w.Code)
+	decodeData(t, w, &resp)
+	assert.Equal(t, 1, resp.Applied)
+	assert.Equal(t, "other-app", service.GetConfig().App.Name)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, do(http.MethodPost, "/config/import?dry_run=maybe", modified).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/config/import", `{"poc":{"enabled":"maybe"}}`).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/config/import", "").Code)
+}
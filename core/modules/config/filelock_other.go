@@ -0,0 +1,15 @@
+//go:build !unix
+
+package config
+
+import "os"
+
+// lockFile 在不支持 flock 的平台上不加锁：同一时间只应有一个进程写入存储文件
+func lockFile(f *os.File) error {
+	return nil
+}
+
+// unlockFile 与 lockFile 对应，不做任何事
+func unlockFile(f *os.File) error {
+	return nil
+}
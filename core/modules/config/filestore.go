@@ -0,0 +1,668 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileStoreVersion is the format version of the document written by FileProvider
+const fileStoreVersion = 1
+
+// fileStoreItem is a dynamic value stored in the file
+type fileStoreItem struct {
+	Value    string `json:"value"`
+	Revision int    `json:"revision"`
+}
+
+// fileStoreState is the document persisted by FileProvider
+type fileStoreState struct {
+	Version   int                                 `json:"version"`
+	Revision  int                                 `json:"revision"`            // Latest change revision
+	Items     map[string]map[string]fileStoreItem `json:"items"`               // Scope name -> key -> value
+	History   []ChangeRecord                      `json:"history"`             // Changes, oldest first
+	Schedules []Schedule                          `json:"schedules,omitempty"` // Scheduled changes, oldest first
+	Changes   []ChangeRequest                     `json:"changes,omitempty"`   // Change requests awaiting or after approval, oldest first
+}
+
+func newFileStoreState() *fileStoreState {
+	return &fileStoreState{
+		Version: fileStoreVersion,
+		Items:   make(map[string]map[string]fileStoreItem),
+	}
+}
+
+// clone copies the state so a failed write leaves the current state untouched
+func (s *fileStoreState) clone() *fileStoreState {
+	c := &fileStoreState{
+		Version:  s.Version,
+		Revision: s.Revision,
+		Items:    make(map[string]map[string]fileStoreItem, len(s.Items)),
+		History:  s.History[:len(s.History):len(s.History)],
+	}
+	c.Schedules = append([]Schedule(nil), s.Schedules...)
+	c.Changes = append([]ChangeRequest(nil), s.Changes...)
+	for scope, items := range s.Items {
+		copied := make(map[string]fileStoreItem, len(items))
+		for key, item := range items {
+			copied[key] = item
+		}
+		c.Items[scope] = copied
+	}
+	return c
+}
+
+// set writes key in the ctx scope and records the change
+func (s *fileStoreState) set(ctx context.Context, key string, value string, expected int) error {
+	scope := ScopeFromContext(ctx).String()
+	item, exists := s.Items[scope][key]
+
+	current := 0
+	if exists {
+		current = item.Revision
+	}
+	if expected != anyRevision && expected != current {
+		return &RevisionConflictError{Key: key, Expected: expected}
+	}
+
+	var oldValue *string
+	if exists {
+		oldValue = &item.Value
+	}
+
+	if s.Items[scope] == nil {
+		s.Items[scope] = make(map[string]fileStoreItem)
+	}
+	s.Items[scope][key] = fileStoreItem{Value: value, Revision: current + 1}
+	s.record(ctx, key, oldValue, &value, changeActionFromContext(ctx, ChangeActionSet))
+	return nil
+}
+
+// delete removes key from the ctx scope and records the change
+func (s *fileStoreState) delete(ctx context.Context, key string, expected int) error {
+	scope := ScopeFromContext(ctx).String()
+	item, exists := s.Items[scope][key]
+	if !exists {
+		if expected != anyRevision && expected != 0 {
+			return &RevisionConflictError{Key: key, Expected: expected}
+		}
+		return fmt.Errorf("config key not found: %s", key)
+	}
+	if expected != anyRevision && expected != item.Revision {
+		return &RevisionConflictError{Key: key, Expected: expected}
+	}
+
+	delete(s.Items[scope], key)
+	if len(s.Items[scope]) == 0 {
+		delete(s.Items, scope)
+	}
+	s.record(ctx, key, &item.Value, nil, changeActionFromContext(ctx, ChangeActionDelete))
+	return nil
+}
+
+// record appends a change with the next revision
+func (s *fileStoreState) record(ctx context.Context, key string, oldValue, newValue *string, action string) {
+	s.Revision++
+	s.History = append(s.History, ChangeRecord{
+		Revision:  s.Revision,
+		Key:       key,
+		Scope:     ScopeFromContext(ctx).String(),
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		Action:    action,
+		Actor:     ActorFromContext(ctx),
+		RequestID: RequestIDFromContext(ctx),
+		CreatedAt: time.Now(),
+	})
+}
+
+// FileProvider 基于本地 JSON 文件实现 ConfigProvider，无需 PostgreSQL（单节点、边缘部署与测试）
+// 每次写入先写入同目录临时文件并 fsync，再原子替换（rename）并 fsync 目录：崩溃后文件要么是旧内容，要么是新内容
+// 文件被其他进程（如 apprunctl --offline）修改后，下次访问时重新读取；
+// 写入期间持有同目录 <path>.lock 文件的排他锁（flock），多个进程的“读取-修改-写入”依次执行，不会丢失更新或重复分配修订号
+type FileProvider struct {
+	path  string
+	mu    sync.Mutex
+	state *fileStoreState
+	info  os.FileInfo // 已读取内容对应的文件；每次写入都替换为新文件，可据此发现其他进程的写入
+}
+
+// NewFileProvider 打开（不存在时创建）path 处的配置存储文件
+func NewFileProvider(path string) (*FileProvider, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create config store dir: %w", err)
+	}
+
+	p := &FileProvider{path: path}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	unlock, err := p.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := p.refresh(); err != nil {
+		return nil, err
+	}
+	if p.info == nil || p.info.Size() == 0 {
+		// 立即创建文件，尽早暴露权限等问题
+		if err := p.write(p.state); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Path 返回存储文件路径
+func (p *FileProvider) Path() string {
+	return p.path
+}
+
+// GetConfig 根据 key 获取 ctx 作用域中的配置项
+func (p *FileProvider) GetConfig(ctx context.Context, key string) (string, bool, error) {
+	state, err := p.read()
+	if err != nil {
+		return "", false, err
+	}
+
+	item, exists := state.Items[ScopeFromContext(ctx).String()][key]
+	if !exists {
+		return "", false, fmt.Errorf("config key not found: %s", key)
+	}
+	return item.Value, true, nil
+}
+
+// SetConfig 设置动态配置项并记录变更历史
+func (p *FileProvider) SetConfig(ctx context.Context, key string, value string) error {
+	return p.update(func(state *fileStoreState) error {
+		return state.set(ctx, key, value, anyRevision)
+	})
+}
+
+// SetConfigIfRevision 仅当当前修订号等于 expected 时写入（0 表示期望尚不存在）
+func (p *FileProvider) SetConfigIfRevision(ctx context.Context, key string, value string, expected int) error {
+	return p.update(func(state *fileStoreState) error {
+		return state.set(ctx, key, value, expected)
+	})
+}
+
+// SetConfigs 一次写入多个动态配置项，要么全部写入，要么全部不写入
+func (p *FileProvider) SetConfigs(ctx context.Context, items map[string]string) error {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return p.update(func(state *fileStoreState) error {
+		for _, key := range keys {
+			if err := state.set(ctx, key, items[key], anyRevision); err != nil {
+				return fmt.Errorf("failed to set config '%s': %w", key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// ListDynamicConfigs 列出 ctx 作用域中的所有动态配置项
+func (p *FileProvider) ListDynamicConfigs(ctx context.Context) (map[string]string, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	items := state.Items[ScopeFromContext(ctx).String()]
+	result := make(map[string]string, len(items))
+	for key, item := range items {
+		result[key] = item.Value
+	}
+	return result, nil
+}
+
+// ListScopes 列出存储了动态配置的全部作用域名称（按名称排序）
+func (p *FileProvider) ListScopes(ctx context.Context) ([]string, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, 0, len(state.Items))
+	for scope, items := range state.Items {
+		if len(items) > 0 {
+			scopes = append(scopes, scope)
+		}
+	}
+	sort.Strings(scopes)
+	return scopes, nil
+}
+
+// DeleteConfig 删除动态配置项并记录变更历史
+func (p *FileProvider) DeleteConfig(ctx context.Context, key string) error {
+	return p.update(func(state *fileStoreState) error {
+		return state.delete(ctx, key, anyRevision)
+	})
+}
+
+// DeleteConfigIfRevision 仅当当前修订号等于 expected 时删除
+func (p *FileProvider) DeleteConfigIfRevision(ctx context.Context, key string, expected int) error {
+	return p.update(func(state *fileStoreState) error {
+		return state.delete(ctx, key, expected)
+	})
+}
+
+// GetRevision 返回动态配置项的当前修订号（不存在时为 0）
+func (p *FileProvider) GetRevision(ctx context.Context, key string) (int, error) {
+	state, err := p.read()
+	if err != nil {
+		return 0, err
+	}
+	return state.Items[ScopeFromContext(ctx).String()][key].Revision, nil
+}
+
+// ListHistory 列出配置项在 ctx 作用域中的变更历史（按修订号倒序）
+func (p *FileProvider) ListHistory(ctx context.Context, key string, limit int) ([]ChangeRecord, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	scope := ScopeFromContext(ctx).String()
+	result := []ChangeRecord{}
+	for i := len(state.History) - 1; i >= 0; i-- {
+		if state.History[i].Key != key || state.History[i].Scope != scope {
+			continue
+		}
+		result = append(result, state.History[i])
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// GetHistory 根据修订号获取单条变更记录
+func (p *FileProvider) GetHistory(ctx context.Context, revision int) (*ChangeRecord, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	i := sort.Search(len(state.History), func(i int) bool { return state.History[i].Revision >= revision })
+	if i == len(state.History) || state.History[i].Revision != revision {
+		return nil, fmt.Errorf("config revision not found: %d", revision)
+	}
+	record := state.History[i]
+	return &record, nil
+}
+
+// ListChangesSince 列出修订号大于 revision 且键匹配 prefix 的变更（按修订号正序）
+func (p *FileProvider) ListChangesSince(ctx context.Context, prefix string, revision int, limit int) ([]ChangeRecord, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	start := sort.Search(len(state.History), func(i int) bool { return state.History[i].Revision > revision })
+	result := []ChangeRecord{}
+	for _, record := range state.History[start:] {
+		if !strings.HasPrefix(record.Key, prefix) {
+			continue
+		}
+		result = append(result, record)
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// LatestRevision 返回当前最大的修订号（无变更时为 0）
+func (p *FileProvider) LatestRevision(ctx context.Context) (int, error) {
+	state, err := p.read()
+	if err != nil {
+		return 0, err
+	}
+	return state.Revision, nil
+}
+
+// UpdateHistoryValues 改写变更记录的旧值与新值（重新加密敏感值），不产生新的变更记录
+func (p *FileProvider) UpdateHistoryValues(ctx context.Context, revision int, oldValue, newValue *string) error {
+	return p.update(func(state *fileStoreState) error {
+		i := sort.Search(len(state.History), func(i int) bool { return state.History[i].Revision >= revision })
+		if i == len(state.History) || state.History[i].Revision != revision {
+			return fmt.Errorf("config revision not found: %d", revision)
+		}
+
+		// clone 与当前状态共享历史记录，改写前复制
+		state.History = slices.Clone(state.History)
+		state.History[i].OldValue = oldValue
+		state.History[i].NewValue = newValue
+		return nil
+	})
+}
+
+// CreateSchedule 保存计划变更，作用域、操作人与请求 ID 取自 ctx
+func (p *FileProvider) CreateSchedule(ctx context.Context, schedule *Schedule) error {
+	return p.update(func(state *fileStoreState) error {
+		now := time.Now()
+		created := *schedule
+		created.ID = 1
+		if n := len(state.Schedules); n > 0 {
+			created.ID = state.Schedules[n-1].ID + 1
+		}
+		created.Scope = ScopeFromContext(ctx).String()
+		created.Status = ScheduleStatusPending
+		created.Actor = ActorFromContext(ctx)
+		created.RequestID = RequestIDFromContext(ctx)
+		created.CreatedAt = now
+		created.UpdatedAt = now
+
+		state.Schedules = append(state.Schedules, created)
+		*schedule = created
+		return nil
+	})
+}
+
+// GetSchedule 根据 ID 获取计划变更
+func (p *FileProvider) GetSchedule(ctx context.Context, id int) (*Schedule, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	i := state.scheduleIndex(id)
+	if i < 0 {
+		return nil, fmt.Errorf("config schedule not found: %d", id)
+	}
+	schedule := state.Schedules[i]
+	return &schedule, nil
+}
+
+// ListSchedules 列出状态属于 statuses 的计划变更（按 ID 正序）
+func (p *FileProvider) ListSchedules(ctx context.Context, statuses ...string) ([]Schedule, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Schedule{}
+	for _, schedule := range state.Schedules {
+		if len(statuses) == 0 || slices.Contains(statuses, schedule.Status) {
+			result = append(result, schedule)
+		}
+	}
+	return result, nil
+}
+
+// UpdateSchedule 仅当计划当前状态等于 expected 时更新
+func (p *FileProvider) UpdateSchedule(ctx context.Context, schedule *Schedule, expected string) error {
+	return p.update(func(state *fileStoreState) error {
+		i := state.scheduleIndex(schedule.ID)
+		if i < 0 {
+			return fmt.Errorf("config schedule not found: %d", schedule.ID)
+		}
+		current := &state.Schedules[i]
+		if current.Status != expected {
+			return &ScheduleConflictError{ID: schedule.ID, Expected: expected}
+		}
+
+		current.Status = schedule.Status
+		current.PriorValue = schedule.PriorValue
+		current.AppliedRevision = schedule.AppliedRevision
+		current.Message = schedule.Message
+		current.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+// scheduleIndex 返回计划在 Schedules 中的位置（按 ID 递增排列），不存在时为 -1
+func (s *fileStoreState) scheduleIndex(id int) int {
+	i := sort.Search(len(s.Schedules), func(i int) bool { return s.Schedules[i].ID >= id })
+	if i == len(s.Schedules) || s.Schedules[i].ID != id {
+		return -1
+	}
+	return i
+}
+
+// CreateChangeRequest 保存待审批的变更申请，作用域、申请人与请求 ID 取自 ctx
+func (p *FileProvider) CreateChangeRequest(ctx context.Context, request *ChangeRequest) error {
+	return p.update(func(state *fileStoreState) error {
+		now := time.Now()
+		created := *request
+		created.ID = 1
+		if n := len(state.Changes); n > 0 {
+			created.ID = state.Changes[n-1].ID + 1
+		}
+		created.Scope = ScopeFromContext(ctx).String()
+		created.Status = ChangeRequestStatusPending
+		created.RequestedBy = ActorFromContext(ctx)
+		created.RequestID = RequestIDFromContext(ctx)
+		created.CreatedAt = now
+		created.UpdatedAt = now
+
+		state.Changes = append(state.Changes, created)
+		*request = created
+		return nil
+	})
+}
+
+// GetChangeRequest 根据 ID 获取变更申请
+func (p *FileProvider) GetChangeRequest(ctx context.Context, id int) (*ChangeRequest, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	i := state.changeRequestIndex(id)
+	if i < 0 {
+		return nil, fmt.Errorf("config change request not found: %d", id)
+	}
+	request := state.Changes[i]
+	return &request, nil
+}
+
+// ListChangeRequests 列出状态属于 statuses 的变更申请（按 ID 正序）
+func (p *FileProvider) ListChangeRequests(ctx context.Context, statuses ...string) ([]ChangeRequest, error) {
+	state, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []ChangeRequest{}
+	for _, request := range state.Changes {
+		if len(statuses) == 0 || slices.Contains(statuses, request.Status) {
+			result = append(result, request)
+		}
+	}
+	return result, nil
+}
+
+// UpdateChangeRequest 仅当申请当前状态等于 expected 时更新
+func (p *FileProvider) UpdateChangeRequest(ctx context.Context, request *ChangeRequest, expected string) error {
+	return p.update(func(state *fileStoreState) error {
+		i := state.changeRequestIndex(request.ID)
+		if i < 0 {
+			return fmt.Errorf("config change request not found: %d", request.ID)
+		}
+		current := &state.Changes[i]
+		if current.Status != expected {
+			return &ChangeRequestConflictError{ID: request.ID, Expected: expected}
+		}
+
+		current.Status = request.Status
+		current.ReviewedBy = request.ReviewedBy
+		current.Comment = request.Comment
+		current.Message = request.Message
+		current.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+// changeRequestIndex 返回申请在 Changes 中的位置（按 ID 递增排列），不存在时为 -1
+func (s *fileStoreState) changeRequestIndex(id int) int {
+	i := sort.Search(len(s.Changes), func(i int) bool { return s.Changes[i].ID >= id })
+	if i == len(s.Changes) || s.Changes[i].ID != id {
+		return -1
+	}
+	return i
+}
+
+// read 返回最新的状态（只读，调用方不得修改）
+func (p *FileProvider) read() (*fileStoreState, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.refresh(); err != nil {
+		return nil, err
+	}
+	return p.state, nil
+}
+
+// update 在最新状态的副本上执行 fn，成功后持久化并替换内存状态
+// 从重新读取到写入完成都持有跨进程文件锁，fn 总是基于其他进程的最新写入
+func (p *FileProvider) update(fn func(state *fileStoreState) error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	unlock, err := p.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := p.refresh(); err != nil {
+		return err
+	}
+
+	next := p.state.clone()
+	if err := fn(next); err != nil {
+		return err
+	}
+	if err := p.write(next); err != nil {
+		return err
+	}
+	p.state = next
+	return nil
+}
+
+// lock 取得存储文件的跨进程排他锁（同目录 <path>.lock），返回释放函数（调用方需持有 p.mu）
+// 读取不加锁：写入以 rename 原子替换文件，读取方总是看到完整的内容
+func (p *FileProvider) lock() (func(), error) {
+	f, err := os.OpenFile(p.path+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open config store lock: %w", err)
+	}
+	if err := lockFile(f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to lock config store: %w", err)
+	}
+	return func() {
+		_ = unlockFile(f)
+		f.Close()
+	}, nil
+}
+
+// refresh 文件自上次读取后发生变化（或尚未读取）时重新加载（调用方需持有 p.mu）
+func (p *FileProvider) refresh() error {
+	info, err := os.Stat(p.path)
+	if errors.Is(err, os.ErrNotExist) {
+		if p.state == nil {
+			p.state = newFileStoreState()
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat config store: %w", err)
+	}
+	if p.state != nil && p.info != nil && os.SameFile(info, p.info) &&
+		info.ModTime().Equal(p.info.ModTime()) && info.Size() == p.info.Size() {
+		return nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read config store: %w", err)
+	}
+
+	state := newFileStoreState()
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, state); err != nil {
+			return fmt.Errorf("invalid config store %s: %w", p.path, err)
+		}
+		if state.Version != fileStoreVersion {
+			return fmt.Errorf("unsupported config store version %d in %s", state.Version, p.path)
+		}
+		if state.Items == nil {
+			state.Items = make(map[string]map[string]fileStoreItem)
+		}
+	}
+
+	p.state = state
+	p.info = info
+	return nil
+}
+
+// write 原子地持久化 state（调用方需持有 p.mu）
+func (p *FileProvider) write(state *fileStoreState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config store: %w", err)
+	}
+	if err := writeFileAtomic(p.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config store: %w", err)
+	}
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat config store: %w", err)
+	}
+	p.info = info
+	return nil
+}
+
+// writeFileAtomic 将 data 写入同目录临时文件并 fsync，再 rename 覆盖 path，
+// 最后 fsync 目录使 rename 持久化
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // rename 成功后临时文件已不存在
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	// 并非所有平台都支持（如 Windows），此时 rename 本身已经成功
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		d.Close()
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestFileProvider 在临时目录中创建 FileProvider
+func newTestFileProvider(t *testing.T) *FileProvider {
+	t.Helper()
+
+	provider, err := NewFileProvider(filepath.Join(t.TempDir(), "data", "config-store.json"))
+	require.NoError(t, err)
+	return provider
+}
+
+// TestFileProvider_CRUD 测试读写、修订号与乐观并发冲突，语义与 Repository 一致
+func TestFileProvider_CRUD(t *testing.T) {
+	provider := newTestFileProvider(t)
+	ctx := WithActor(context.Background(), "alice")
+
+	// 创建时即写入空存储文件
+	_, err := os.Stat(provider.Path())
+	require.NoError(t, err)
+
+	_, _, err = provider.GetConfig(ctx, "app.name")
+	assert.EqualError(t, err, "config key not found: app.name")
+
+	require.NoError(t, provider.SetConfigIfRevision(ctx, "app.name", "first", 0))
+	value, exists, err := provider.GetConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "first", value)
+
+	revision, err := provider.GetRevision(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, 1, revision)
+
+	var conflict *RevisionConflictError
+	err = provider.SetConfigIfRevision(ctx, "app.name", "stale", 0)
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, 0, conflict.Expected)
+
+	require.NoError(t, provider.SetConfigIfRevision(ctx, "app.name", "second", 1))
+	require.NoError(t, provider.SetConfigs(ctx, map[string]string{"poc.enabled": "true", "app.version": "2.0.0"}))
+
+	configs, err := provider.ListDynamicConfigs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.name": "second", "poc.enabled": "true", "app.version": "2.0.0"}, configs)
+
+	err = provider.DeleteConfigIfRevision(ctx, "app.name", 1)
+	require.True(t, errors.As(err, &conflict))
+	require.NoError(t, provider.DeleteConfigIfRevision(ctx, "app.name", 2))
+	assert.EqualError(t, provider.DeleteConfig(ctx, "app.name"), "config key not found: app.name")
+
+	revision, err = provider.GetRevision(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, 0, revision)
+
+	latest, err := provider.LatestRevision(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, latest)
+}
+
+// TestFileProvider_ScopesAndHistory 测试作用域隔离与变更历史
+func TestFileProvider_ScopesAndHistory(t *testing.T) {
+	provider := newTestFileProvider(t)
+	ctx := WithActor(context.Background(), "alice")
+	scope, err := ParseScope("project:alpha")
+	require.NoError(t, err)
+	projectCtx := WithScope(ctx, scope)
+
+	require.NoError(t, provider.SetConfig(ctx, "app.name", "global-app"))
+	require.NoError(t, provider.SetConfig(projectCtx, "app.name", "alpha-app"))
+	require.NoError(t, provider.SetConfig(ctx, "app.name", "global-app-2"))
+
+	value, _, err := provider.GetConfig(projectCtx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "alpha-app", value)
+
+	scopes, err := provider.ListScopes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"global", "project:alpha"}, scopes)
+
+	history, err := provider.ListHistory(ctx, "app.name", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 3, history[0].Revision)
+	assert.Equal(t, "global-app", *history[0].OldValue)
+	assert.Equal(t, "alice", history[0].Actor)
+	assert.Equal(t, ChangeActionSet, history[0].Action)
+	assert.Nil(t, history[1].OldValue)
+
+	record, err := provider.GetHistory(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "project:alpha", record.Scope)
+	_, err = provider.GetHistory(ctx, 9)
+	assert.Error(t, err)
+
+	changes, err := provider.ListChangesSince(ctx, "app.", 1, 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, 2, changes[0].Revision)
+	assert.Equal(t, 3, changes[1].Revision)
+
+	changes, err = provider.ListChangesSince(ctx, "poc.", 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// 改写历史值（重新加密），不产生新的变更记录
+	rewritten := "enc:v1:rewritten"
+	require.NoError(t, provider.UpdateHistoryValues(ctx, 2, nil, &rewritten))
+	record, err = provider.GetHistory(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, rewritten, *record.NewValue)
+	assert.Nil(t, record.OldValue)
+	latest, err := provider.LatestRevision(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, latest)
+	assert.Error(t, provider.UpdateHistoryValues(ctx, 9, nil, nil))
+}
+
+// TestFileProvider_Persistence 测试重新打开后数据仍在，且能读到其他进程写入的变更
+func TestFileProvider_Persistence(t *testing.T) {
+	provider := newTestFileProvider(t)
+	ctx := context.Background()
+
+	require.NoError(t, provider.SetConfig(ctx, "app.name", "persisted"))
+
+	other, err := NewFileProvider(provider.Path())
+	require.NoError(t, err)
+	value, _, err := other.GetConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "persisted", value)
+
+	// 另一个进程写入后，原实例下次访问时重新读取
+	require.NoError(t, other.SetConfig(ctx, "app.name", "changed-elsewhere"))
+	value, _, err = provider.GetConfig(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "changed-elsewhere", value)
+
+	revision, err := provider.GetRevision(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, 2, revision)
+
+	// 原子写入不留下临时文件，只有存储文件与锁文件
+	entries, err := os.ReadDir(filepath.Dir(provider.Path()))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "config-store.json", entries[0].Name())
+	assert.Equal(t, "config-store.json.lock", entries[1].Name())
+}
+
+// TestFileProvider_ConcurrentWriters 测试多个实例（模拟服务与 apprunctl --offline 两个进程）
+// 并发写入同一文件：不丢失更新，修订号不重复
+func TestFileProvider_ConcurrentWriters(t *testing.T) {
+	first := newTestFileProvider(t)
+	second, err := NewFileProvider(first.Path())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	const writes = 20
+	var wg sync.WaitGroup
+	for i, provider := range []*FileProvider{first, second} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < writes; j++ {
+				assert.NoError(t, provider.SetConfig(ctx, fmt.Sprintf("writer%d.key%d", i, j), "value"))
+			}
+		}()
+	}
+	wg.Wait()
+
+	reopened, err := NewFileProvider(first.Path())
+	require.NoError(t, err)
+	configs, err := reopened.ListDynamicConfigs(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 2*writes)
+
+	changes, err := reopened.ListChangesSince(ctx, "", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 2*writes)
+	for i, change := range changes {
+		assert.Equal(t, i+1, change.Revision)
+	}
+}
+
+// TestFileProvider_Invalid 测试损坏的存储文件报错，不会被空状态覆盖
+func TestFileProvider_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config-store.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0600))
+
+	_, err := NewFileProvider(path)
+	assert.ErrorContains(t, err, "invalid config store")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{not json", string(data))
+}
+
+// TestFileProvider_Service 测试配置服务使用 FileProvider：写入后重启仍然生效
+func TestFileProvider_Service(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+	storePath := filepath.Join(tmpDir, "config-store.json")
+
+	newService := func() *Service {
+		store, err := OpenProvider(&StoreConfig{Provider: StoreProviderFile, File: storePath}, nil)
+		require.NoError(t, err)
+		service, err := NewBootstrap(tmpDir).CreateServiceWithProvider(context.Background(), store)
+		require.NoError(t, err)
+		return service
+	}
+
+	require.NoError(t, newService().UpdateConfig(context.Background(), "app.name", "from-file-store"))
+	assert.Equal(t, "from-file-store", newService().GetConfig().App.Name)
+
+	_, err := OpenProvider(&StoreConfig{Provider: StoreProviderDatabase}, nil)
+	assert.Error(t, err)
+}
+
+// TestFileProvider_ServiceWithoutDatabase 测试使用 FileProvider 时不校验 database 段，其他存储仍要求密码
+func TestFileProvider_ServiceWithoutDatabase(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(strings.Replace(validDefaultYAML, `  password: "testpassword123"`+"\n", "", 1)), 0644))
+
+	store, err := NewFileProvider(filepath.Join(tmpDir, "config-store.json"))
+	require.NoError(t, err)
+	service, err := NewBootstrap(tmpDir).CreateServiceWithProvider(context.Background(), store)
+	require.NoError(t, err)
+
+	resp, err := service.ValidateConfigs(context.Background(), map[string]string{"app.name": "checked"})
+	require.NoError(t, err)
+	assert.True(t, resp.Valid, "errors: %v", resp.Errors)
+
+	_, err = NewBootstrap(tmpDir).CreateServiceWithProvider(context.Background(), newMockProvider())
+	assert.ErrorContains(t, err, "Password")
+}
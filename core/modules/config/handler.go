@@ -1,44 +1,114 @@
 package config
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"apprun/pkg/response"
 
 	"github.com/go-chi/chi/v5"
 )
 
+// Watch endpoint defaults
+const (
+	watchBatchSize          = 100              // 每次从历史中读取的最大变更数
+	watchHeartbeatInterval  = 15 * time.Second // SSE 心跳间隔（同时用于补查遗漏的变更）
+	watchPollDefaultTimeout = 30 * time.Second // 长轮询默认等待时间
+	watchPollMaxTimeout     = 60 * time.Second // 长轮询最大等待时间
+	watchGapGrace           = 2 * time.Second  // 修订号空缺（较小修订号的事务尚未提交）最长等待时间
+	watchGapTTL             = time.Minute      // SSE 检查被跳过的修订号是否迟到提交的时长
+	importMaxBodySize       = 1 << 20          // 导入文档最大字节数
+)
+
 // Handler 配置管理 HTTP 处理器
 type Handler struct {
-	service *Service
+	service   *Service
+	heartbeat time.Duration // SSE 心跳间隔
+	gapGrace  time.Duration // 修订号空缺最长等待时间
 }
 
 // NewHandler 创建处理器实例
 func NewHandler(service *Service) *Handler {
-	return &Handler{service: service}
+	return &Handler{
+		service:   service,
+		heartbeat: watchHeartbeatInterval,
+		gapGrace:  watchGapGrace,
+	}
 }
 
 // RegisterRoutes 注册路由到 chi.Router
 // 注意：此方法应在 /api 路由组内调用，会注册 /config 子路由
 func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Route("/config", func(r chi.Router) {
-		r.Get("/", h.GetConfig)             // GET /api/config?key=xxx
-		r.Put("/", h.UpdateConfig)          // PUT /api/config
-		r.Get("/list", h.ListConfigs)       // GET /api/config/list
-		r.Delete("/", h.DeleteConfig)       // DELETE /api/config?key=xxx
-		r.Get("/allowed", h.GetAllowedKeys) // GET /api/config/allowed
+		r.Use(h.scopeMiddleware) // ?scope=global|project:<id>|project:<id>/user:<id>
+
+		r.Get("/", h.GetConfig)               // GET /api/config?key=xxx
+		r.Put("/", h.UpdateConfig)            // PUT /api/config
+		r.Put("/batch", h.BatchUpdateConfig)  // PUT /api/config/batch
+		r.Post("/validate", h.ValidateConfig) // POST /api/config/validate
+		r.Get("/list", h.ListConfigs)         // GET /api/config/list
+		r.Delete("/", h.DeleteConfig)         // DELETE /api/config?key=xxx
+		r.Get("/allowed", h.GetAllowedKeys)   // GET /api/config/allowed
+		r.Get("/explain", h.ExplainConfig)    // GET /api/config/explain?key=xxx
+		r.Get("/export", h.ExportConfig)      // GET /api/config/export?format=yaml|json
+		r.Post("/import", h.ImportConfig)     // POST /api/config/import?dry_run=true
+		r.Get("/schema", h.GetSchema)         // GET /api/config/schema
+
+		r.Get("/history", h.ListHistory)              // GET /api/config/history?key=xxx
+		r.Post("/history/rollback", h.RollbackConfig) // POST /api/config/history/rollback
+
+		r.Get("/schedules", h.ListSchedules)          // GET /api/config/schedules?status=pending,active
+		r.Delete("/schedules/{id}", h.CancelSchedule) // DELETE /api/config/schedules/{id}
+
+		r.Get("/changes", h.ListChangeRequests)                 // GET /api/config/changes?status=pending
+		r.Get("/changes/{id}", h.GetChangeRequest)              // GET /api/config/changes/{id}
+		r.Post("/changes/{id}/approve", h.ApproveChangeRequest) // POST /api/config/changes/{id}/approve
+		r.Post("/changes/{id}/reject", h.RejectChangeRequest)   // POST /api/config/changes/{id}/reject
+
+		r.Get("/watch", h.Watch)            // GET /api/config/watch?prefix=xxx (SSE)
+		r.Get("/watch/poll", h.PollChanges) // GET /api/config/watch/poll?prefix=xxx&revision=N
+
+		r.Get("/reload/status", h.GetReloadStatus) // GET /api/config/reload/status
+		r.Get("/sync/status", h.GetSyncStatus)     // GET /api/config/sync/status
+	})
+}
+
+// scopeMiddleware 解析 scope 查询参数（默认 global）并放入请求 context，非法时返回 422
+// 动态配置的读写均作用于该作用域，读取时按 user → project → global 回退
+func (h *Handler) scopeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope, err := ParseScope(r.URL.Query().Get("scope"))
+		if err != nil {
+			response.ValidationErrorWithRequest(w, r, "scope", err.Error())
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithScope(r.Context(), scope)))
 	})
 }
 
+// changeContext 从请求中提取变更操作人（X-Actor 头），用于记录配置变更历史
+func (h *Handler) changeContext(r *http.Request) context.Context {
+	return WithActor(r.Context(), r.Header.Get(ActorHeader))
+}
+
 // GetConfig 获取配置值（查询单个配置项）
 // @Summary      Get configuration item
 // @Description  Query a single configuration item by key, returns value, source and dynamic flag
+// @Description  Values of secret configuration items (secret:"true") are masked.
 // @Tags         config
 // @Accept       json
 // @Produce      json
-// @Param        key  query  string  true  "Configuration key, e.g. app.name"
-// @Success      200  {object}  GetConfigResponse  "Configuration retrieved successfully"
+// @Param        key    query  string  true   "Configuration key, e.g. app.name"
+// @Param        scope  query  string  false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  GetConfigResponse  "Configuration retrieved successfully (ETag header carries the revision)"
+// @Header       200  {string}  ETag               "Database revision of the key, e.g. \"3\""
 // @Failure      400  {object}  response.Response  "Missing key parameter"
 // @Failure      404  {object}  response.Response  "Configuration not found"
 // @Router       /config [get]
@@ -49,7 +119,7 @@ func (h *Handler) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	value, source, err := h.service.GetConfigValue(r.Context(), key)
+	value, source, fromScope, err := h.service.lookupConfigValue(r.Context(), key)
 	if err != nil {
 		response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, "config not found: "+err.Error())
 		return
@@ -59,11 +129,159 @@ func (h *Handler) GetConfig(w http.ResponseWriter, r *http.Request) {
 	// regardless of its current source
 	isDynamic := h.service.loader.AllowDatabaseStorage(key)
 
+	revision := 0
+	if isDynamic {
+		revision, err = h.service.GetConfigRevision(r.Context(), key)
+		if err != nil {
+			response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+			return
+		}
+		w.Header().Set("ETag", formatETag(revision))
+	}
+
 	resp := GetConfigResponse{
 		Key:       key,
-		Value:     value,
+		Value:     h.service.DisplayValue(key, value),
 		IsDynamic: isDynamic,
 		Source:    source,
+		Scope:     ScopeFromContext(r.Context()).String(),
+		Revision:  revision,
+	}
+	switch {
+	case source == "database":
+		resp.FromScope = fromScope.String()
+	case source == "file" || strings.HasPrefix(source, "profile:"):
+		resp.SourceFile = h.service.Snapshot().SourceFile(key)
+	}
+
+	response.SuccessWithRequest(w, r, resp)
+}
+
+// ExplainConfig 解释配置项的取值来源
+// @Summary      Explain configuration item
+// @Description  List the value every layer contributes for a key, lowest precedence first:
+// @Description  tag default, default.yaml, specialized files, each conf_d file by name, database and the env var.
+// @Description  The layer in effect is marked; database values of db:false keys are marked as ignored.
+// @Description  Values of secret configuration items (secret:"true") are masked.
+// @Tags         config
+// @Accept       json
+// @Produce      json
+// @Param        key    query  string  true   "Configuration key, e.g. poc.enabled"
+// @Param        scope  query  string  false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  ExplainConfigResponse  "Per-layer provenance of the key"
+// @Failure      404  {object}  response.Response      "Configuration not found"
+// @Failure      422  {object}  response.Response      "Missing key parameter"
+// @Router       /config/explain [get]
+func (h *Handler) ExplainConfig(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		response.ValidationErrorWithRequest(w, r, "key", "missing 'key' query parameter")
+		return
+	}
+
+	resp, err := h.service.ExplainConfig(r.Context(), key)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, "config not found: "+err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, resp)
+}
+
+// GetSchema 获取配置的 JSON Schema
+// @Summary      Get configuration JSON Schema
+// @Description  JSON Schema (draft 2020-12) of the application config and all registered modules, generated from the struct tags:
+// @Description  types, defaults, enums (oneof), min/max and other validate rules.
+// @Description  x-dynamic marks keys that can be changed via the API (db:true), x-secret marks secret keys.
+// @Tags         config
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}  "JSON Schema document (application/schema+json)"
+// @Router       /config/schema [get]
+func (h *Handler) GetSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(h.service.ConfigSchema())
+}
+
+// ExportConfig 导出当前生效的完整配置
+// @Summary      Export effective configuration
+// @Description  Render the fully merged configuration (application config and all registered modules) as a YAML or JSON document.
+// @Description  Secret configuration items (secret:"true") are redacted. The document can be posted to /config/import.
+// @Tags         config
+// @Produce      json
+// @Produce      application/x-yaml
+// @Param        format  query  string  false  "Document format"  Enums(yaml, json)  default(yaml)
+// @Success      200  {object}  map[string]interface{}  "Nested configuration document"
+// @Failure      422  {object}  response.Response       "Unsupported format"
+// @Failure      500  {object}  response.Response       "Config not loaded"
+// @Router       /config/export [get]
+func (h *Handler) ExportConfig(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	contentType := "application/x-yaml"
+	switch format {
+	case "", ExportFormatYAML:
+		format = ExportFormatYAML
+	case ExportFormatJSON:
+		contentType = "application/json"
+	default:
+		response.ValidationErrorWithRequest(w, r, "format", "format must be 'yaml' or 'json'")
+		return
+	}
+
+	data, err := h.service.ExportConfig(format)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"config.%s\"", format))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// ImportConfig 导入配置文档
+// @Summary      Import configuration
+// @Description  Compare a YAML or JSON configuration document (e.g. from /config/export) with the effective configuration.
+// @Description  Only dynamic keys (db:true) are applied, with the same validation as PUT /config, in a single transaction.
+// @Description  Static keys (db:false), unknown keys and redacted secrets are reported as skipped.
+// @Description  With dry_run=true only the diff is returned. When any key is invalid nothing is applied.
+// @Tags         config
+// @Accept       json
+// @Accept       application/x-yaml
+// @Produce      json
+// @Param        document  body    object  true   "Nested configuration document"
+// @Param        dry_run   query   bool    false  "Only return the diff"  default(false)
+// @Param        X-Actor   header  string  false  "Operator recorded in config history"
+// @Param        scope     query   string  false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  ImportConfigResponse  "Per-key diff and number of applied keys"
+// @Failure      400  {object}  response.Response     "Invalid document or rejected import, error details carry the per-key diff"
+// @Failure      422  {object}  response.Response     "Invalid dry_run parameter"
+// @Router       /config/import [post]
+func (h *Handler) ImportConfig(w http.ResponseWriter, r *http.Request) {
+	dryRun := false
+	if raw := r.URL.Query().Get("dry_run"); raw != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(raw); err != nil {
+			response.ValidationErrorWithRequest(w, r, "dry_run", "dry_run must be a boolean")
+			return
+		}
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, importMaxBodySize))
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "invalid request body: "+err.Error())
+		return
+	}
+
+	resp, err := h.service.ImportConfig(h.changeContext(r), data, dryRun)
+	if err != nil {
+		if resp != nil {
+			response.ErrorWithDetailsAndRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, err.Error(), resp)
+			return
+		}
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, err.Error())
+		return
 	}
 
 	response.SuccessWithRequest(w, r, resp)
@@ -74,12 +292,27 @@ func (h *Handler) GetConfig(w http.ResponseWriter, r *http.Request) {
 // @Description  Update a single dynamic configuration item (only for db:true configs).
 // @Description  Static configurations (db:false) cannot be updated via API.
 // @Description  Changes are persisted to database and take effect immediately.
+// @Description  The value is a JSON value of the field type, e.g. true, 8080, "30s" or ["stdout","file:/var/log/a.log"];
+// @Description  a JSON string is parsed as the field type, so "true" and true are equivalent.
+// @Description  Send the ETag from GET /config as If-Match to reject the update when the key was changed concurrently.
+// @Description  With effective_at and/or expires_at (RFC 3339) the change is scheduled instead and the response data is a Schedule:
+// @Description  it is applied at effective_at (now if omitted) and the prior value is restored at expires_at.
+// @Description  Schedules are persisted and survive restarts; list them with GET /config/schedules, cancel with DELETE /config/schedules/{id}.
+// @Description  Keys tagged approval:"required" (x-approval in GET /config/schema) are not written directly: the response is 202
+// @Description  with a pending ChangeRequest that another operator approves via POST /config/changes/{id}/approve. X-Actor is required for them.
 // @Tags         config
 // @Accept       json
 // @Produce      json
-// @Param        request  body  UpdateConfigRequest  true  "Configuration update request"  example({"key":"poc.enabled","value":"true"})
-// @Success      200  {object}  UpdateConfigResponse  "Configuration updated successfully"
-// @Failure      400  {object}  response.Response     "Invalid request or config not allowed to store in database"
+// @Param        request   body    UpdateConfigRequest  true   "Configuration update request"  example({"key":"logger.level","value":"debug","expires_at":"2025-12-31T22:30:00Z"})
+// @Param        X-Actor   header  string               false  "Operator recorded in config history"
+// @Param        If-Match  header  string               false  "Expected revision as returned in ETag, e.g. \"3\" (\"0\" = not stored yet)"
+// @Param        scope     query   string               false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  UpdateConfigResponse  "Configuration updated successfully (ETag header carries the new revision); a Schedule when effective_at or expires_at is set"
+// @Success      202  {object}  ChangeRequest         "Change request created for a key that requires approval (Location header points to it)"
+// @Failure      400  {object}  response.Response     "Invalid request, invalid schedule times or config not allowed to store in database"
+// @Failure      403  {object}  response.Response     "Scheduled change of a key that requires approval"
+// @Failure      409  {object}  response.Response     "Revision mismatch, error details carry the current value and revision"
+// @Failure      422  {object}  response.Response     "Missing field, malformed If-Match header, If-Match on a scheduled change or approval request, missing X-Actor or invalid scope"
 // @Router       /config [put]
 func (h *Handler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 	var req UpdateConfigRequest
@@ -93,30 +326,241 @@ func (h *Handler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 		response.ValidationErrorWithRequest(w, r, "key", "missing 'key' field")
 		return
 	}
-	if req.Value == "" {
-		response.ValidationErrorWithRequest(w, r, "value", "missing 'value' field")
+	value, err := requestValue(req.Value)
+	if err != nil {
+		response.ValidationErrorWithRequest(w, r, "value", err.Error())
+		return
+	}
+
+	expected, err := parseIfMatch(r)
+	if err != nil {
+		response.ValidationErrorWithRequest(w, r, "If-Match", err.Error())
+		return
+	}
+
+	// 计划变更：在 effective_at 生效，到 expires_at 恢复
+	if req.EffectiveAt != nil || req.ExpiresAt != nil {
+		if expected != anyRevision {
+			response.ValidationErrorWithRequest(w, r, "If-Match", "If-Match cannot be combined with effective_at or expires_at")
+			return
+		}
+		h.scheduleConfig(w, r, req, value)
+		return
+	}
+
+	// 需要审批的配置项：创建变更申请，批准后才写入
+	if h.service.RequiresApproval(req.Key) {
+		if expected != anyRevision {
+			response.ValidationErrorWithRequest(w, r, "If-Match", "If-Match cannot be used for a key that requires approval")
+			return
+		}
+		h.requestChange(w, r, req.Key, value)
 		return
 	}
 
 	// 更新配置
-	if err := h.service.UpdateConfig(r.Context(), req.Key, req.Value); err != nil {
+	if err := h.service.UpdateConfigIfMatch(h.changeContext(r), req.Key, value, expected); err != nil {
+		if h.writeConflict(w, r, req.Key, err) {
+			return
+		}
 		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to update config: "+err.Error())
 		return
 	}
 
-	resp := UpdateConfigResponse(req)
+	if revision, err := h.service.GetConfigRevision(r.Context(), req.Key); err == nil {
+		w.Header().Set("ETag", formatETag(revision))
+	}
+
+	resp := UpdateConfigResponse{
+		Key:   req.Key,
+		Value: h.service.DisplayValue(req.Key, value),
+	}
+
+	response.SuccessWithRequest(w, r, resp)
+}
+
+// scheduleConfig 创建计划变更，返回脱敏后的计划
+func (h *Handler) scheduleConfig(w http.ResponseWriter, r *http.Request, req UpdateConfigRequest, value string) {
+	effectiveAt := time.Now()
+	if req.EffectiveAt != nil {
+		effectiveAt = *req.EffectiveAt
+	}
+
+	schedule, err := h.service.ScheduleConfig(h.changeContext(r), req.Key, value, effectiveAt, req.ExpiresAt)
+	if err != nil {
+		if h.writeApprovalRequired(w, r, err) {
+			return
+		}
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to schedule config: "+err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, h.service.MaskSchedule(*schedule))
+}
+
+// requestChange 创建变更申请，返回 202 及脱敏后的申请
+func (h *Handler) requestChange(w http.ResponseWriter, r *http.Request, key string, value string) {
+	if r.Header.Get(ActorHeader) == "" {
+		response.ValidationErrorWithRequest(w, r, ActorHeader, "an operator is required for a key that requires approval")
+		return
+	}
+
+	request, err := h.service.RequestChange(h.changeContext(r), key, value)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to request config change: "+err.Error())
+		return
+	}
+
+	location := fmt.Sprintf("/api/config/changes/%d", request.ID)
+	response.AcceptedWithRequest(w, r, h.service.MaskChangeRequest(*request), location)
+}
+
+// BatchUpdateConfig 批量更新动态配置项
+// @Summary      Update configuration items in a batch
+// @Description  Update several dynamic configuration items (db:true) together.
+// @Description  The merged configuration is validated once with all changes applied, then every key is written in a single transaction.
+// @Description  Either all items are applied or none of them.
+// @Description  Keys that require approval (approval:"required") are rejected; change them one at a time with PUT /config.
+// @Tags         config
+// @Accept       json
+// @Produce      json
+// @Param        request  body    BatchUpdateConfigRequest  true   "Batch update request"  example({"items":[{"key":"poc.enabled","value":"true"},{"key":"poc.api_key","value":"new-api-key-123"}]})
+// @Param        X-Actor  header  string                    false  "Operator recorded in config history"
+// @Param        scope    query   string                    false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  BatchUpdateConfigResponse  "All configuration items updated"
+// @Failure      400  {object}  response.Response          "Invalid request or validation failed, nothing applied"
+// @Failure      403  {object}  response.Response          "A key requires approval"
+// @Failure      422  {object}  response.Response          "Empty batch, missing field or duplicate key"
+// @Router       /config/batch [put]
+func (h *Handler) BatchUpdateConfig(w http.ResponseWriter, r *http.Request) {
+	var req BatchUpdateConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "invalid request body: "+err.Error())
+		return
+	}
+
+	if len(req.Items) == 0 {
+		response.ValidationErrorWithRequest(w, r, "items", "missing 'items' field")
+		return
+	}
+
+	items := make(map[string]string, len(req.Items))
+	for i, item := range req.Items {
+		if item.Key == "" {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d].key", i), "missing 'key' field")
+			return
+		}
+		value, err := requestValue(item.Value)
+		if err != nil {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d].value", i), err.Error())
+			return
+		}
+		if item.EffectiveAt != nil || item.ExpiresAt != nil {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d]", i), "effective_at and expires_at are only supported by PUT /config")
+			return
+		}
+		if _, dup := items[item.Key]; dup {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d].key", i), "duplicate key: "+item.Key)
+			return
+		}
+		items[item.Key] = value
+	}
+	if err := h.service.UpdateConfigs(h.changeContext(r), items); err != nil {
+		if h.writeApprovalRequired(w, r, err) {
+			return
+		}
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to update configs: "+err.Error())
+		return
+	}
+
+	resp := BatchUpdateConfigResponse{
+		Items: make([]UpdateConfigResponse, 0, len(req.Items)),
+		Count: len(req.Items),
+	}
+	for _, item := range req.Items {
+		resp.Items = append(resp.Items, UpdateConfigResponse{
+			Key:   item.Key,
+			Value: h.service.DisplayValue(item.Key, items[item.Key]),
+		})
+	}
+
+	response.SuccessWithRequest(w, r, resp)
+}
+
+// ValidateConfig 试运行校验配置变更
+// @Summary      Validate configuration changes (dry run)
+// @Description  Run one or more key/value pairs through the same checks as PUT /config/batch without persisting anything:
+// @Description  each key must be dynamic (db:true), each value must parse as the field type and satisfy its validate tag,
+// @Description  then the merged configuration (including registered modules) is validated as a whole.
+// @Description  Every failure is returned as a structured field error; values of secret items are masked.
+// @Tags         config
+// @Accept       json
+// @Produce      json
+// @Param        request  body   ValidateConfigRequest  true   "Changes to validate"  example({"items":[{"key":"poc.enabled","value":true},{"key":"poc.api_key","value":"short"}]})
+// @Param        scope    query  string                 false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  ValidateConfigResponse  "Validation result (valid is false when any error is reported)"
+// @Failure      400  {object}  response.Response       "Invalid request body"
+// @Failure      422  {object}  response.Response       "Empty items, missing field or duplicate key"
+// @Router       /config/validate [post]
+func (h *Handler) ValidateConfig(w http.ResponseWriter, r *http.Request) {
+	var req ValidateConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "invalid request body: "+err.Error())
+		return
+	}
+
+	if len(req.Items) == 0 {
+		response.ValidationErrorWithRequest(w, r, "items", "missing 'items' field")
+		return
+	}
+
+	// 与批量更新不同，空字符串也参与校验（由 required 等规则给出结构化错误）
+	items := make(map[string]string, len(req.Items))
+	for i, item := range req.Items {
+		if item.Key == "" {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d].key", i), "missing 'key' field")
+			return
+		}
+		if item.Value == nil {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d].value", i), "missing 'value' field")
+			return
+		}
+		value, err := valueText(item.Value)
+		if err != nil {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d].value", i), err.Error())
+			return
+		}
+		if item.EffectiveAt != nil || item.ExpiresAt != nil {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d]", i), "effective_at and expires_at are only supported by PUT /config")
+			return
+		}
+		if _, dup := items[item.Key]; dup {
+			response.ValidationErrorWithRequest(w, r, fmt.Sprintf("items[%d].key", i), "duplicate key: "+item.Key)
+			return
+		}
+		items[item.Key] = value
+	}
+
+	resp, err := h.service.ValidateConfigs(r.Context(), items)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, "failed to validate configs: "+err.Error())
+		return
+	}
 
 	response.SuccessWithRequest(w, r, resp)
 }
 
 // ListConfigs 列出所有动态配置项
 // @Summary      List dynamic configurations
-// @Description  Returns all dynamic configuration items stored in database.
+// @Description  Returns all dynamic configuration items stored in database for the requested scope
+// @Description  (values inherited from parent scopes are not included).
 // @Description  This does not include static configurations from files.
 // @Description  Use this to see which configs have been overridden dynamically.
+// @Description  Values of secret configuration items (secret:"true") are masked.
 // @Tags         config
 // @Accept       json
 // @Produce      json
+// @Param        scope  query  string  false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
 // @Success      200  {object}  ListConfigsResponse  "Configuration list"
 // @Failure      500  {object}  response.Response    "Internal server error"
 // @Router       /config/list [get]
@@ -127,8 +571,14 @@ func (h *Handler) ListConfigs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	values := make(map[string]interface{}, len(configs))
+	for key, value := range configs {
+		values[key] = h.service.DisplayValue(key, value)
+	}
+
 	resp := ListConfigsResponse{
-		Configs: configs,
+		Scope:   ScopeFromContext(r.Context()).String(),
+		Configs: values,
 		Count:   len(configs),
 	}
 
@@ -140,12 +590,19 @@ func (h *Handler) ListConfigs(w http.ResponseWriter, r *http.Request) {
 // @Description  Delete a dynamic configuration item from database (config will fallback to file or default value).
 // @Description  Only dynamic configurations (db:true) can be deleted.
 // @Description  After deletion, the config will use the value from config files or built-in defaults.
+// @Description  Keys that require approval (approval:"required") cannot be deleted through the API.
 // @Tags         config
 // @Accept       json
 // @Produce      json
-// @Param        key  query  string  true  "Configuration key"  example(poc.enabled)
+// @Param        key       query   string  true   "Configuration key"  example(poc.enabled)
+// @Param        X-Actor   header  string  false  "Operator recorded in config history"
+// @Param        If-Match  header  string  false  "Expected revision as returned in ETag, e.g. \"3\""
+// @Param        scope     query   string  false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
 // @Success      200  {object}  map[string]interface{}  "Deletion successful"
 // @Failure      400  {object}  response.Response       "Missing key parameter or deletion failed"
+// @Failure      403  {object}  response.Response       "The key requires approval"
+// @Failure      409  {object}  response.Response       "Revision mismatch, error details carry the current value and revision"
+// @Failure      422  {object}  response.Response       "Malformed If-Match header"
 // @Router       /config [delete]
 func (h *Handler) DeleteConfig(w http.ResponseWriter, r *http.Request) {
 	key := r.URL.Query().Get("key")
@@ -154,7 +611,16 @@ func (h *Handler) DeleteConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.service.DeleteDynamicConfig(r.Context(), key); err != nil {
+	expected, err := parseIfMatch(r)
+	if err != nil {
+		response.ValidationErrorWithRequest(w, r, "If-Match", err.Error())
+		return
+	}
+
+	if err := h.service.DeleteDynamicConfigIfMatch(h.changeContext(r), key, expected); err != nil {
+		if h.writeConflict(w, r, key, err) || h.writeApprovalRequired(w, r, err) {
+			return
+		}
 		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to delete config: "+err.Error())
 		return
 	}
@@ -164,6 +630,67 @@ func (h *Handler) DeleteConfig(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// writeApprovalRequired 若 err 为 *ApprovalRequiredError，返回 403：
+// 需要审批的配置项只能通过 PUT /config 创建变更申请，不能批量、计划、删除或回滚
+func (h *Handler) writeApprovalRequired(w http.ResponseWriter, r *http.Request, err error) bool {
+	var required *ApprovalRequiredError
+	if !errors.As(err, &required) {
+		return false
+	}
+	response.ErrorWithRequest(w, r, http.StatusForbidden, response.ErrCodeForbidden, required.Error())
+	return true
+}
+
+// writeConflict 若 err 为修订号冲突，返回 409 及当前值和修订号，供客户端重新提交
+func (h *Handler) writeConflict(w http.ResponseWriter, r *http.Request, key string, err error) bool {
+	var conflict *RevisionConflictError
+	if !errors.As(err, &conflict) {
+		return false
+	}
+
+	details := ConflictDetails{Key: key}
+	currentValue, _, _ := h.service.GetConfigValue(r.Context(), key)
+	details.CurrentValue = h.service.DisplayValue(key, currentValue)
+	details.CurrentRevision, _ = h.service.GetConfigRevision(r.Context(), key)
+
+	w.Header().Set("ETag", formatETag(details.CurrentRevision))
+	response.ErrorWithDetailsAndRequest(w, r, http.StatusConflict, response.ErrCodeConflict, conflict.Error(), details)
+	return true
+}
+
+// requestValue 将请求中的 JSON 值转换为文本形式，由服务层按字段类型解析
+func requestValue(value interface{}) (string, error) {
+	if value == nil || value == "" {
+		return "", fmt.Errorf("missing 'value' field")
+	}
+	return valueText(value)
+}
+
+// formatETag 将修订号格式化为强 ETag
+func formatETag(revision int) string {
+	return strconv.Quote(strconv.Itoa(revision))
+}
+
+// parseIfMatch 解析 If-Match 头中的修订号；未提供或为 * 时不做并发检查
+func parseIfMatch(r *http.Request) (int, error) {
+	raw := strings.TrimSpace(r.Header.Get("If-Match"))
+	if raw == "" || raw == "*" {
+		return anyRevision, nil
+	}
+
+	tag := strings.TrimPrefix(raw, "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		unquoted = tag
+	}
+
+	revision, err := strconv.Atoi(unquoted)
+	if err != nil || revision < 0 {
+		return 0, fmt.Errorf("If-Match must be a revision ETag such as \"3\"")
+	}
+	return revision, nil
+}
+
 // GetAllowedKeys 获取所有允许动态配置的键（db:true）
 // @Summary      Get allowed configuration keys
 // @Description  Returns all configuration keys marked as db:true (can be modified dynamically via API).
@@ -182,3 +709,689 @@ func (h *Handler) GetAllowedKeys(w http.ResponseWriter, r *http.Request) {
 		"count":        len(keys),
 	})
 }
+
+// ListHistory 列出配置项的变更历史
+// @Summary      List configuration change history
+// @Description  Returns the change history of a dynamic configuration item, newest first.
+// @Description  Each entry records the old and new value, the actor, the request ID and the time of the change.
+// @Tags         config
+// @Accept       json
+// @Produce      json
+// @Param        key    query  string  true   "Configuration key"  example(poc.enabled)
+// @Param        limit  query  int     false  "Maximum number of entries to return (default: all)"
+// @Param        scope  query  string  false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  ListHistoryResponse  "Configuration change history"
+// @Failure      400  {object}  response.Response    "Unknown configuration key"
+// @Failure      422  {object}  response.Response    "Missing key or invalid limit parameter"
+// @Router       /config/history [get]
+func (h *Handler) ListHistory(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		response.ValidationErrorWithRequest(w, r, "key", "missing 'key' query parameter")
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			response.ValidationErrorWithRequest(w, r, "limit", "'limit' must be a non-negative integer")
+			return
+		}
+		limit = n
+	}
+
+	history, err := h.service.ListConfigHistory(r.Context(), key, limit)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to list config history: "+err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, ListHistoryResponse{
+		Key:     key,
+		History: h.service.MaskRecords(history),
+		Count:   len(history),
+	})
+}
+
+// RollbackConfig 将配置项回滚到指定修订号
+// @Summary      Roll back configuration item
+// @Description  Restore a dynamic configuration item to the value it had right after the given revision.
+// @Description  The restored value goes through the same validation as PUT /config and is recorded in history as a rollback.
+// @Tags         config
+// @Accept       json
+// @Produce      json
+// @Param        request  body    RollbackConfigRequest  true   "Rollback request"  example({"key":"poc.enabled","revision":42})
+// @Param        X-Actor  header  string                 false  "Operator recorded in config history"
+// @Param        scope    query   string                 false  "Scope: global (default), project:<id> or project:<id>/user:<id>"
+// @Success      200  {object}  RollbackConfigResponse  "Configuration rolled back successfully"
+// @Failure      400  {object}  response.Response       "Invalid request, unknown revision or validation failed"
+// @Failure      403  {object}  response.Response       "The key requires approval, submit the old value with PUT /config instead"
+// @Router       /config/history/rollback [post]
+func (h *Handler) RollbackConfig(w http.ResponseWriter, r *http.Request) {
+	var req RollbackConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "invalid request body: "+err.Error())
+		return
+	}
+
+	if req.Key == "" {
+		response.ValidationErrorWithRequest(w, r, "key", "missing 'key' field")
+		return
+	}
+	if req.Revision <= 0 {
+		response.ValidationErrorWithRequest(w, r, "revision", "missing or invalid 'revision' field")
+		return
+	}
+	record, err := h.service.RollbackConfig(h.changeContext(r), req.Key, req.Revision)
+	if err != nil {
+		if h.writeApprovalRequired(w, r, err) {
+			return
+		}
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "failed to roll back config: "+err.Error())
+		return
+	}
+
+	masked := h.service.MaskRecord(*record)
+	response.SuccessWithRequest(w, r, RollbackConfigResponse{
+		Key:      masked.Key,
+		Revision: masked.Revision,
+		Value:    masked.NewValue,
+	})
+}
+
+// ListSchedules 列出计划变更
+// @Summary      List scheduled configuration changes
+// @Description  Returns changes scheduled with effective_at/expires_at on PUT /config across all scopes, oldest first.
+// @Description  By default only pending (not applied yet) and active (applied, waiting for expiry) schedules are returned.
+// @Description  Values of secret configuration items are masked.
+// @Tags         config
+// @Produce      json
+// @Param        status  query  string  false  "Comma separated statuses: pending, active, completed, cancelled, failed or all (default: pending,active)"
+// @Success      200  {object}  ListSchedulesResponse  "Scheduled changes"
+// @Failure      422  {object}  response.Response      "Invalid status"
+// @Failure      500  {object}  response.Response      "Failed to list schedules"
+// @Router       /config/schedules [get]
+func (h *Handler) ListSchedules(w http.ResponseWriter, r *http.Request) {
+	statuses := []string{ScheduleStatusPending, ScheduleStatusActive}
+	if raw := r.URL.Query().Get("status"); raw == "all" {
+		statuses = nil
+	} else if raw != "" {
+		statuses = strings.Split(raw, ",")
+		for i, status := range statuses {
+			statuses[i] = strings.TrimSpace(status)
+			switch statuses[i] {
+			case ScheduleStatusPending, ScheduleStatusActive, ScheduleStatusCompleted, ScheduleStatusCancelled, ScheduleStatusFailed:
+			default:
+				response.ValidationErrorWithRequest(w, r, "status", "unknown schedule status: "+statuses[i])
+				return
+			}
+		}
+	}
+
+	schedules, err := h.service.ListSchedules(r.Context(), statuses...)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, ListSchedulesResponse{
+		Schedules: h.service.MaskSchedules(schedules),
+		Count:     len(schedules),
+	})
+}
+
+// CancelSchedule 取消计划变更
+// @Summary      Cancel a scheduled configuration change
+// @Description  Cancels a pending schedule so it is never applied.
+// @Description  Cancelling an active schedule restores the prior value immediately (recorded in history as a revert),
+// @Description  unless the key was changed after the schedule was applied.
+// @Tags         config
+// @Produce      json
+// @Param        id       path    int     true   "Schedule ID"
+// @Param        X-Actor  header  string  false  "Operator recorded in config history"
+// @Success      200  {object}  Schedule           "Cancelled schedule"
+// @Failure      404  {object}  response.Response  "Schedule not found"
+// @Failure      409  {object}  response.Response  "Schedule already completed, cancelled or failed"
+// @Failure      422  {object}  response.Response  "Invalid schedule ID"
+// @Router       /config/schedules/{id} [delete]
+func (h *Handler) CancelSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id <= 0 {
+		response.ValidationErrorWithRequest(w, r, "id", "schedule ID must be a positive integer")
+		return
+	}
+
+	if _, err := h.service.GetSchedule(r.Context(), id); err != nil {
+		response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, err.Error())
+		return
+	}
+
+	schedule, err := h.service.CancelSchedule(h.changeContext(r), id)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusConflict, response.ErrCodeConflict, err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, h.service.MaskSchedule(*schedule))
+}
+
+// ListChangeRequests 列出变更申请
+// @Summary      List configuration change requests
+// @Description  Returns change requests created by PUT /config for keys that require approval (approval:"required") across all scopes, oldest first.
+// @Description  By default only pending requests are returned; pending requests past expires_at are reported as expired.
+// @Description  Values of secret configuration items are masked.
+// @Tags         config
+// @Produce      json
+// @Param        status  query  string  false  "Comma separated statuses: pending, approved, rejected, expired, failed or all (default: pending)"
+// @Success      200  {object}  ListChangeRequestsResponse  "Change requests"
+// @Failure      422  {object}  response.Response           "Invalid status"
+// @Failure      500  {object}  response.Response           "Failed to list change requests"
+// @Router       /config/changes [get]
+func (h *Handler) ListChangeRequests(w http.ResponseWriter, r *http.Request) {
+	statuses := []string{ChangeRequestStatusPending}
+	if raw := r.URL.Query().Get("status"); raw == "all" {
+		statuses = nil
+	} else if raw != "" {
+		statuses = strings.Split(raw, ",")
+		for i, status := range statuses {
+			statuses[i] = strings.TrimSpace(status)
+			switch statuses[i] {
+			case ChangeRequestStatusPending, ChangeRequestStatusApproved, ChangeRequestStatusRejected, ChangeRequestStatusExpired, ChangeRequestStatusFailed:
+			default:
+				response.ValidationErrorWithRequest(w, r, "status", "unknown change request status: "+statuses[i])
+				return
+			}
+		}
+	}
+
+	requests, err := h.service.ListChangeRequests(r.Context(), statuses...)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, ListChangeRequestsResponse{
+		Changes: h.service.MaskChangeRequests(requests),
+		Count:   len(requests),
+	})
+}
+
+// GetChangeRequest 获取变更申请
+// @Summary      Get a configuration change request
+// @Description  Returns a single change request; values of secret configuration items are masked.
+// @Tags         config
+// @Produce      json
+// @Param        id  path  int  true  "Change request ID"
+// @Success      200  {object}  ChangeRequest      "Change request"
+// @Failure      404  {object}  response.Response  "Change request not found"
+// @Failure      422  {object}  response.Response  "Invalid change request ID"
+// @Router       /config/changes/{id} [get]
+func (h *Handler) GetChangeRequest(w http.ResponseWriter, r *http.Request) {
+	id, ok := changeRequestID(w, r)
+	if !ok {
+		return
+	}
+
+	request, err := h.service.GetChangeRequest(r.Context(), id)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, h.service.MaskChangeRequest(*request))
+}
+
+// ApproveChangeRequest 批准变更申请
+// @Summary      Approve a configuration change request
+// @Description  Approves a pending change request and applies it through the same validation as PUT /config,
+// @Description  in the scope it was requested for; config history records the requester as the actor.
+// @Description  The approver (X-Actor, required) must be someone other than the requester.
+// @Description  X-Actor is not authenticated by the server; deploy behind a proxy that authenticates users and sets X-Actor.
+// @Description  When the value no longer validates, the request is marked failed and 400 is returned.
+// @Tags         config
+// @Accept       json
+// @Produce      json
+// @Param        id       path    int                  true   "Change request ID"
+// @Param        request  body    ReviewChangeRequest  false  "Reviewer's comment"
+// @Param        X-Actor  header  string               true   "Approver"
+// @Success      200  {object}  ChangeRequest      "Approved and applied change request"
+// @Failure      400  {object}  response.Response  "Invalid request body or the approved value failed validation"
+// @Failure      403  {object}  response.Response  "Approver missing or same as the requester"
+// @Failure      404  {object}  response.Response  "Change request not found"
+// @Failure      409  {object}  response.Response  "Change request already reviewed or expired"
+// @Failure      422  {object}  response.Response  "Invalid change request ID"
+// @Router       /config/changes/{id}/approve [post]
+func (h *Handler) ApproveChangeRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewChangeRequest(w, r, h.service.ApproveChange)
+}
+
+// RejectChangeRequest 拒绝变更申请
+// @Summary      Reject a configuration change request
+// @Description  Rejects a pending change request; nothing is written.
+// @Description  The reviewer (X-Actor, required) must be someone other than the requester.
+// @Tags         config
+// @Accept       json
+// @Produce      json
+// @Param        id       path    int                  true   "Change request ID"
+// @Param        request  body    ReviewChangeRequest  false  "Reviewer's comment"
+// @Param        X-Actor  header  string               true   "Reviewer"
+// @Success      200  {object}  ChangeRequest      "Rejected change request"
+// @Failure      400  {object}  response.Response  "Invalid request body"
+// @Failure      403  {object}  response.Response  "Reviewer missing or same as the requester"
+// @Failure      404  {object}  response.Response  "Change request not found"
+// @Failure      409  {object}  response.Response  "Change request already reviewed or expired"
+// @Failure      422  {object}  response.Response  "Invalid change request ID"
+// @Router       /config/changes/{id}/reject [post]
+func (h *Handler) RejectChangeRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewChangeRequest(w, r, h.service.RejectChange)
+}
+
+// reviewChangeRequest 解析申请 ID 与审批意见（请求体可省略），调用 review 并映射错误状态码
+func (h *Handler) reviewChangeRequest(w http.ResponseWriter, r *http.Request, review func(context.Context, int, string) (*ChangeRequest, error)) {
+	id, ok := changeRequestID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ReviewChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "invalid request body: "+err.Error())
+		return
+	}
+
+	if _, err := h.service.GetChangeRequest(r.Context(), id); err != nil {
+		response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, err.Error())
+		return
+	}
+
+	request, err := review(h.changeContext(r), id, req.Comment)
+	var forbidden *ReviewForbiddenError
+	var conflict *ChangeRequestConflictError
+	switch {
+	case errors.As(err, &forbidden):
+		response.ErrorWithRequest(w, r, http.StatusForbidden, response.ErrCodeForbidden, err.Error())
+	case errors.As(err, &conflict):
+		response.ErrorWithRequest(w, r, http.StatusConflict, response.ErrCodeConflict, err.Error())
+	case err != nil && request != nil:
+		response.ErrorWithDetailsAndRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, err.Error(), h.service.MaskChangeRequest(*request))
+	case err != nil:
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+	default:
+		response.SuccessWithRequest(w, r, h.service.MaskChangeRequest(*request))
+	}
+}
+
+// changeRequestID 解析路径中的变更申请 ID，非法时返回 422
+func changeRequestID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id <= 0 {
+		response.ValidationErrorWithRequest(w, r, "id", "change request ID must be a positive integer")
+		return 0, false
+	}
+	return id, true
+}
+
+// Watch 以 Server-Sent Events 推送配置变更
+// @Summary      Watch configuration changes (SSE)
+// @Description  Streams changes of dynamic configuration items as Server-Sent Events.
+// @Description  Each event has type "change", its id is the change revision and its data is a ChangeRecord JSON.
+// @Description  To resume after a disconnect, pass the last seen revision via the Last-Event-ID header or the revision parameter;
+// @Description  all changes after that revision are replayed before live changes. Without a revision only new changes are sent.
+// @Description  Revisions are assigned when a change is written, not when its transaction commits. Changes after a missing revision
+// @Description  are held back for up to 2 seconds so that events stay in revision order; a missing revision is then assumed rolled back.
+// @Description  If it is committed later (within a minute), the change is sent as a "reset" event (data: ChangeRecord, id unchanged):
+// @Description  the client may have missed it and should re-read the configuration. Such late commits are not detected across reconnects.
+// @Tags         config
+// @Produce      text/event-stream
+// @Param        prefix         query   string  false  "Only changes whose key starts with this prefix"  example(logger.)
+// @Param        scope          query   string  false  "Only changes of this scope: global, project:<id> or project:<id>/user:<id> (default: all scopes)"
+// @Param        revision       query   int     false  "Resume after this revision"
+// @Param        Last-Event-ID  header  string  false  "Resume after this revision (set automatically by EventSource)"
+// @Success      200  {object}  ChangeRecord       "Stream of change events"
+// @Failure      422  {object}  response.Response  "Invalid revision or scope"
+// @Failure      500  {object}  response.Response  "Streaming not supported"
+// @Router       /config/watch [get]
+func (h *Handler) Watch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := newWatchFilter(r)
+
+	revision, hasRevision, err := parseWatchRevision(r)
+	if err != nil {
+		response.ValidationErrorWithRequest(w, r, "revision", err.Error())
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, "streaming not supported")
+		return
+	}
+
+	if !hasRevision {
+		revision, err = h.service.LatestRevision(ctx)
+		if err != nil {
+			response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+			return
+		}
+	}
+
+	// 订阅本进程内的变更作为唤醒信号，实际数据从变更历史中读取，保证不丢失、可续传
+	wake, cancel := h.watchSignal()
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, ": watching revision %d\n\n", revision)
+	flusher.Flush()
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	late := make(map[int]time.Time) // 被视为已回滚而跳过的修订号 → 跳过时间
+	for {
+		batch, err := h.committedChanges(ctx, filter, revision)
+		if err == nil {
+			for _, change := range batch.changes {
+				writeWatchEvent(w, "change", change.Revision, h.service.MaskRecord(change))
+			}
+			revision = batch.revision
+			for _, skipped := range batch.skipped {
+				late[skipped] = time.Now()
+			}
+			err = h.reportLateChanges(ctx, w, filter, revision, late)
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				data, _ := json.Marshal(map[string]string{"message": err.Error()})
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+				flusher.Flush()
+			}
+			return
+		}
+		flusher.Flush()
+
+		// 批次已满，说明可能还有积压，继续读取
+		if batch.full {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-batch.recheck(h.gapGrace):
+		case <-ticker.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// PollChanges 长轮询获取配置变更
+// @Summary      Long-poll configuration changes
+// @Description  Returns changes after the given revision. If there are none, the request blocks until a change happens or the timeout expires.
+// @Description  Pass the returned revision on the next call to continue without missing updates.
+// @Description  Without a revision the current revision is returned immediately, so clients can start from "now".
+// @Description  Changes after a missing revision (a transaction that has not committed yet) are held back for up to 2 seconds;
+// @Description  a missing revision is then assumed rolled back and skipped. Commits later than that are not reported.
+// @Tags         config
+// @Produce      json
+// @Param        prefix    query  string  false  "Only changes whose key starts with this prefix"  example(logger.)
+// @Param        scope     query  string  false  "Only changes of this scope: global, project:<id> or project:<id>/user:<id> (default: all scopes)"
+// @Param        revision  query  int     false  "Return changes after this revision"
+// @Param        timeout   query  string  false  "Maximum wait time, e.g. 30s (default 30s, max 60s)"
+// @Success      200  {object}  WatchPollResponse  "Changes after the revision (may be empty on timeout)"
+// @Failure      422  {object}  response.Response  "Invalid revision, timeout or scope"
+// @Failure      500  {object}  response.Response  "Internal server error"
+// @Router       /config/watch/poll [get]
+func (h *Handler) PollChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := newWatchFilter(r)
+
+	revision, hasRevision, err := parseWatchRevision(r)
+	if err != nil {
+		response.ValidationErrorWithRequest(w, r, "revision", err.Error())
+		return
+	}
+
+	timeout := watchPollDefaultTimeout
+	if raw := r.URL.Query().Get("timeout"); raw != "" {
+		timeout, err = time.ParseDuration(raw)
+		if err != nil || timeout < 0 {
+			response.ValidationErrorWithRequest(w, r, "timeout", "'timeout' must be a duration such as 30s")
+			return
+		}
+		if timeout > watchPollMaxTimeout {
+			timeout = watchPollMaxTimeout
+		}
+	}
+
+	if !hasRevision {
+		latest, err := h.service.LatestRevision(ctx)
+		if err != nil {
+			response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+			return
+		}
+		response.SuccessWithRequest(w, r, WatchPollResponse{Revision: latest, Changes: []ChangeRecord{}})
+		return
+	}
+
+	// 先订阅再查询，避免查询与等待之间的变更被漏掉
+	wake, cancel := h.watchSignal()
+	defer cancel()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		batch, err := h.committedChanges(ctx, filter, revision)
+		if err != nil {
+			response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeInternalError, err.Error())
+			return
+		}
+		revision = batch.revision
+
+		if len(batch.changes) > 0 {
+			response.SuccessWithRequest(w, r, WatchPollResponse{
+				Revision: revision,
+				Changes:  h.service.MaskRecords(batch.changes),
+				Count:    len(batch.changes),
+			})
+			return
+		}
+		if batch.full {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-batch.recheck(h.gapGrace):
+		case <-timer.C:
+			response.SuccessWithRequest(w, r, WatchPollResponse{Revision: revision, Changes: []ChangeRecord{}})
+			return
+		}
+	}
+}
+
+// watchBatch 一次从变更历史中读取的结果
+type watchBatch struct {
+	changes  []ChangeRecord // 匹配过滤条件的变更（按修订号正序）
+	revision int            // 已连续读到的修订号，下次从其后读取
+	skipped  []int          // 等待超过 gapGrace 仍未提交、视为已回滚而跳过的修订号
+	held     bool           // 遇到尚在等待的空缺，其后的变更暂不返回
+	full     bool           // 批次已满，可能还有积压
+}
+
+// recheck 有暂缓返回的变更时，返回 grace 后触发的通道；否则返回 nil（select 中永不触发）
+func (b *watchBatch) recheck(grace time.Duration) <-chan time.Time {
+	if !b.held {
+		return nil
+	}
+	return time.After(grace)
+}
+
+// watchFilter 变更订阅的过滤条件
+type watchFilter struct {
+	prefix string // 键前缀，空字符串匹配全部键
+	scope  string // 作用域名称，空字符串匹配全部作用域
+}
+
+// newWatchFilter 从 prefix 与 scope 参数构建过滤条件（scope 已由 scopeMiddleware 校验）
+// 未传 scope 时订阅全部作用域的变更
+func newWatchFilter(r *http.Request) watchFilter {
+	filter := watchFilter{prefix: r.URL.Query().Get("prefix")}
+	if r.URL.Query().Get("scope") != "" {
+		filter.scope = ScopeFromContext(r.Context()).String()
+	}
+	return filter
+}
+
+// matches 判断变更是否匹配过滤条件（早于作用域引入的记录属于 global）
+func (f watchFilter) matches(change ChangeRecord) bool {
+	if !strings.HasPrefix(change.Key, f.prefix) {
+		return false
+	}
+	if f.scope == "" {
+		return true
+	}
+	scope := change.Scope
+	if scope == "" {
+		scope = GlobalScopeName
+	}
+	return scope == f.scope
+}
+
+// committedChanges 读取 revision 之后修订号连续的变更
+// 修订号在写入时分配而不是在提交时分配：较慢的事务可能在更大的修订号之后才提交。
+// 遇到空缺时，若空缺之后的变更写入不足 gapGrace，在空缺处截断，等待较慢的事务提交；
+// 否则视为空缺的修订号已回滚，跳过（最近 watchGapTTL 内的记入 skipped）
+// 空缺检测需要全部修订号，因此读取全部变更后再按 filter 过滤
+func (h *Handler) committedChanges(ctx context.Context, filter watchFilter, revision int) (*watchBatch, error) {
+	changes, err := h.service.ChangesSince(ctx, "", revision, watchBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &watchBatch{revision: revision, full: len(changes) == watchBatchSize}
+	now := time.Now()
+	for _, change := range changes {
+		if change.Revision > batch.revision+1 {
+			age := now.Sub(change.CreatedAt)
+			if age < h.gapGrace {
+				batch.held = true
+				batch.full = false
+				break
+			}
+			if age < watchGapTTL {
+				for skipped := batch.revision + 1; skipped < change.Revision; skipped++ {
+					batch.skipped = append(batch.skipped, skipped)
+				}
+			}
+		}
+
+		batch.revision = change.Revision
+		if filter.matches(change) {
+			batch.changes = append(batch.changes, change)
+		}
+	}
+	return batch, nil
+}
+
+// reportLateChanges 检查被跳过的修订号是否迟到提交，匹配过滤条件的以 reset 事件发送
+// reset 事件的 id 仍为当前修订号，续传不会倒退；超过 watchGapTTL 的修订号不再检查
+func (h *Handler) reportLateChanges(ctx context.Context, w io.Writer, filter watchFilter, revision int, late map[int]time.Time) error {
+	lowest := revision
+	for skipped, since := range late {
+		if time.Since(since) > watchGapTTL {
+			delete(late, skipped)
+			continue
+		}
+		lowest = min(lowest, skipped)
+	}
+	if len(late) == 0 {
+		return nil
+	}
+
+	changes, err := h.service.ChangesSince(ctx, "", lowest-1, revision-lowest+1)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if _, skipped := late[change.Revision]; !skipped {
+			continue
+		}
+		delete(late, change.Revision)
+		if filter.matches(change) {
+			writeWatchEvent(w, "reset", revision, h.service.MaskRecord(change))
+		}
+	}
+	return nil
+}
+
+// writeWatchEvent 写入一个 SSE 事件，data 为 JSON
+func writeWatchEvent(w io.Writer, event string, id int, data interface{}) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", id, event, payload)
+}
+
+// watchSignal 订阅所有作用域的配置变更，返回一个非阻塞的唤醒通道
+func (h *Handler) watchSignal() (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+	cancel := h.service.watchAllScopes("", func(ChangeEvent) {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	})
+	return wake, cancel
+}
+
+// parseWatchRevision 从 revision 参数或 Last-Event-ID 头中解析续传修订号
+func parseWatchRevision(r *http.Request) (int, bool, error) {
+	raw := r.URL.Query().Get("revision")
+	if raw == "" {
+		raw = r.Header.Get("Last-Event-ID")
+	}
+	if raw == "" {
+		return 0, false, nil
+	}
+
+	revision, err := strconv.Atoi(raw)
+	if err != nil || revision < 0 {
+		return 0, false, fmt.Errorf("'revision' must be a non-negative integer")
+	}
+	return revision, true, nil
+}
+
+// GetReloadStatus 获取配置文件热加载状态
+// @Summary      Get config file reload status
+// @Description  Returns counters of file-triggered config reloads and the last reload error.
+// @Description  A failed reload (parse or validation error) keeps the previous configuration in effect.
+// @Tags         config
+// @Produce      json
+// @Success      200  {object}  ReloadStatus  "Reload status"
+// @Router       /config/reload/status [get]
+func (h *Handler) GetReloadStatus(w http.ResponseWriter, r *http.Request) {
+	response.SuccessWithRequest(w, r, h.service.ReloadStatus())
+}
+
+// GetSyncStatus 获取多实例配置同步状态
+// @Summary      Get config sync status
+// @Description  Returns how this instance follows dynamic config changes committed by other instances
+// @Description  (Postgres LISTEN/NOTIFY plus periodic reconciliation) and the last synchronization error.
+// @Tags         config
+// @Produce      json
+// @Success      200  {object}  SyncStatus  "Sync status"
+// @Router       /config/sync/status [get]
+func (h *Handler) GetSyncStatus(w http.ResponseWriter, r *http.Request) {
+	response.SuccessWithRequest(w, r, h.service.SyncStatus())
+}
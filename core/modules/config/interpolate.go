@@ -0,0 +1,234 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// interpolationFilePrefix 文件引用前缀：${file:/run/secrets/db_pw}
+const interpolationFilePrefix = "file:"
+
+// envVarNamePattern 合法的环境变量名（不含 "."，含 "." 的名称是配置项引用）
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// InterpolationError 配置文件中的 ${...} 表达式无法解析
+type InterpolationError struct {
+	File string // 相对 configDir 的文件名
+	Key  string // 包含表达式的配置键
+	Expr string // 无法解析的表达式（不含 ${}）
+	Err  error
+}
+
+func (e *InterpolationError) Error() string {
+	return fmt.Sprintf("%s: %s: cannot resolve ${%s}: %v", e.File, e.Key, e.Expr, e.Err)
+}
+
+func (e *InterpolationError) Unwrap() error {
+	return e.Err
+}
+
+// interpolator 解析文件层（Layer 2-4）值中的表达式，结果写回主 viper 实例
+type interpolator struct {
+	loader   *Loader
+	sources  map[string]fileSource
+	resolved map[string]interface{} // 已解析的键
+	stack    []string               // 正在解析的键（用于检测循环引用）
+}
+
+// interpolate 解析文件层字符串值（包括列表元素）中的表达式：
+//   - ${ENV_VAR}、${ENV_VAR:-default}：环境变量，未设置或为空时使用默认值
+//   - ${file:/run/secrets/db_pw}：文件内容（去掉末尾换行），相对路径基于 configDir
+//   - ${database.host}、${database.host:-default}：合并后的其他配置项（同样先解析其中的表达式）
+//
+// $${ 表示字面量 ${。环境变量覆盖的键（Layer 6）与数据库中的值不做解析
+func (l *Loader) interpolate(sources map[string]fileSource) error {
+	in := &interpolator{
+		loader:   l,
+		sources:  sources,
+		resolved: make(map[string]interface{}),
+	}
+
+	keys := make([]string, 0, len(sources))
+	for key := range sources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, err := in.resolveKey(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveKey 返回键解析后的值；文件中的值解析后写回 viper
+func (in *interpolator) resolveKey(key string) (interface{}, error) {
+	if value, ok := in.resolved[key]; ok {
+		return value, nil
+	}
+	for i, pending := range in.stack {
+		if pending == key {
+			cycle := append(append([]string{}, in.stack[i:]...), key)
+			source := in.sources[in.stack[len(in.stack)-1]]
+			return nil, &InterpolationError{
+				File: source.File,
+				Key:  in.stack[len(in.stack)-1],
+				Expr: key,
+				Err:  fmt.Errorf("reference cycle %s", strings.Join(cycle, " -> ")),
+			}
+		}
+	}
+
+	value := in.loader.viper.Get(key)
+	source, fromFile := in.sources[key]
+	if _, overridden := os.LookupEnv(envVarName(key)); overridden || !fromFile {
+		in.resolved[key] = value
+		return value, nil
+	}
+
+	in.stack = append(in.stack, key)
+	defer func() { in.stack = in.stack[:len(in.stack)-1] }()
+
+	var (
+		result  = value
+		changed bool
+	)
+	switch v := value.(type) {
+	case string:
+		expanded, err := in.expand(source, key, v)
+		if err != nil {
+			return nil, err
+		}
+		result, changed = expanded, expanded != v
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+			text, ok := item.(string)
+			if !ok {
+				continue
+			}
+			expanded, err := in.expand(source, key, text)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = expanded
+			changed = changed || expanded != text
+		}
+		result = items
+	}
+
+	if changed {
+		in.loader.viper.Set(key, result)
+	}
+	in.resolved[key] = result
+	return result, nil
+}
+
+// expand 替换 text 中的全部表达式
+func (in *interpolator) expand(source fileSource, key, text string) (string, error) {
+	if !strings.Contains(text, "${") {
+		return text, nil
+	}
+
+	var out strings.Builder
+	for {
+		start := strings.Index(text, "${")
+		if start < 0 {
+			out.WriteString(text)
+			return out.String(), nil
+		}
+
+		// $${ 转义为字面量 ${
+		if start > 0 && text[start-1] == '$' {
+			out.WriteString(text[:start-1])
+			out.WriteString("${")
+			text = text[start+2:]
+			continue
+		}
+
+		end := strings.Index(text[start:], "}")
+		if end < 0 {
+			return "", &InterpolationError{File: source.File, Key: key, Expr: text[start+2:], Err: fmt.Errorf("missing closing '}'")}
+		}
+		expr := text[start+2 : start+end]
+
+		value, err := in.evaluate(expr)
+		if err != nil {
+			// 被引用的配置项自身无法解析时，错误指向该配置项所在的文件
+			var interpolationErr *InterpolationError
+			if errors.As(err, &interpolationErr) {
+				return "", err
+			}
+			return "", &InterpolationError{File: source.File, Key: key, Expr: expr, Err: err}
+		}
+
+		out.WriteString(text[:start])
+		out.WriteString(value)
+		text = text[start+end+1:]
+	}
+}
+
+// evaluate 解析单个表达式（不含 ${}）
+func (in *interpolator) evaluate(expr string) (string, error) {
+	if path, ok := strings.CutPrefix(expr, interpolationFilePrefix); ok {
+		return in.readFile(path)
+	}
+
+	name, fallback, hasFallback := strings.Cut(expr, ":-")
+	name = strings.TrimSpace(name)
+
+	// 含 "." 的名称引用其他配置项
+	if strings.Contains(name, ".") {
+		ref := strings.ToLower(name)
+		if !in.loader.viper.IsSet(ref) {
+			if hasFallback {
+				return fallback, nil
+			}
+			return "", fmt.Errorf("config key %s is not set", name)
+		}
+
+		value, err := in.resolveKey(ref)
+		if err != nil {
+			return "", err
+		}
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return "", fmt.Errorf("config key %s is not a scalar value", name)
+		}
+		return fmt.Sprint(value), nil
+	}
+
+	if !envVarNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid variable name %q", name)
+	}
+	if value := os.Getenv(name); value != "" {
+		return value, nil
+	}
+	if hasFallback {
+		return fallback, nil
+	}
+	return "", fmt.Errorf("environment variable %s is not set", name)
+}
+
+// readFile 读取文件引用（如 Docker/Kubernetes secret），去掉末尾换行
+func (in *interpolator) readFile(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("empty file path")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(in.loader.configDir, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
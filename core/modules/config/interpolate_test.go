@@ -0,0 +1,145 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"apprun/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfigFiles 在临时配置目录中写入文件（名称相对 configDir）
+func writeConfigFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+// TestLoader_Interpolate 测试环境变量、文件引用与配置项引用在验证之前解析
+func TestLoader_Interpolate(t *testing.T) {
+	secrets := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(secrets, "db_pw"), []byte("s3cret-password\n"), 0600))
+
+	dir := writeConfigFiles(t, map[string]string{
+		"default.yaml": `
+app:
+  name: "${APP_NAME_TEST:-fallback-app}"
+database:
+  host: db.internal
+  user: "${DB_USER_TEST}"
+  password: "${file:` + filepath.Join(secrets, "db_pw") + `}"
+`,
+		"conf_d/10-poc.yaml": `
+poc:
+  database: "postgres://${database.user}@${database.host}:${database.port}/poc"
+  api_key: "$${literal}-key-123"
+`,
+		"conf_d/20-logger.yaml": `
+logger:
+  output:
+    targets: ["stdout", "${LOG_TARGET_TEST:-stderr}"]
+`,
+	})
+	t.Setenv("DB_USER_TEST", "apprun")
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Register("logger", &logger.Config{}))
+	loader, err := NewLoaderWithRegistry(dir, nil, registry)
+	require.NoError(t, err)
+
+	cfg, modules, err := loader.loadAll(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "fallback-app", cfg.App.Name)
+	assert.Equal(t, "apprun", cfg.Database.User)
+	assert.Equal(t, "s3cret-password", cfg.Database.Password)
+	assert.Equal(t, "postgres://apprun@db.internal:5432/poc", cfg.POC.Database)
+	assert.Equal(t, "${literal}-key-123", cfg.POC.APIKey)
+	assert.Equal(t, []string{"stdout", "stderr"}, modules["logger"].(*logger.Config).Output.Targets)
+
+	// 环境变量覆盖的键不做解析，被引用时使用环境变量的值
+	t.Setenv("DATABASE_HOST", "env-host")
+	t.Setenv("APP_NAME_TEST", "env-app")
+	cfg, err = loader.Load(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "env-app", cfg.App.Name)
+	assert.Equal(t, "postgres://apprun@env-host:5432/poc", cfg.POC.Database)
+}
+
+// TestLoader_Interpolate_Errors 测试缺失变量、缺失文件与循环引用的错误指明文件与配置键
+func TestLoader_Interpolate_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		file    string
+		key     string
+		message string
+	}{
+		{
+			name:    "missing env var",
+			files:   map[string]string{"conf_d/10-db.yaml": "database:\n  host: \"${MISSING_HOST_TEST}\"\n"},
+			file:    "conf_d/10-db.yaml",
+			key:     "database.host",
+			message: "conf_d/10-db.yaml: database.host: cannot resolve ${MISSING_HOST_TEST}: environment variable MISSING_HOST_TEST is not set",
+		},
+		{
+			name:    "missing file",
+			files:   map[string]string{"default.yaml": "database:\n  password: \"${file:secrets/none}\"\n"},
+			file:    "default.yaml",
+			key:     "database.password",
+			message: "failed to read file",
+		},
+		{
+			name:    "missing key",
+			files:   map[string]string{"default.yaml": "app:\n  name: \"${app.nothing}\"\n"},
+			file:    "default.yaml",
+			key:     "app.name",
+			message: "config key app.nothing is not set",
+		},
+		{
+			name: "cycle",
+			files: map[string]string{
+				"default.yaml":       "app:\n  name: \"${database.host}\"\n",
+				"conf_d/10-db.yaml":  "database:\n  host: \"${database.user}\"\n",
+				"conf_d/20-usr.yaml": "database:\n  user: \"${app.name}\"\n",
+			},
+			file:    "conf_d/20-usr.yaml",
+			key:     "database.user",
+			message: "reference cycle app.name -> database.host -> database.user -> app.name",
+		},
+		{
+			name:    "unterminated",
+			files:   map[string]string{"default.yaml": "app:\n  name: \"${APP\"\n"},
+			file:    "default.yaml",
+			key:     "app.name",
+			message: "missing closing '}'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader, err := NewLoader(writeConfigFiles(t, tt.files), nil)
+			require.NoError(t, err)
+
+			_, err = loader.Load(context.Background())
+			require.Error(t, err)
+
+			var interpolationErr *InterpolationError
+			require.True(t, errors.As(err, &interpolationErr), err.Error())
+			assert.Equal(t, tt.file, interpolationErr.File)
+			assert.Equal(t, tt.key, interpolationErr.Key)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"apprun/internal/config"
 
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/spf13/viper"
 )
 
@@ -20,15 +24,35 @@ type Loader struct {
 	provider  ConfigProvider        // 数据库配置提供者
 	viper     *viper.Viper          // Viper 实例
 	metadata  map[string]*fieldMeta // 字段元数据（从反射提取）
+	fieldKeys map[string]string     // Go 字段路径 → 配置键（如 "POC.APIKey"、模块 "logger/Level"），用于映射校验错误
+	paths     map[string]keyPath    // 配置键 → 字段位置（至少两级的键），用于构建快照时按索引取值
 	registry  *ConfigRegistry       // 模块配置注册表（可选）
+	profile   string                // 环境 profile（APP_ENV），为空表示不加载 profile 文件
+
+	fileSources atomic.Pointer[map[string]fileSource] // 最近一次加载中每个键最终取自的文件
 }
 
+// ProfileEnv 选择环境 profile 的环境变量，如 APP_ENV=staging
+const ProfileEnv = "APP_ENV"
+
+// profileNamePattern 合法的 profile 名称（用于拼接文件名和目录名）
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// yamlTagDecoding 让 Viper 按 yaml 标签解码（与元数据键、环境变量名一致，如 api_key / POC_API_KEY），
+// 未设置 yaml 标签的字段按字段名匹配
+var yamlTagDecoding = viper.DecoderConfigOption(func(c *mapstructure.DecoderConfig) {
+	c.TagName = "yaml"
+})
+
 // fieldMeta 字段元数据
 type fieldMeta struct {
-	Key         string // 配置键路径，如 "app.name"
-	DefaultVal  string // 默认值（从 default 标签）
-	AllowDB     bool   // 是否允许数据库存储（db 标签）
-	ValidateTag string // 验证规则（validate 标签）
+	Key         string       // 配置键路径，如 "app.name"
+	DefaultVal  string       // 默认值（从 default 标签）
+	AllowDB     bool         // 是否允许数据库存储（db 标签）
+	ValidateTag string       // 验证规则（validate 标签）
+	Secret      bool         // 是否为敏感值（secret 标签），数据库中加密存储、响应中脱敏
+	Approval    bool         // 修改是否需要他人审批（approval:"required" 标签）
+	Type        reflect.Type // 字段的 Go 类型（用于解析和验证动态值）
 }
 
 // NewLoader 创建配置加载器
@@ -43,12 +67,20 @@ func NewLoaderWithRegistry(configDir string, provider ConfigProvider, registry *
 	v.AutomaticEnv() // 自动绑定环境变量
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
+	profile := strings.TrimSpace(os.Getenv(ProfileEnv))
+	if profile != "" && !profileNamePattern.MatchString(profile) {
+		return nil, fmt.Errorf("invalid %s profile name: %q", ProfileEnv, profile)
+	}
+
 	loader := &Loader{
 		configDir: configDir,
 		provider:  provider,
 		viper:     v,
 		metadata:  make(map[string]*fieldMeta),
+		fieldKeys: make(map[string]string),
+		paths:     make(map[string]keyPath),
 		registry:  registry,
+		profile:   profile,
 	}
 
 	// 使用反射提取字段元数据（全局 Config）
@@ -71,7 +103,7 @@ func (l *Loader) extractMetadata() error {
 	cfg := config.Config{}
 	t := reflect.TypeOf(cfg)
 
-	return l.walkStruct(t, "")
+	return l.walkStruct(t, "", "", nil)
 }
 
 // extractRegistryMetadata 提取注册模块的元数据
@@ -88,7 +120,7 @@ func (l *Loader) extractRegistryMetadata() error {
 			t = t.Elem()
 		}
 
-		if err := l.walkStruct(t, namespace); err != nil {
+		if err := l.walkStruct(t, namespace, namespace+"/", nil); err != nil {
 			return fmt.Errorf("failed to extract metadata for module '%s': %w", namespace, err)
 		}
 	}
@@ -97,7 +129,8 @@ func (l *Loader) extractRegistryMetadata() error {
 }
 
 // walkStruct 递归遍历结构体字段
-func (l *Loader) walkStruct(t reflect.Type, prefix string) error {
+// prefix 为配置键前缀，fieldPrefix 为 Go 字段路径前缀（模块为 "<namespace>/"），index 为 t 在根结构体中的字段索引
+func (l *Loader) walkStruct(t reflect.Type, prefix, fieldPrefix string, index []int) error {
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 		fieldType := field.Type
@@ -115,10 +148,21 @@ func (l *Loader) walkStruct(t reflect.Type, prefix string) error {
 		} else {
 			path = prefix + "." + yamlTag
 		}
+		fieldPath := fieldPrefix + field.Name
+		l.fieldKeys[fieldPath] = path
+
+		fieldIndex := append(append([]int(nil), index...), i)
+		if strings.Contains(path, ".") {
+			module := ""
+			if namespace, _, ok := strings.Cut(fieldPrefix, "/"); ok {
+				module = namespace
+			}
+			l.paths[path] = keyPath{Module: module, Index: fieldIndex}
+		}
 
 		// 如果是嵌套结构体，递归处理
 		if fieldType.Kind() == reflect.Struct {
-			if err := l.walkStruct(fieldType, path); err != nil {
+			if err := l.walkStruct(fieldType, path, fieldPath+".", fieldIndex); err != nil {
 				return err
 			}
 			continue
@@ -139,12 +183,38 @@ func (l *Loader) walkStruct(t reflect.Type, prefix string) error {
 			}
 		}
 
+		// 解析 secret 标签（默认为 false）
+		secret := false
+		if secretTag := field.Tag.Get("secret"); secretTag != "" {
+			var err error
+			secret, err = strconv.ParseBool(secretTag)
+			if err != nil {
+				return fmt.Errorf("invalid secret tag for field %s: %s", field.Name, secretTag)
+			}
+		}
+
+		// 解析 approval 标签（required 表示 API 修改需要另一位操作人审批，只用于 db:true 的项）
+		approval := false
+		switch approvalTag := field.Tag.Get("approval"); approvalTag {
+		case "":
+		case "required":
+			if !allowDB {
+				return fmt.Errorf("approval tag for field %s requires db:\"true\"", field.Name)
+			}
+			approval = true
+		default:
+			return fmt.Errorf("invalid approval tag for field %s: %s", field.Name, approvalTag)
+		}
+
 		// 保存元数据
 		l.metadata[path] = &fieldMeta{
 			Key:         path,
 			DefaultVal:  defaultVal,
 			AllowDB:     allowDB,
 			ValidateTag: validateTag,
+			Secret:      secret,
+			Approval:    approval,
+			Type:        fieldType,
 		}
 	}
 
@@ -156,6 +226,19 @@ func (l *Loader) walkStruct(t reflect.Type, prefix string) error {
 //
 //	< Layer 4 (conf_d) < Layer 5 (数据库) < Layer 6 (环境变量)
 func (l *Loader) Load(ctx context.Context) (*config.Config, error) {
+	return l.LoadWithOverrides(ctx, nil)
+}
+
+// LoadWithOverrides 按 6 层优先级加载配置，并将 overrides 视为已写入数据库的动态值
+// 用于在持久化之前验证一组变更的合并结果（只接受 db:true 的键）
+func (l *Loader) LoadWithOverrides(ctx context.Context, overrides map[string]string) (*config.Config, error) {
+	cfg, _, err := l.loadAll(ctx, overrides)
+	return cfg, err
+}
+
+// loadAll 按 6 层优先级加载全局配置，并将合并结果绑定到各注册模块的新实例
+// 返回的模块配置为 namespace -> 指向新实例的指针（类型与注册时一致）
+func (l *Loader) loadAll(ctx context.Context, overrides map[string]string) (*config.Config, map[string]interface{}, error) {
 	// Create a fresh viper instance to avoid stale values from previous loads
 	l.viper = viper.New()
 	l.viper.AutomaticEnv()
@@ -165,123 +248,261 @@ func (l *Loader) Load(ctx context.Context) (*config.Config, error) {
 
 	// Layer 1: 应用标签默认值
 	if err := l.applyTagDefaults(cfg); err != nil {
-		return nil, fmt.Errorf("failed to apply tag defaults: %w", err)
+		return nil, nil, fmt.Errorf("failed to apply tag defaults: %w", err)
 	}
 
-	// Layer 2: 加载 default.yaml
-	if err := l.loadDefaultYAML(); err != nil {
-		return nil, fmt.Errorf("failed to load default.yaml: %w", err)
+	sources := map[string]fileSource{}
+
+	// Layer 2: 加载 default.yaml，以及 profile 的 default.<profile>.yaml
+	if err := l.loadDefaultYAML(sources); err != nil {
+		return nil, nil, fmt.Errorf("failed to load default.yaml: %w", err)
 	}
 
 	// Layer 3: 加载专用配置文件（如 database.yaml, server.yaml）
-	if err := l.loadSpecializedFiles(); err != nil {
-		return nil, fmt.Errorf("failed to load specialized files: %w", err)
+	if err := l.loadSpecializedFiles(sources); err != nil {
+		return nil, nil, fmt.Errorf("failed to load specialized files: %w", err)
+	}
+
+	// Layer 4: 加载 conf_d 目录下的配置文件，以及 profile 的 conf_d/<profile>/
+	if err := l.loadConfD(sources); err != nil {
+		return nil, nil, fmt.Errorf("failed to load conf_d: %w", err)
 	}
 
-	// Layer 4: 加载 conf_d 目录下的配置文件
-	if err := l.loadConfD(); err != nil {
-		return nil, fmt.Errorf("failed to load conf_d: %w", err)
+	// 解析文件值中的 ${ENV:-default}、${file:path} 与 ${other.key} 引用（在验证之前）
+	if err := l.interpolate(sources); err != nil {
+		return nil, nil, err
 	}
 
 	// 将 Viper 配置解析到结构体
-	if err := l.viper.Unmarshal(cfg); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	if err := l.viper.Unmarshal(cfg, yamlTagDecoding); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
 	// Layer 5: 从数据库覆盖动态配置（只覆盖 db:true 的字段）
-	if err := l.applyDatabaseConfig(ctx, cfg); err != nil {
-		return nil, fmt.Errorf("failed to apply database config: %w", err)
+	if err := l.applyDatabaseConfig(ctx, cfg, overrides); err != nil {
+		return nil, nil, fmt.Errorf("failed to apply database config: %w", err)
 	}
 
 	// Layer 6: 环境变量自动覆盖（通过 Viper 的 AutomaticEnv）
 
-	return cfg, nil
+	// 将合并结果绑定到注册模块
+	modules, err := l.bindModules()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to bind module configs: %w", err)
+	}
+
+	l.fileSources.Store(&sources)
+	return cfg, modules, nil
+}
+
+// bindModules 从合并后的配置中填充每个注册模块的配置结构体
+// 每次加载都创建新实例（注册时传入的结构体只作为类型模板），读取方持有的旧实例不会被修改
+func (l *Loader) bindModules() (map[string]interface{}, error) {
+	if l.registry == nil {
+		return nil, nil
+	}
+
+	settings := l.viper.AllSettings()
+	modules := make(map[string]interface{})
+	for namespace, template := range l.registry.GetAll() {
+		t := reflect.TypeOf(template)
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		// 使用独立的 Viper 解码，与全局配置共享类型转换规则（如 "30s" -> time.Duration）
+		section := viper.New()
+		if err := section.MergeConfigMap(subSettings(settings, namespace)); err != nil {
+			return nil, fmt.Errorf("failed to read settings of module '%s': %w", namespace, err)
+		}
+
+		instance := reflect.New(t).Interface()
+		if err := section.Unmarshal(instance, yamlTagDecoding); err != nil {
+			return nil, fmt.Errorf("failed to bind config of module '%s': %w", namespace, err)
+		}
+		modules[namespace] = instance
+	}
+
+	return modules, nil
+}
+
+// subSettings 取出 namespace（可包含 "."）对应的配置子树
+func subSettings(settings map[string]interface{}, namespace string) map[string]interface{} {
+	current := settings
+	for _, part := range strings.Split(strings.ToLower(namespace), ".") {
+		next, ok := current[part].(map[string]interface{})
+		if !ok {
+			return map[string]interface{}{}
+		}
+		current = next
+	}
+	return current
+}
+
+// envVarName 返回配置键对应的环境变量名（如 poc.api_key -> POC_API_KEY）
+func envVarName(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
 }
 
 // applyTagDefaults 应用标签默认值（Layer 1）
+// 同时为每个键绑定环境变量，使没有默认值、也未出现在文件中的键（如 database.password）也能由环境变量提供
 func (l *Loader) applyTagDefaults(cfg *config.Config) error {
 	for key, meta := range l.metadata {
 		if meta.DefaultVal != "" {
 			l.viper.SetDefault(key, meta.DefaultVal)
 		}
+		if err := l.viper.BindEnv(key); err != nil {
+			return fmt.Errorf("failed to bind env for '%s': %w", key, err)
+		}
 	}
 	return nil
 }
 
-// loadDefaultYAML 加载 default.yaml（Layer 2）
-func (l *Loader) loadDefaultYAML() error {
-	defaultFile := filepath.Join(l.configDir, "default.yaml")
-	if _, err := os.Stat(defaultFile); err == nil {
-		l.viper.SetConfigFile(defaultFile)
-		if err := l.viper.ReadInConfig(); err != nil {
-			return fmt.Errorf("failed to read default.yaml: %w", err)
-		}
+// loadDefaultYAML 加载 default.yaml 与 default.<profile>.yaml（Layer 2）
+func (l *Loader) loadDefaultYAML(sources map[string]fileSource) error {
+	if err := l.mergeFile("default.yaml", false, sources); err != nil {
+		return err
+	}
+
+	if file := l.profileDefaultFile(); file != "" {
+		return l.mergeFile(file, true, sources)
 	}
 	return nil
 }
 
+// specializedFiles 专用配置文件（Layer 3），按顺序合并
+var specializedFiles = []string{"database.yaml", "server.yaml", "poc.yaml"}
+
 // loadSpecializedFiles 加载专用配置文件（Layer 3）
-func (l *Loader) loadSpecializedFiles() error {
-	specialFiles := []string{"database.yaml", "server.yaml", "poc.yaml"}
-	for _, fname := range specialFiles {
-		fpath := filepath.Join(l.configDir, fname)
-		if _, err := os.Stat(fpath); err == nil {
-			// 临时设置配置文件路径并合并
-			tmpViper := viper.New()
-			tmpViper.SetConfigFile(fpath)
-			if err := tmpViper.ReadInConfig(); err != nil {
-				return fmt.Errorf("failed to read %s: %w", fname, err)
-			}
-			// 合并到主 viper 实例
-			if err := l.viper.MergeConfigMap(tmpViper.AllSettings()); err != nil {
-				return fmt.Errorf("failed to merge %s: %w", fname, err)
-			}
+func (l *Loader) loadSpecializedFiles(sources map[string]fileSource) error {
+	for _, fname := range specializedFiles {
+		if err := l.mergeFile(fname, false, sources); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-// loadConfD 加载 conf_d 目录下的配置文件（Layer 4）
-func (l *Loader) loadConfD() error {
-	confDDir := filepath.Join(l.configDir, "conf_d")
-	if _, err := os.Stat(confDDir); os.IsNotExist(err) {
-		return nil // conf_d 目录不存在，跳过
+// loadConfD 加载 conf_d 目录下的配置来源，再加载 conf_d/<profile>/ 下的配置来源（Layer 4）
+// 每个目录内按名称排序合并，后者覆盖前者（见 configEntries）
+func (l *Loader) loadConfD(sources map[string]fileSource) error {
+	files, err := l.confDFiles()
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		if err := l.mergeFile(name, false, sources); err != nil {
+			return err
+		}
 	}
 
-	entries, err := os.ReadDir(confDDir)
+	files, err = l.profileConfDFiles()
 	if err != nil {
-		return fmt.Errorf("failed to read conf_d directory: %w", err)
+		return err
 	}
-
-	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
-			continue
+	for _, name := range files {
+		if err := l.mergeFile(name, true, sources); err != nil {
+			return err
 		}
+	}
 
-		fpath := filepath.Join(confDDir, entry.Name())
-		// 使用临时 viper 实例读取并合并
-		tmpViper := viper.New()
-		tmpViper.SetConfigFile(fpath)
-		if err := tmpViper.ReadInConfig(); err != nil {
-			return fmt.Errorf("failed to read %s: %w", entry.Name(), err)
-		}
-		if err := l.viper.MergeConfigMap(tmpViper.AllSettings()); err != nil {
-			return fmt.Errorf("failed to merge %s: %w", entry.Name(), err)
-		}
+	return nil
+}
+
+// fileSource 配置键最终取自的文件
+type fileSource struct {
+	File    string // 相对 configDir 的文件名，如 conf_d/10-db.yaml
+	Profile bool   // 是否为 profile 文件
+}
+
+// mergeFile 读取 configDir 下的配置来源（不存在则跳过）并合并到主 viper 实例
+// 同时记录每个键最终取自的文件，后加载的文件会覆盖该记录
+func (l *Loader) mergeFile(name string, fromProfile bool, sources map[string]fileSource) error {
+	tmpViper, err := l.readSource(name)
+	if err != nil || tmpViper == nil {
+		return err
+	}
+	if err := l.viper.MergeConfigMap(tmpViper.AllSettings()); err != nil {
+		return fmt.Errorf("failed to merge %s: %w", name, err)
 	}
 
+	for _, key := range tmpViper.AllKeys() {
+		sources[key] = fileSource{File: sourceFileName(name, key), Profile: fromProfile}
+	}
 	return nil
 }
 
-// applyDatabaseConfig 从数据库覆盖动态配置（Layer 5）
-func (l *Loader) applyDatabaseConfig(ctx context.Context, cfg *config.Config) error {
-	if l.provider == nil {
+// Profile 返回当前环境 profile（APP_ENV），未设置时为空
+func (l *Loader) Profile() string {
+	return l.profile
+}
+
+// FromProfile 检查配置项在最近一次加载中是否取自 profile 文件
+func (l *Loader) FromProfile(key string) bool {
+	sources := l.fileSources.Load()
+	return sources != nil && (*sources)[key].Profile
+}
+
+// SourceFile 返回配置项在最近一次加载中最终取自的文件（相对 configDir），不来自文件时为空
+func (l *Loader) SourceFile(key string) string {
+	sources := l.fileSources.Load()
+	if sources == nil {
+		return ""
+	}
+	return (*sources)[key].File
+}
+
+// profileDefaultFile 返回 profile 的 default 文件名（相对 configDir），未设置 profile 时为空
+func (l *Loader) profileDefaultFile() string {
+	if l.profile == "" {
+		return ""
+	}
+	return "default." + l.profile + ".yaml"
+}
+
+// profileConfDDir 返回 profile 的 conf_d 子目录，未设置 profile 时为空
+func (l *Loader) profileConfDDir() string {
+	if l.profile == "" {
+		return ""
+	}
+	return filepath.Join(l.configDir, "conf_d", l.profile)
+}
+
+// profileConfDFiles 返回 conf_d/<profile>/ 下参与合并的配置来源（相对 configDir，按名称排序）
+func (l *Loader) profileConfDFiles() ([]string, error) {
+	if l.profile == "" {
+		return nil, nil
+	}
+	return configEntries(l.configDir, filepath.Join("conf_d", l.profile))
+}
+
+// confDFiles 返回 conf_d 目录下参与合并的配置来源（相对 configDir，按名称排序，后者覆盖前者）
+func (l *Loader) confDFiles() ([]string, error) {
+	return configEntries(l.configDir, "conf_d")
+}
+
+// applyDatabaseConfig 从数据库覆盖动态配置（Layer 5），overrides 优先于数据库中的值
+// ctx 中的作用域决定读取哪些作用域的值（见 Scope.Chain）
+func (l *Loader) applyDatabaseConfig(ctx context.Context, cfg *config.Config, overrides map[string]string) error {
+	if l.provider == nil && len(overrides) == 0 {
 		return nil // 没有数据库提供者，跳过
 	}
 
-	dbConfigs, err := l.provider.ListDynamicConfigs(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to list database configs: %w", err)
+	// 按作用域链（global → project → user）逐级覆盖，取最具体作用域的值
+	dbConfigs := make(map[string]string, len(overrides))
+	if l.provider != nil {
+		for _, scope := range ScopeFromContext(ctx).Chain() {
+			stored, err := l.provider.ListDynamicConfigs(WithScope(ctx, scope))
+			if err != nil {
+				return fmt.Errorf("failed to list database configs for scope %s: %w", scope, err)
+			}
+			for key, value := range stored {
+				dbConfigs[key] = value
+			}
+		}
+	}
+	for key, value := range overrides {
+		dbConfigs[key] = value
 	}
 
 	// 只覆盖 db:true 的字段
@@ -295,24 +516,81 @@ func (l *Loader) applyDatabaseConfig(ctx context.Context, cfg *config.Config) er
 			continue // db:false，不允许数据库覆盖
 		}
 
-		// 设置到 Viper（覆盖之前的值）
-		l.viper.Set(key, value)
+		if _, ok := os.LookupEnv(envVarName(key)); ok {
+			continue // Layer 6 环境变量优先于数据库
+		}
+
+		// 按字段类型解析后设置到 Viper（覆盖之前的值）
+		typed, err := decodeValue(meta.Type, value)
+		if err != nil {
+			return fmt.Errorf("invalid stored value for config key '%s': %w", key, err)
+		}
+		l.viper.Set(key, typed)
 	}
 
 	// 重新解析到结构体
-	if err := l.viper.Unmarshal(cfg); err != nil {
+	if err := l.viper.Unmarshal(cfg, yamlTagDecoding); err != nil {
 		return fmt.Errorf("failed to re-unmarshal after database config: %w", err)
 	}
 
 	return nil
 }
 
+// keyForField 将 validator 错误的命名空间（如 "Config.POC.APIKey"、"Targets[0]"）映射为配置键
+// namespace 为注册模块名（全局 Config 为空）；无法映射时返回 false
+func (l *Loader) keyForField(namespace, structNamespace string) (string, bool) {
+	// 去掉根类型名
+	_, fieldPath, ok := strings.Cut(structNamespace, ".")
+	if !ok {
+		return "", false
+	}
+	if namespace != "" {
+		fieldPath = namespace + "/" + fieldPath
+	}
+
+	// dive 产生的元素错误（如 Targets[0]）归属于切片/map 字段本身
+	if i := strings.IndexByte(fieldPath, '['); i >= 0 {
+		fieldPath = fieldPath[:i]
+	}
+
+	key, ok := l.fieldKeys[fieldPath]
+	return key, ok
+}
+
 // GetMetadata 获取字段元数据（用于验证和服务层）
 func (l *Loader) GetMetadata(key string) (*fieldMeta, bool) {
 	meta, exists := l.metadata[key]
 	return meta, exists
 }
 
+// sortedKeys 返回全部配置键（按字典序）
+func (l *Loader) sortedKeys() []string {
+	keys := make([]string, 0, len(l.metadata))
+	for key := range l.metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsSecret 检查配置项是否为敏感值（secret:"true"）
+func (l *Loader) IsSecret(key string) bool {
+	meta, exists := l.metadata[key]
+	if !exists {
+		return false
+	}
+	return meta.Secret
+}
+
+// RequiresApproval 检查配置项的修改是否需要审批（approval:"required"）
+func (l *Loader) RequiresApproval(key string) bool {
+	meta, exists := l.metadata[key]
+	if !exists {
+		return false
+	}
+	return meta.Approval
+}
+
 // AllowDatabaseStorage 检查配置项是否允许数据库存储
 func (l *Loader) AllowDatabaseStorage(key string) bool {
 	meta, exists := l.metadata[key]
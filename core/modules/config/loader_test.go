@@ -129,6 +129,105 @@ poc:
 	assert.Equal(t, "custom-poc-db", cfg.POC.Database)
 }
 
+// TestLoader_ConfDFormats 测试 conf_d 中的 YAML/JSON/TOML/.env 文件与 key-per-file 目录按名称顺序合并，并记录来源文件
+func TestLoader_ConfDFormats(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"default.yaml":                            "app:\n  name: from-default\n",
+		"conf_d/10-app.json":                      `{"app": {"name": "from-json", "timezone": "UTC"}}`,
+		"conf_d/20-poc.toml":                      "[poc]\nenabled = false\napi_key = \"toml-api-key-123\"\n",
+		"conf_d/30-db.env":                        "# shared with docker compose\nexport DATABASE_HOST=env-file-host\nDATABASE_PORT=6543\nPOC_API_KEY=\"env-file-key-123\"\napp.version='2.0.0'\nCOMPOSE_PROJECT_NAME=ignored\n",
+		"conf_d/40-secret.keys/database.password": "mounted-password\n",
+		"conf_d/40-secret.keys/poc.enabled":       "true",
+		"conf_d/40-secret.keys/..data":            "ignored",
+		"conf_d/50-last.yml":                      "app:\n  name: from-yml\n",
+		"conf_d/notes.txt":                        "ignored",
+		"conf_d/staging/10.yaml":                  "app:\n  name: from-other-profile\n",
+	})
+
+	loader, err := NewLoader(dir, nil)
+	require.NoError(t, err)
+	cfg, err := loader.Load(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "from-yml", cfg.App.Name)
+	assert.Equal(t, "UTC", cfg.App.Timezone)
+	assert.Equal(t, "2.0.0", cfg.App.Version)
+	assert.Equal(t, "env-file-host", cfg.Database.Host)
+	assert.Equal(t, 6543, cfg.Database.Port)
+	assert.Equal(t, "mounted-password", cfg.Database.Password)
+	assert.Equal(t, "env-file-key-123", cfg.POC.APIKey)
+	assert.True(t, cfg.POC.Enabled)
+
+	assert.Equal(t, "conf_d/50-last.yml", loader.SourceFile("app.name"))
+	assert.Equal(t, "conf_d/10-app.json", loader.SourceFile("app.timezone"))
+	assert.Equal(t, "conf_d/30-db.env", loader.SourceFile("database.port"))
+	assert.Equal(t, "conf_d/40-secret.keys/database.password", loader.SourceFile("database.password"))
+	assert.Empty(t, loader.SourceFile("database.user"))
+
+	files, err := loader.confDFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join("conf_d", "10-app.json"),
+		filepath.Join("conf_d", "20-poc.toml"),
+		filepath.Join("conf_d", "30-db.env"),
+		filepath.Join("conf_d", "40-secret.keys"),
+		filepath.Join("conf_d", "50-last.yml"),
+	}, files)
+
+	// 无法按字段类型解析的值报告所在文件
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "conf_d", "40-secret.keys", "database.port"), []byte("not-a-port"), 0644))
+	_, err = loader.Load(context.Background())
+	assert.ErrorContains(t, err, "conf_d/40-secret.keys/database.port")
+}
+
+// TestLoader_Profile 测试 APP_ENV profile 文件的加载顺序
+func TestLoader_Profile(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "conf_d", "staging"), 0755))
+	t.Setenv(ProfileEnv, "staging")
+
+	files := map[string]string{
+		"default.yaml":             "app:\n  name: default-app\n  timezone: UTC\ndatabase:\n  host: default-db\n  port: 5432\n",
+		"default.staging.yaml":     "database:\n  host: staging-db\n  port: 6432\n",
+		"database.yaml":            "database:\n  port: 7432\n",
+		"default.prod.yaml":        "app:\n  name: prod-app\n",
+		"conf_d/10-site.yaml":      "app:\n  name: site-app\n",
+		"conf_d/staging/10-a.yaml": "app:\n  name: staging-app\n",
+		"conf_d/staging/20-b.yaml": "poc:\n  enabled: true\n",
+		"conf_d/prod/10-prod.yaml": "poc:\n  enabled: false\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tmpDir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644))
+	}
+
+	loader, err := NewLoader(tmpDir, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "staging", loader.Profile())
+
+	cfg, err := loader.Load(context.Background())
+	require.NoError(t, err)
+
+	// default.staging.yaml 覆盖 default.yaml，专用文件覆盖 default.staging.yaml
+	assert.Equal(t, "staging-db", cfg.Database.Host)
+	assert.Equal(t, 7432, cfg.Database.Port)
+	// conf_d/staging 覆盖 conf_d
+	assert.Equal(t, "staging-app", cfg.App.Name)
+	assert.True(t, cfg.POC.Enabled)
+	// 其他 profile 的文件不加载
+	assert.Equal(t, "UTC", cfg.App.Timezone)
+
+	assert.True(t, loader.FromProfile("database.host"))
+	assert.True(t, loader.FromProfile("app.name"))
+	assert.False(t, loader.FromProfile("database.port"))
+	assert.False(t, loader.FromProfile("app.timezone"))
+
+	// 非法的 profile 名称
+	t.Setenv(ProfileEnv, "../etc")
+	_, err = NewLoader(tmpDir, nil)
+	assert.Error(t, err)
+}
+
 // TestLoader_DatabaseOverride 测试 Layer 5: 数据库覆盖
 func TestLoader_DatabaseOverride(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -0,0 +1,35 @@
+package config
+
+import "fmt"
+
+// moduleConfigs maps a registered namespace to the config instance bound by
+// the last successful load (a pointer of the registered type)
+type moduleConfigs map[string]interface{}
+
+// ModuleConfig returns the config bound to namespace by the last successful
+// load, as a pointer of the type passed to ConfigRegistry.Register.
+// The instance is replaced, never modified, on reload; callers must not modify it.
+func (s *Service) ModuleConfig(namespace string) (interface{}, bool) {
+	snap := s.snapshot.Load()
+	if snap == nil {
+		return nil, false
+	}
+	return snap.Module(namespace)
+}
+
+// Module returns a copy of the current config of the module registered under
+// namespace, e.g. config.Module[logger.Config](svc, "logger")
+func Module[T any](s *Service, namespace string) (T, error) {
+	var zero T
+
+	cfg, exists := s.ModuleConfig(namespace)
+	if !exists {
+		return zero, fmt.Errorf("config module '%s' is not registered", namespace)
+	}
+
+	typed, ok := cfg.(*T)
+	if !ok {
+		return zero, fmt.Errorf("config module '%s' is %T, not %T", namespace, cfg, &zero)
+	}
+	return *typed, nil
+}
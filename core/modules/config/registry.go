@@ -21,7 +21,8 @@ func NewRegistry() *ConfigRegistry {
 
 // Register registers a module's config struct
 // namespace: module name (e.g., "logger", "user", "project")
-// configStruct: pointer to config struct (e.g., &logger.Config{})
+// configStruct: pointer to config struct (e.g., &logger.Config{}), used as the type template;
+// every load binds the merged layers into a new instance, read it with Module[T]
 func (r *ConfigRegistry) Register(namespace string, configStruct interface{}) error {
 	if namespace == "" {
 		return fmt.Errorf("namespace cannot be empty")
@@ -0,0 +1,283 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"apprun/pkg/logger"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultReloadDebounce is how long file events are coalesced before a reload
+const DefaultReloadDebounce = 500 * time.Millisecond
+
+// ReloadStatus reports the outcome of file-triggered config reloads
+type ReloadStatus struct {
+	Reloads      int64      `json:"reloads" example:"3"`                                     // Successful reloads
+	Failures     int64      `json:"failures" example:"1"`                                    // Rejected reloads (load or validation error)
+	LastReloadAt *time.Time `json:"last_reload_at,omitempty" example:"2025-12-31T10:00:00Z"` // Time of the last successful reload
+	LastError    string     `json:"last_error,omitempty" example:"config validation failed"` // Error of the last failed reload
+	LastErrorAt  *time.Time `json:"last_error_at,omitempty" example:"2025-12-31T09:59:00Z"`  // Time of the last failed reload
+	Watching     bool       `json:"watching" example:"true"`                                 // Whether the config directory is being watched
+}
+
+// reloadTracker keeps reload counters for a Service
+type reloadTracker struct {
+	mu     sync.Mutex
+	status ReloadStatus
+}
+
+func (t *reloadTracker) success() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.status.Reloads++
+	t.status.LastReloadAt = &now
+}
+
+func (t *reloadTracker) failure(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.status.Failures++
+	t.status.LastError = err.Error()
+	t.status.LastErrorAt = &now
+}
+
+func (t *reloadTracker) setWatching(watching bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.status.Watching = watching
+}
+
+func (t *reloadTracker) snapshot() ReloadStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.status
+}
+
+// Reload 重新执行 6 层加载并验证，只有验证通过才替换当前配置
+// 替换后向订阅者发布生效值发生变化的键（Action 为 reload）；失败时保留旧配置，并记录到 ReloadStatus
+func (s *Service) Reload(ctx context.Context) error {
+	events, err := s.applyReload(ctx)
+	if err != nil {
+		s.reload.failure(err)
+		return fmt.Errorf("config reload rejected, keeping previous config: %w", err)
+	}
+
+	s.reload.success()
+	for _, event := range events {
+		s.watchers.notify(event)
+	}
+	return nil
+}
+
+// applyReload 重新加载并替换缓存（持有写锁），对比新旧快照返回待发布的变更事件
+func (s *Service) applyReload(ctx context.Context) ([]ChangeEvent, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	newCfg, modules, err := s.loader.loadAll(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(newCfg, modules); err != nil {
+		return nil, fmt.Errorf("config validation failed: %w", err)
+	}
+
+	previous := s.snapshot.Load()
+	s.store(newCfg, modules)
+
+	events := snapshotChanges(previous, s.snapshot.Load())
+	for i := range events {
+		events[i] = s.maskEvent(events[i])
+	}
+	return events, nil
+}
+
+// snapshotChanges 按键名顺序列出两个快照间文本值不同的键，作为文件热加载的变更事件
+func snapshotChanges(previous, current *Snapshot) []ChangeEvent {
+	if previous == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(current.values))
+	for key, value := range current.values {
+		if previous.values[key] != value {
+			keys = append(keys, key)
+		}
+	}
+	for key := range previous.values {
+		if _, ok := current.values[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	events := make([]ChangeEvent, 0, len(keys))
+	for _, key := range keys {
+		events = append(events, ChangeEvent{
+			Key:      key,
+			Scope:    GlobalScopeName,
+			OldValue: previous.Value(key),
+			NewValue: current.Value(key),
+			Action:   ChangeActionReload,
+			Actor:    DefaultActor,
+		})
+	}
+	return events
+}
+
+// ReloadStatus 返回文件热加载的统计信息
+func (s *Service) ReloadStatus() ReloadStatus {
+	return s.reload.snapshot()
+}
+
+// WatchFiles 监听配置目录（default.yaml、专用文件、conf_d 及 profile 文件），变更经防抖后自动 Reload
+// 监听在 ctx 结束时停止
+func (s *Service) WatchFiles(ctx context.Context, debounce time.Duration) error {
+	if debounce <= 0 {
+		debounce = DefaultReloadDebounce
+	}
+
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	configDir := s.loader.configDir
+	if err := fw.Add(configDir); err != nil {
+		fw.Close()
+		return fmt.Errorf("failed to watch config dir %s: %w", configDir, err)
+	}
+
+	// conf_d 及 conf_d/<profile> 可能在启动后才创建，此时在事件循环中补充监听
+	dirs := []string{filepath.Join(configDir, "conf_d")}
+	if dir := s.loader.profileConfDDir(); dir != "" {
+		dirs = append(dirs, dir)
+	}
+	for _, dir := range dirs {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			if err := fw.Add(dir); err != nil {
+				fw.Close()
+				return fmt.Errorf("failed to watch %s dir: %w", dir, err)
+			}
+		}
+	}
+
+	// key-per-file 目录（*.keys）内的文件变更同样触发重新加载
+	for _, dir := range dirs {
+		names, _ := configEntries(dir, "")
+		for _, name := range names {
+			if strings.HasSuffix(name, keyDirSuffix) {
+				if err := fw.Add(filepath.Join(dir, name)); err != nil {
+					fw.Close()
+					return fmt.Errorf("failed to watch %s dir: %w", name, err)
+				}
+			}
+		}
+	}
+
+	s.reload.setWatching(true)
+	go s.runFileWatcher(ctx, fw, dirs, debounce)
+	return nil
+}
+
+// runFileWatcher 事件循环：合并短时间内的多次文件事件，只触发一次重新加载
+func (s *Service) runFileWatcher(ctx context.Context, fw *fsnotify.Watcher, dirs []string, debounce time.Duration) {
+	defer func() {
+		fw.Close()
+		s.reload.setWatching(false)
+	}()
+
+	timer := time.NewTimer(debounce)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	var changed []string
+
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+
+		case event, ok := <-fw.Events:
+			if !ok {
+				return
+			}
+
+			if (slices.Contains(dirs, event.Name) || isKeyDirEvent(event)) && event.Has(fsnotify.Create) {
+				if err := fw.Add(event.Name); err != nil {
+					logger.Warn("failed to watch conf_d dir",
+						logger.Field{Key: "dir", Value: event.Name},
+						logger.Field{Key: "error", Value: err})
+				}
+			}
+
+			if !isConfigFileEvent(event, dirs) {
+				continue
+			}
+
+			changed = append(changed, event.Name)
+			timer.Reset(debounce)
+
+		case err, ok := <-fw.Errors:
+			if !ok {
+				return
+			}
+			logger.Warn("config file watcher error", logger.Field{Key: "error", Value: err})
+
+		case <-timer.C:
+			files := changed
+			changed = nil
+
+			if err := s.Reload(ctx); err != nil {
+				status := s.ReloadStatus()
+				logger.Error("config file reload failed",
+					logger.Field{Key: "files", Value: files},
+					logger.Field{Key: "failures", Value: status.Failures},
+					logger.Field{Key: "error", Value: err})
+				continue
+			}
+
+			status := s.ReloadStatus()
+			logger.Info("config files reloaded",
+				logger.Field{Key: "files", Value: files},
+				logger.Field{Key: "reloads", Value: status.Reloads})
+		}
+	}
+}
+
+// isConfigFileEvent 判断事件是否涉及配置文件（忽略编辑器临时文件等）
+func isConfigFileEvent(event fsnotify.Event, dirs []string) bool {
+	if slices.Contains(dirs, event.Name) {
+		return true
+	}
+	if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+		return false
+	}
+
+	// Kubernetes 通过替换 ..data 链接原子更新挂载的 ConfigMap/Secret
+	if strings.HasSuffix(filepath.Dir(event.Name), keyDirSuffix) || isKeyDirEvent(event) {
+		return true
+	}
+	return isConfigFileName(event.Name)
+}
+
+// isKeyDirEvent 判断事件是否针对 key-per-file 目录本身（如新建 conf_d/50-db.keys）
+func isKeyDirEvent(event fsnotify.Event) bool {
+	return strings.HasSuffix(event.Name, keyDirSuffix)
+}
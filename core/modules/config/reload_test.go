@@ -0,0 +1,150 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestService_Reload 测试重新加载：验证通过才替换，失败保留旧配置
+func TestService_Reload(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+	configDir := service.loader.configDir
+
+	var events []ChangeEvent
+	service.Watch("", func(e ChangeEvent) { events = append(events, e) })
+
+	// 无变化的重新加载不发布事件
+	require.NoError(t, service.Reload(ctx))
+	assert.Empty(t, events)
+
+	// 有效变更：conf_d 覆盖 app.name，订阅者收到生效值的变化
+	confD := filepath.Join(configDir, "conf_d")
+	require.NoError(t, os.MkdirAll(confD, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(confD, "app.yaml"), []byte("app:\n  name: from-conf-d\n"), 0644))
+
+	require.NoError(t, service.Reload(ctx))
+	assert.Equal(t, "from-conf-d", service.GetConfig().App.Name)
+	require.Len(t, events, 1)
+	assert.Equal(t, ChangeEvent{
+		Key:      "app.name",
+		Scope:    GlobalScopeName,
+		OldValue: "test-app",
+		NewValue: "from-conf-d",
+		Action:   ChangeActionReload,
+		Actor:    DefaultActor,
+	}, events[0])
+
+	status := service.ReloadStatus()
+	assert.Equal(t, int64(2), status.Reloads)
+	assert.NotNil(t, status.LastReloadAt)
+
+	// 无效变更：端口越界，保留旧配置
+	require.NoError(t, os.WriteFile(filepath.Join(confD, "db.yaml"), []byte("database:\n  port: 70000\n"), 0644))
+
+	err := service.Reload(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "keeping previous config")
+	assert.Equal(t, 5432, service.GetConfig().Database.Port)
+	assert.Len(t, events, 1)
+
+	status = service.ReloadStatus()
+	assert.Equal(t, int64(2), status.Reloads)
+	assert.Equal(t, int64(1), status.Failures)
+	assert.Contains(t, status.LastError, "validation failed")
+}
+
+// TestService_Reload_MasksSecrets 测试重新加载发布的事件中敏感配置项的值已脱敏
+func TestService_Reload_MasksSecrets(t *testing.T) {
+	service, _ := newTestService(t)
+	confD := filepath.Join(service.loader.configDir, "conf_d")
+	require.NoError(t, os.MkdirAll(confD, 0755))
+
+	var events []ChangeEvent
+	service.Watch("poc.", func(e ChangeEvent) { events = append(events, e) })
+
+	require.NoError(t, os.WriteFile(filepath.Join(confD, "poc.yaml"), []byte("poc:\n  api_key: rotated-api-key-123\n"), 0644))
+	require.NoError(t, service.Reload(context.Background()))
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "poc.api_key", events[0].Key)
+	assert.Equal(t, SecretMask, events[0].OldValue)
+	assert.Equal(t, SecretMask, events[0].NewValue)
+	assert.Equal(t, "rotated-api-key-123", service.GetConfig().POC.APIKey)
+}
+
+// TestService_WatchFiles 测试监听 conf_d 目录并防抖重新加载
+func TestService_WatchFiles(t *testing.T) {
+	service, _ := newTestService(t)
+	configDir := service.loader.configDir
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 文件变更经热加载后通知订阅者
+	notified := make(chan ChangeEvent, 10)
+	service.Watch("app.name", func(e ChangeEvent) { notified <- e })
+
+	require.NoError(t, service.WatchFiles(ctx, 20*time.Millisecond))
+	assert.True(t, service.ReloadStatus().Watching)
+
+	// conf_d 在监听开始后才创建
+	confD := filepath.Join(configDir, "conf_d")
+	require.NoError(t, os.MkdirAll(confD, 0755))
+	time.Sleep(50 * time.Millisecond)
+
+	// 连续多次写入只触发少量重新加载
+	target := filepath.Join(confD, "app.yaml")
+	for i := 0; i < 5; i++ {
+		require.NoError(t, os.WriteFile(target, []byte("app:\n  name: hot-reloaded\n"), 0644))
+	}
+	// 编辑器临时文件被忽略
+	require.NoError(t, os.WriteFile(filepath.Join(confD, ".app.yaml.swp"), []byte("x"), 0644))
+
+	require.Eventually(t, func() bool {
+		return service.GetConfig().App.Name == "hot-reloaded"
+	}, 2*time.Second, 10*time.Millisecond)
+
+	select {
+	case event := <-notified:
+		assert.Equal(t, "hot-reloaded", event.NewValue)
+		assert.Equal(t, ChangeActionReload, event.Action)
+	case <-time.After(time.Second):
+		t.Fatal("watcher was not notified of the file change")
+	}
+
+	status := service.ReloadStatus()
+	assert.GreaterOrEqual(t, status.Reloads, int64(1))
+	assert.Less(t, status.Reloads, int64(5))
+
+	// 停止监听
+	cancel()
+	require.Eventually(t, func() bool {
+		return !service.ReloadStatus().Watching
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestHandler_GetReloadStatus 测试热加载状态接口
+func TestHandler_GetReloadStatus(t *testing.T) {
+	service, _ := newTestService(t)
+	handler := NewHandler(service)
+	require.NoError(t, service.Reload(context.Background()))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/config/reload/status", nil)
+	w := httptest.NewRecorder()
+	handler.GetReloadStatus(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var status ReloadStatus
+	decodeData(t, w, &status)
+	assert.Equal(t, int64(1), status.Reloads)
+	assert.False(t, status.Watching)
+}
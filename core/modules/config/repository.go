@@ -2,28 +2,41 @@ package config
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 
 	"apprun/ent"
+	"apprun/ent/configchangerequest"
+	"apprun/ent/confighistory"
 	"apprun/ent/configitem"
+	"apprun/ent/configschedule"
+	"apprun/pkg/database"
+	"apprun/pkg/logger"
 )
 
 // Repository 实现 ConfigProvider 接口，提供数据库访问层
 // 使用反腐层模式，隔离 Ent 实现细节
 type Repository struct {
+	db     database.Client
 	client *ent.Client
 }
 
 // NewRepository 创建配置仓储实例
-func NewRepository(client *ent.Client) *Repository {
-	return &Repository{client: client}
+// 写操作通过 database.Client.Tx 在事务中执行
+func NewRepository(db database.Client) *Repository {
+	return &Repository{
+		db:     db,
+		client: db.GetEntClient(),
+	}
 }
 
-// GetConfig 根据 key 获取配置项
+// GetConfig 根据 key 获取 ctx 作用域中的配置项
 func (r *Repository) GetConfig(ctx context.Context, key string) (value string, isDynamic bool, err error) {
 	item, err := r.client.Configitem.
 		Query().
-		Where(configitem.KeyEQ(key)).
+		Where(configitem.KeyEQ(key), configitem.ScopeEQ(ScopeFromContext(ctx).String())).
 		Only(ctx)
 
 	if err != nil {
@@ -36,51 +49,105 @@ func (r *Repository) GetConfig(ctx context.Context, key string) (value string, i
 	return item.Value, item.IsDynamic, nil
 }
 
-// SetConfig 设置动态配置项
+// SetConfig 设置动态配置项，并在同一事务中记录变更历史
 func (r *Repository) SetConfig(ctx context.Context, key string, value string) error {
-	// 检查配置项是否存在
-	exists, err := r.client.Configitem.
+	return r.db.Tx(ctx, func(tx *ent.Tx) error {
+		return r.setConfigTx(ctx, tx, key, value, anyRevision)
+	})
+}
+
+// SetConfigIfRevision 仅当当前修订号等于 expected 时写入（0 表示期望尚不存在）
+func (r *Repository) SetConfigIfRevision(ctx context.Context, key string, value string, expected int) error {
+	return r.db.Tx(ctx, func(tx *ent.Tx) error {
+		return r.setConfigTx(ctx, tx, key, value, expected)
+	})
+}
+
+// SetConfigs 在同一事务中设置多个动态配置项，要么全部写入，要么全部不写入
+func (r *Repository) SetConfigs(ctx context.Context, items map[string]string) error {
+	// 按键排序，保证历史记录顺序与加锁顺序稳定
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return r.db.Tx(ctx, func(tx *ent.Tx) error {
+		for _, key := range keys {
+			if err := r.setConfigTx(ctx, tx, key, items[key], anyRevision); err != nil {
+				return fmt.Errorf("failed to set config '%s': %w", key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// setConfigTx 在事务内写入单个配置项并记录变更历史
+// expected 为 anyRevision 时无条件写入，否则修订号不匹配返回 *RevisionConflictError
+func (r *Repository) setConfigTx(ctx context.Context, tx *ent.Tx, key string, value string, expected int) error {
+	// 查询现有配置项（用于判断更新/创建并记录旧值）
+	item, err := tx.Configitem.
 		Query().
-		Where(configitem.KeyEQ(key)).
-		Exist(ctx)
+		Where(configitem.KeyEQ(key), configitem.ScopeEQ(ScopeFromContext(ctx).String())).
+		Only(ctx)
 
-	if err != nil {
+	if err != nil && !ent.IsNotFound(err) {
 		return fmt.Errorf("failed to check config existence: %w", err)
 	}
 
-	if exists {
-		// 更新现有配置
-		err = r.client.Configitem.
+	current := 0
+	if item != nil {
+		current = item.Revision
+	}
+	if expected != anyRevision && expected != current {
+		return &RevisionConflictError{Key: key, Expected: expected}
+	}
+
+	var oldValue *string
+	if item != nil {
+		oldValue = &item.Value
+
+		// 更新现有配置（带修订号条件，防止并发事务覆盖）
+		updated, err := tx.Configitem.
 			Update().
-			Where(configitem.KeyEQ(key)).
+			Where(configitem.ID(item.ID), configitem.RevisionEQ(item.Revision)).
 			SetValue(value).
-			Exec(ctx)
+			AddRevision(1).
+			Save(ctx)
 
 		if err != nil {
 			return fmt.Errorf("failed to update config: %w", err)
 		}
+		if updated == 0 {
+			return &RevisionConflictError{Key: key, Expected: item.Revision}
+		}
 	} else {
 		// 创建新配置项（标记为动态）
-		_, err = r.client.Configitem.
+		_, err = tx.Configitem.
 			Create().
 			SetKey(key).
+			SetScope(ScopeFromContext(ctx).String()).
 			SetValue(value).
 			SetIsDynamic(true).
 			Save(ctx)
 
 		if err != nil {
+			if ent.IsConstraintError(err) {
+				return &RevisionConflictError{Key: key, Expected: 0}
+			}
 			return fmt.Errorf("failed to create config: %w", err)
 		}
 	}
 
-	return nil
+	action := changeActionFromContext(ctx, ChangeActionSet)
+	return r.recordChange(ctx, tx, key, oldValue, &value, action)
 }
 
-// ListDynamicConfigs 列出所有动态配置项
+// ListDynamicConfigs 列出 ctx 作用域中的所有动态配置项
 func (r *Repository) ListDynamicConfigs(ctx context.Context) (map[string]string, error) {
 	items, err := r.client.Configitem.
 		Query().
-		Where(configitem.IsDynamicEQ(true)).
+		Where(configitem.IsDynamicEQ(true), configitem.ScopeEQ(ScopeFromContext(ctx).String())).
 		All(ctx)
 
 	if err != nil {
@@ -95,20 +162,463 @@ func (r *Repository) ListDynamicConfigs(ctx context.Context) (map[string]string,
 	return result, nil
 }
 
-// DeleteConfig 删除动态配置项
+// ListScopes 列出存储了动态配置的全部作用域名称（按名称排序）
+func (r *Repository) ListScopes(ctx context.Context) ([]string, error) {
+	scopes, err := r.client.Configitem.
+		Query().
+		Unique(true).
+		Order(ent.Asc(configitem.FieldScope)).
+		Select(configitem.FieldScope).
+		Strings(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config scopes: %w", err)
+	}
+	return scopes, nil
+}
+
+// DeleteConfig 删除动态配置项，并在同一事务中记录变更历史
 func (r *Repository) DeleteConfig(ctx context.Context, key string) error {
-	deleted, err := r.client.Configitem.
+	return r.db.Tx(ctx, func(tx *ent.Tx) error {
+		return r.deleteConfigTx(ctx, tx, key, anyRevision)
+	})
+}
+
+// DeleteConfigIfRevision 仅当当前修订号等于 expected 时删除
+func (r *Repository) DeleteConfigIfRevision(ctx context.Context, key string, expected int) error {
+	return r.db.Tx(ctx, func(tx *ent.Tx) error {
+		return r.deleteConfigTx(ctx, tx, key, expected)
+	})
+}
+
+// deleteConfigTx 在事务内删除配置项并记录变更历史
+func (r *Repository) deleteConfigTx(ctx context.Context, tx *ent.Tx, key string, expected int) error {
+	item, err := tx.Configitem.
+		Query().
+		Where(configitem.KeyEQ(key), configitem.ScopeEQ(ScopeFromContext(ctx).String())).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			if expected != anyRevision && expected != 0 {
+				return &RevisionConflictError{Key: key, Expected: expected}
+			}
+			return fmt.Errorf("config key not found: %s", key)
+		}
+		return fmt.Errorf("failed to query config: %w", err)
+	}
+
+	if expected != anyRevision && expected != item.Revision {
+		return &RevisionConflictError{Key: key, Expected: expected}
+	}
+
+	deleted, err := tx.Configitem.
 		Delete().
-		Where(configitem.KeyEQ(key)).
+		Where(configitem.ID(item.ID), configitem.RevisionEQ(item.Revision)).
 		Exec(ctx)
 
 	if err != nil {
 		return fmt.Errorf("failed to delete config: %w", err)
 	}
-
 	if deleted == 0 {
-		return fmt.Errorf("config key not found: %s", key)
+		return &RevisionConflictError{Key: key, Expected: item.Revision}
+	}
+
+	action := changeActionFromContext(ctx, ChangeActionDelete)
+	return r.recordChange(ctx, tx, key, &item.Value, nil, action)
+}
+
+// GetRevision 返回动态配置项的当前修订号（数据库中不存在时为 0）
+func (r *Repository) GetRevision(ctx context.Context, key string) (int, error) {
+	item, err := r.client.Configitem.
+		Query().
+		Where(configitem.KeyEQ(key), configitem.ScopeEQ(ScopeFromContext(ctx).String())).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to query config revision: %w", err)
+	}
+
+	return item.Revision, nil
+}
+
+// ListHistory 列出配置项的变更历史（按修订号倒序）
+func (r *Repository) ListHistory(ctx context.Context, key string, limit int) ([]ChangeRecord, error) {
+	query := r.client.ConfigHistory.
+		Query().
+		Where(confighistory.KeyEQ(key), confighistory.ScopeEQ(ScopeFromContext(ctx).String())).
+		Order(ent.Desc(confighistory.FieldID))
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config history: %w", err)
+	}
+
+	result := make([]ChangeRecord, 0, len(items))
+	for _, item := range items {
+		result = append(result, toChangeRecord(item))
+	}
+
+	return result, nil
+}
+
+// GetHistory 根据修订号获取单条变更记录
+func (r *Repository) GetHistory(ctx context.Context, revision int) (*ChangeRecord, error) {
+	item, err := r.client.ConfigHistory.Get(ctx, revision)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("config revision not found: %d", revision)
+		}
+		return nil, fmt.Errorf("failed to query config history: %w", err)
+	}
+
+	record := toChangeRecord(item)
+	return &record, nil
+}
+
+// ListChangesSince 列出修订号大于 revision 且键匹配 prefix 的变更（按修订号正序）
+func (r *Repository) ListChangesSince(ctx context.Context, prefix string, revision int, limit int) ([]ChangeRecord, error) {
+	query := r.client.ConfigHistory.
+		Query().
+		Where(confighistory.IDGT(revision)).
+		Order(ent.Asc(confighistory.FieldID))
+
+	if prefix != "" {
+		query = query.Where(confighistory.KeyHasPrefix(prefix))
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config changes: %w", err)
+	}
+
+	result := make([]ChangeRecord, 0, len(items))
+	for _, item := range items {
+		result = append(result, toChangeRecord(item))
+	}
+
+	return result, nil
+}
+
+// LatestRevision 返回当前最大的修订号（无变更时为 0）
+func (r *Repository) LatestRevision(ctx context.Context) (int, error) {
+	id, err := r.client.ConfigHistory.
+		Query().
+		Order(ent.Desc(confighistory.FieldID)).
+		FirstID(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to query latest config revision: %w", err)
+	}
+
+	return id, nil
+}
+
+// UpdateHistoryValues 改写变更记录的旧值与新值（重新加密敏感值），不产生新的变更记录
+func (r *Repository) UpdateHistoryValues(ctx context.Context, revision int, oldValue, newValue *string) error {
+	update := r.client.ConfigHistory.UpdateOneID(revision)
+	if oldValue != nil {
+		update = update.SetOldValue(*oldValue)
+	} else {
+		update = update.ClearOldValue()
+	}
+	if newValue != nil {
+		update = update.SetNewValue(*newValue)
+	} else {
+		update = update.ClearNewValue()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("config revision not found: %d", revision)
+		}
+		return fmt.Errorf("failed to update config history: %w", err)
+	}
+	return nil
+}
+
+// CreateSchedule 保存计划变更，作用域、操作人与请求 ID 取自 ctx
+func (r *Repository) CreateSchedule(ctx context.Context, schedule *Schedule) error {
+	item, err := r.client.ConfigSchedule.
+		Create().
+		SetKey(schedule.Key).
+		SetScope(ScopeFromContext(ctx).String()).
+		SetValue(schedule.Value).
+		SetEffectiveAt(schedule.EffectiveAt).
+		SetNillableExpiresAt(schedule.ExpiresAt).
+		SetStatus(configschedule.StatusPending).
+		SetActor(ActorFromContext(ctx)).
+		SetRequestID(RequestIDFromContext(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create config schedule: %w", err)
+	}
+
+	*schedule = toSchedule(item)
+	return nil
+}
+
+// GetSchedule 根据 ID 获取计划变更
+func (r *Repository) GetSchedule(ctx context.Context, id int) (*Schedule, error) {
+	item, err := r.client.ConfigSchedule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("config schedule not found: %d", id)
+		}
+		return nil, fmt.Errorf("failed to query config schedule: %w", err)
+	}
+
+	schedule := toSchedule(item)
+	return &schedule, nil
+}
+
+// ListSchedules 列出状态属于 statuses 的计划变更（按 ID 正序）
+func (r *Repository) ListSchedules(ctx context.Context, statuses ...string) ([]Schedule, error) {
+	query := r.client.ConfigSchedule.
+		Query().
+		Order(ent.Asc(configschedule.FieldID))
+
+	if len(statuses) > 0 {
+		values := make([]configschedule.Status, len(statuses))
+		for i, status := range statuses {
+			values[i] = configschedule.Status(status)
+		}
+		query = query.Where(configschedule.StatusIn(values...))
+	}
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config schedules: %w", err)
+	}
+
+	result := make([]Schedule, 0, len(items))
+	for _, item := range items {
+		result = append(result, toSchedule(item))
+	}
+	return result, nil
+}
+
+// UpdateSchedule 仅当计划当前状态等于 expected 时更新（条件更新，多实例只有一个能完成状态转换）
+func (r *Repository) UpdateSchedule(ctx context.Context, schedule *Schedule, expected string) error {
+	update := r.client.ConfigSchedule.
+		Update().
+		Where(configschedule.ID(schedule.ID), configschedule.StatusEQ(configschedule.Status(expected))).
+		SetStatus(configschedule.Status(schedule.Status)).
+		SetAppliedRevision(schedule.AppliedRevision).
+		SetMessage(schedule.Message)
+
+	if schedule.PriorValue != nil {
+		update = update.SetPriorValue(*schedule.PriorValue)
+	} else {
+		update = update.ClearPriorValue()
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update config schedule: %w", err)
+	}
+	if updated == 0 {
+		if _, err := r.GetSchedule(ctx, schedule.ID); err != nil {
+			return err
+		}
+		return &ScheduleConflictError{ID: schedule.ID, Expected: expected}
+	}
+	return nil
+}
+
+// CreateChangeRequest 保存待审批的变更申请，作用域、申请人与请求 ID 取自 ctx
+func (r *Repository) CreateChangeRequest(ctx context.Context, request *ChangeRequest) error {
+	item, err := r.client.ConfigChangeRequest.
+		Create().
+		SetKey(request.Key).
+		SetScope(ScopeFromContext(ctx).String()).
+		SetValue(request.Value).
+		SetStatus(configchangerequest.StatusPending).
+		SetRequestedBy(ActorFromContext(ctx)).
+		SetRequestID(RequestIDFromContext(ctx)).
+		SetExpiresAt(request.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create config change request: %w", err)
 	}
 
+	*request = toChangeRequest(item)
 	return nil
 }
+
+// GetChangeRequest 根据 ID 获取变更申请
+func (r *Repository) GetChangeRequest(ctx context.Context, id int) (*ChangeRequest, error) {
+	item, err := r.client.ConfigChangeRequest.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("config change request not found: %d", id)
+		}
+		return nil, fmt.Errorf("failed to query config change request: %w", err)
+	}
+
+	request := toChangeRequest(item)
+	return &request, nil
+}
+
+// ListChangeRequests 列出状态属于 statuses 的变更申请（按 ID 正序）
+func (r *Repository) ListChangeRequests(ctx context.Context, statuses ...string) ([]ChangeRequest, error) {
+	query := r.client.ConfigChangeRequest.
+		Query().
+		Order(ent.Asc(configchangerequest.FieldID))
+
+	if len(statuses) > 0 {
+		values := make([]configchangerequest.Status, len(statuses))
+		for i, status := range statuses {
+			values[i] = configchangerequest.Status(status)
+		}
+		query = query.Where(configchangerequest.StatusIn(values...))
+	}
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config change requests: %w", err)
+	}
+
+	result := make([]ChangeRequest, 0, len(items))
+	for _, item := range items {
+		result = append(result, toChangeRequest(item))
+	}
+	return result, nil
+}
+
+// UpdateChangeRequest 仅当申请当前状态等于 expected 时更新（条件更新，同一申请只能被审批一次）
+func (r *Repository) UpdateChangeRequest(ctx context.Context, request *ChangeRequest, expected string) error {
+	updated, err := r.client.ConfigChangeRequest.
+		Update().
+		Where(configchangerequest.ID(request.ID), configchangerequest.StatusEQ(configchangerequest.Status(expected))).
+		SetStatus(configchangerequest.Status(request.Status)).
+		SetReviewedBy(request.ReviewedBy).
+		SetComment(request.Comment).
+		SetMessage(request.Message).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to update config change request: %w", err)
+	}
+	if updated == 0 {
+		if _, err := r.GetChangeRequest(ctx, request.ID); err != nil {
+			return err
+		}
+		return &ChangeRequestConflictError{ID: request.ID, Expected: expected}
+	}
+	return nil
+}
+
+// recordChange 在事务内写入一条变更历史，提交后向其他实例发布变更通知
+func (r *Repository) recordChange(ctx context.Context, tx *ent.Tx, key string, oldValue, newValue *string, action string) error {
+	record, err := tx.ConfigHistory.
+		Create().
+		SetKey(key).
+		SetScope(ScopeFromContext(ctx).String()).
+		SetNillableOldValue(oldValue).
+		SetNillableNewValue(newValue).
+		SetAction(confighistory.Action(action)).
+		SetActor(ActorFromContext(ctx)).
+		SetRequestID(RequestIDFromContext(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to record config history: %w", err)
+	}
+
+	r.publishOnCommit(tx, changeNotification{Revision: record.ID, Key: record.Key, Scope: record.Scope})
+	return nil
+}
+
+// publishOnCommit 在事务成功提交后通过 NOTIFY 发布变更（见 ChangeChannel）
+// 发布失败只记录日志：其他实例的定期对账会补上遗漏的通知
+func (r *Repository) publishOnCommit(tx *ent.Tx, notification changeNotification) {
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+
+			payload, err := json.Marshal(notification)
+			if err == nil {
+				err = r.db.Notify(ctx, ChangeChannel, string(payload))
+			}
+			if err != nil && !errors.Is(err, database.ErrNotifyUnsupported) {
+				logger.Warn("failed to publish config change",
+					logger.Field{Key: "revision", Value: notification.Revision},
+					logger.Field{Key: "key", Value: notification.Key},
+					logger.Field{Key: "error", Value: err})
+			}
+			return nil
+		})
+	})
+}
+
+// toChangeRecord 将 Ent 实体转换为领域模型
+func toChangeRecord(item *ent.ConfigHistory) ChangeRecord {
+	return ChangeRecord{
+		Revision:  item.ID,
+		Key:       item.Key,
+		Scope:     item.Scope,
+		OldValue:  item.OldValue,
+		NewValue:  item.NewValue,
+		Action:    string(item.Action),
+		Actor:     item.Actor,
+		RequestID: item.RequestID,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+// toSchedule 将 Ent 实体转换为领域模型
+func toSchedule(item *ent.ConfigSchedule) Schedule {
+	return Schedule{
+		ID:              item.ID,
+		Key:             item.Key,
+		Scope:           item.Scope,
+		Value:           item.Value,
+		PriorValue:      item.PriorValue,
+		AppliedRevision: item.AppliedRevision,
+		EffectiveAt:     item.EffectiveAt,
+		ExpiresAt:       item.ExpiresAt,
+		Status:          string(item.Status),
+		Message:         item.Message,
+		Actor:           item.Actor,
+		RequestID:       item.RequestID,
+		CreatedAt:       item.CreatedAt,
+		UpdatedAt:       item.UpdatedAt,
+	}
+}
+
+// toChangeRequest 将 Ent 实体转换为领域模型
+func toChangeRequest(item *ent.ConfigChangeRequest) ChangeRequest {
+	return ChangeRequest{
+		ID:          item.ID,
+		Key:         item.Key,
+		Scope:       item.Scope,
+		Value:       item.Value,
+		Status:      string(item.Status),
+		RequestedBy: item.RequestedBy,
+		RequestID:   item.RequestID,
+		ReviewedBy:  item.ReviewedBy,
+		Comment:     item.Comment,
+		Message:     item.Message,
+		ExpiresAt:   item.ExpiresAt,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"apprun/ent"
+	"apprun/ent/enttest"
+	"apprun/pkg/database"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteClient 以 SQLite 内存库实现 database.Client，不支持 LISTEN/NOTIFY
+type sqliteClient struct {
+	client *ent.Client
+}
+
+func (c *sqliteClient) Close() error                   { return c.client.Close() }
+func (c *sqliteClient) Ping(ctx context.Context) error { return nil }
+func (c *sqliteClient) GetEntClient() *ent.Client      { return c.client }
+
+func (c *sqliteClient) Tx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := c.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *sqliteClient) Notify(ctx context.Context, channel, payload string) error {
+	return database.ErrNotifyUnsupported
+}
+
+func (c *sqliteClient) Listen(channel string) (database.Listener, error) {
+	return nil, database.ErrNotifyUnsupported
+}
+
+// newTestRepository 创建基于 SQLite 内存库（已自动迁移）的 Repository
+func newTestRepository(t *testing.T) *Repository {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return NewRepository(&sqliteClient{client: client})
+}
@@ -0,0 +1,321 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"apprun/pkg/logger"
+)
+
+// DefaultScheduleInterval is how often the scheduler looks for schedules that are due
+const DefaultScheduleInterval = 10 * time.Second
+
+// ScheduleConfig 计划在 effectiveAt 写入动态配置项，expiresAt 不为 nil 时到期恢复生效前的值
+// 键与值立即按 UpdateConfig 的规则校验；effectiveAt 不晚于当前时间时立即生效，
+// 生效时验证失败返回错误（计划记录为 failed），修订号冲突则保持 pending，由调度器重试
+// 需要审批的配置项不能计划变更，返回 *ApprovalRequiredError
+func (s *Service) ScheduleConfig(ctx context.Context, key string, value string, effectiveAt time.Time, expiresAt *time.Time) (*Schedule, error) {
+	if err := s.checkApproval(ctx, key); err != nil {
+		return nil, err
+	}
+
+	value, err := s.normalizeDynamicValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if expiresAt != nil {
+		if !expiresAt.After(effectiveAt) {
+			return nil, fmt.Errorf("expires_at must be after effective_at")
+		}
+		if !expiresAt.After(now) {
+			return nil, fmt.Errorf("expires_at must be in the future")
+		}
+	}
+
+	schedule := &Schedule{Key: key, Value: value, EffectiveAt: effectiveAt, ExpiresAt: expiresAt}
+	if err := s.provider.CreateSchedule(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to create config schedule: %w", err)
+	}
+	if effectiveAt.After(now) {
+		return schedule, nil
+	}
+
+	if err := s.applySchedule(ctx, schedule); err != nil {
+		var conflict *RevisionConflictError
+		if errors.As(err, &conflict) {
+			return schedule, nil
+		}
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// ListSchedules 列出状态属于 statuses 的计划变更（全部作用域，statuses 为空时列出全部）
+func (s *Service) ListSchedules(ctx context.Context, statuses ...string) ([]Schedule, error) {
+	schedules, err := s.provider.ListSchedules(ctx, statuses...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// GetSchedule 根据 ID 获取计划变更
+func (s *Service) GetSchedule(ctx context.Context, id int) (*Schedule, error) {
+	return s.provider.GetSchedule(ctx, id)
+}
+
+// CancelSchedule 取消计划变更：pending 的计划不再生效，active 的计划立即恢复生效前的值
+func (s *Service) CancelSchedule(ctx context.Context, id int) (*Schedule, error) {
+	schedule, err := s.provider.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch schedule.Status {
+	case ScheduleStatusPending:
+		next := *schedule
+		next.Status = ScheduleStatusCancelled
+		if err := s.provider.UpdateSchedule(ctx, &next, ScheduleStatusPending); err != nil {
+			return nil, fmt.Errorf("failed to cancel config schedule: %w", err)
+		}
+		return &next, nil
+
+	case ScheduleStatusActive:
+		if err := s.revertSchedule(ctx, schedule, ScheduleStatusCancelled); err != nil {
+			return nil, fmt.Errorf("failed to cancel config schedule: %w", err)
+		}
+		return schedule, nil
+	}
+
+	return nil, fmt.Errorf("config schedule %d is already %s", id, schedule.Status)
+}
+
+// RunScheduler 在后台按计划生效与恢复动态配置，每隔 interval 检查一次，在 ctx 结束时停止
+// 计划保存在存储中：启动时立即检查，补上停机期间到期的计划；多个实例共享存储时，
+// 状态转换使用条件更新（UpdateSchedule），每个计划只会被一个实例处理
+func (s *Service) RunScheduler(ctx context.Context, interval time.Duration) error {
+	if s.provider == nil {
+		return fmt.Errorf("config scheduler requires a config provider")
+	}
+	if interval <= 0 {
+		interval = DefaultScheduleInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			s.runDueSchedules(ctx, time.Now())
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// runDueSchedules 处理 now 时已到期的计划，返回处理成功的计划数
+func (s *Service) runDueSchedules(ctx context.Context, now time.Time) int {
+	schedules, err := s.provider.ListSchedules(ctx, ScheduleStatusPending, ScheduleStatusActive)
+	if err != nil {
+		logger.Error("failed to list config schedules", logger.Field{Key: "error", Value: err})
+		return 0
+	}
+
+	processed := 0
+	for _, listed := range schedules {
+		if !scheduleDue(&listed, now) {
+			continue
+		}
+
+		// 重新读取：列表中无法解密的敏感值已被脱敏，状态也可能已被其他实例修改
+		schedule, err := s.provider.GetSchedule(ctx, listed.ID)
+		if err == nil {
+			err = s.runSchedule(ctx, schedule, now)
+		}
+
+		var claimed *ScheduleConflictError
+		if errors.As(err, &claimed) {
+			continue
+		}
+		if err != nil {
+			logger.Error("config schedule failed",
+				logger.Field{Key: "schedule", Value: listed.ID},
+				logger.Field{Key: "key", Value: listed.Key},
+				logger.Field{Key: "error", Value: err})
+			continue
+		}
+
+		logger.Info("config schedule processed",
+			logger.Field{Key: "schedule", Value: schedule.ID},
+			logger.Field{Key: "key", Value: schedule.Key},
+			logger.Field{Key: "scope", Value: schedule.Scope},
+			logger.Field{Key: "status", Value: schedule.Status})
+		processed++
+	}
+	return processed
+}
+
+// scheduleDue 判断计划在 now 时是否需要生效或恢复
+func scheduleDue(schedule *Schedule, now time.Time) bool {
+	switch schedule.Status {
+	case ScheduleStatusPending:
+		return !schedule.EffectiveAt.After(now)
+	case ScheduleStatusActive:
+		return schedule.ExpiresAt != nil && !schedule.ExpiresAt.After(now)
+	}
+	return false
+}
+
+// runSchedule 生效或恢复一个已到期的计划
+func (s *Service) runSchedule(ctx context.Context, schedule *Schedule, now time.Time) error {
+	if !scheduleDue(schedule, now) {
+		return nil
+	}
+
+	if schedule.Status == ScheduleStatusActive {
+		return s.revertSchedule(ctx, schedule, ScheduleStatusCompleted)
+	}
+
+	// 停机期间整个时间窗口已经过去：不再生效
+	if schedule.ExpiresAt != nil && !schedule.ExpiresAt.After(now) {
+		next := *schedule
+		next.Status = ScheduleStatusCancelled
+		next.Message = "expired before it could be applied"
+		if err := s.provider.UpdateSchedule(ctx, &next, ScheduleStatusPending); err != nil {
+			return err
+		}
+		*schedule = next
+		return nil
+	}
+
+	return s.applySchedule(ctx, schedule)
+}
+
+// applySchedule 生效计划：先以条件更新认领计划并记录生效前的值与写入后的修订号，再写入配置
+// 写入时修订号冲突（期间有其他写入）则退回 pending，下次检查重试；验证失败标记为 failed
+func (s *Service) applySchedule(ctx context.Context, schedule *Schedule) error {
+	ctx = scheduleContext(ctx, schedule)
+
+	prior, stored, err := s.provider.GetConfig(ctx, schedule.Key)
+	stored = err == nil && stored
+	revision, err := s.provider.GetRevision(ctx, schedule.Key)
+	if err != nil {
+		return fmt.Errorf("failed to get config revision: %w", err)
+	}
+
+	claimed := *schedule
+	claimed.Status = ScheduleStatusActive
+	if schedule.ExpiresAt == nil {
+		claimed.Status = ScheduleStatusCompleted
+	}
+	claimed.PriorValue = nil
+	if stored {
+		claimed.PriorValue = &prior
+	}
+	claimed.AppliedRevision = revision + 1 // 每次写入修订号加 1
+	if err := s.provider.UpdateSchedule(ctx, &claimed, ScheduleStatusPending); err != nil {
+		return err
+	}
+	*schedule = claimed
+
+	applyErr := s.UpdateConfigIfMatch(ctx, schedule.Key, schedule.Value, revision)
+	if applyErr == nil {
+		return nil
+	}
+
+	next := *schedule
+	var conflict *RevisionConflictError
+	if errors.As(applyErr, &conflict) {
+		next.Status = ScheduleStatusPending
+		next.PriorValue = nil
+		next.AppliedRevision = 0
+	} else {
+		next.Status = ScheduleStatusFailed
+		next.Message = applyErr.Error()
+	}
+	if err := s.provider.UpdateSchedule(ctx, &next, schedule.Status); err != nil {
+		return err
+	}
+	*schedule = next
+	return applyErr
+}
+
+// revertSchedule 恢复生效前的值：先认领计划（active → status），再以生效时的修订号条件写入
+// 生效后该键又被修改时不恢复，在 Message 中说明；恢复失败标记为 failed
+func (s *Service) revertSchedule(ctx context.Context, schedule *Schedule, status string) error {
+	ctx = withChangeAction(scheduleContext(ctx, schedule), ChangeActionRevert)
+
+	claimed := *schedule
+	claimed.Status = status
+	if err := s.provider.UpdateSchedule(ctx, &claimed, ScheduleStatusActive); err != nil {
+		return err
+	}
+	*schedule = claimed
+
+	var revertErr error
+	if schedule.PriorValue != nil {
+		revertErr = s.UpdateConfigIfMatch(ctx, schedule.Key, *schedule.PriorValue, schedule.AppliedRevision)
+	} else {
+		revertErr = s.DeleteDynamicConfigIfMatch(ctx, schedule.Key, schedule.AppliedRevision)
+	}
+	if revertErr == nil {
+		return nil
+	}
+
+	next := *schedule
+	var conflict *RevisionConflictError
+	if errors.As(revertErr, &conflict) {
+		next.Message = "config key was changed after the schedule was applied, prior value not restored"
+		revertErr = nil
+	} else {
+		next.Status = ScheduleStatusFailed
+		next.Message = "failed to restore prior value: " + revertErr.Error()
+	}
+	if err := s.provider.UpdateSchedule(ctx, &next, schedule.Status); err != nil {
+		return err
+	}
+	*schedule = next
+	return revertErr
+}
+
+// scheduleContext 返回计划作用域中的 ctx；ctx 未指定操作人时（调度器）记为创建计划的操作人
+func scheduleContext(ctx context.Context, schedule *Schedule) context.Context {
+	if scope, err := ParseScope(schedule.Scope); err == nil {
+		ctx = WithScope(ctx, scope)
+	}
+	if ActorFromContext(ctx) == DefaultActor {
+		ctx = WithActor(ctx, schedule.Actor)
+	}
+	return ctx
+}
+
+// MaskSchedules 返回对敏感配置项脱敏后的计划副本
+func (s *Service) MaskSchedules(schedules []Schedule) []Schedule {
+	masked := make([]Schedule, len(schedules))
+	for i, schedule := range schedules {
+		masked[i] = s.MaskSchedule(schedule)
+	}
+	return masked
+}
+
+// MaskSchedule 返回对敏感配置项脱敏后的计划
+func (s *Service) MaskSchedule(schedule Schedule) Schedule {
+	if !s.IsSecret(schedule.Key) {
+		return schedule
+	}
+	schedule.Value = s.MaskValue(schedule.Key, schedule.Value)
+	if schedule.PriorValue != nil {
+		prior := s.MaskValue(schedule.Key, *schedule.PriorValue)
+		schedule.PriorValue = &prior
+	}
+	return schedule
+}
@@ -0,0 +1,219 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestService_ScheduleConfig 测试计划在生效时间写入、到期恢复生效前的值
+func TestService_ScheduleConfig(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := WithActor(context.Background(), "alice")
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "before"))
+
+	now := time.Now()
+	expiresAt := now.Add(2 * time.Hour)
+	schedule, err := service.ScheduleConfig(ctx, "app.name", "window", now.Add(time.Hour), &expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduleStatusPending, schedule.Status)
+	assert.Equal(t, "alice", schedule.Actor)
+
+	// 未到生效时间
+	assert.Equal(t, 0, service.runDueSchedules(context.Background(), now))
+	assert.Equal(t, "before", service.GetConfig().App.Name)
+
+	assert.Equal(t, 1, service.runDueSchedules(context.Background(), now.Add(90*time.Minute)))
+	assert.Equal(t, "window", service.GetConfig().App.Name)
+
+	active, err := service.GetSchedule(ctx, schedule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduleStatusActive, active.Status)
+	require.NotNil(t, active.PriorValue)
+	assert.Equal(t, "before", *active.PriorValue)
+	assert.Equal(t, 2, active.AppliedRevision)
+
+	assert.Equal(t, 1, service.runDueSchedules(context.Background(), now.Add(3*time.Hour)))
+	assert.Equal(t, "before", service.GetConfig().App.Name)
+
+	completed, err := service.GetSchedule(ctx, schedule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduleStatusCompleted, completed.Status)
+	assert.Empty(t, completed.Message)
+
+	history, err := service.ListConfigHistory(ctx, "app.name", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, ChangeActionRevert, history[0].Action)
+	assert.Equal(t, "alice", history[0].Actor)
+	assert.Equal(t, ChangeActionSet, history[1].Action)
+
+	pending, err := service.ListSchedules(ctx, ScheduleStatusPending, ScheduleStatusActive)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	assert.Len(t, mockProvider.schedules, 1)
+}
+
+// TestService_ScheduleConfig_Immediate 测试只指定到期时间时立即生效，到期时删除生效前不存在的值
+func TestService_ScheduleConfig_Immediate(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(30 * time.Minute)
+	schedule, err := service.ScheduleConfig(ctx, "app.name", "debug-window", time.Now(), &expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduleStatusActive, schedule.Status)
+	assert.Nil(t, schedule.PriorValue)
+	assert.Equal(t, "debug-window", service.GetConfig().App.Name)
+
+	assert.Equal(t, 1, service.runDueSchedules(ctx, expiresAt))
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+	_, stored, _ := service.provider.GetConfig(ctx, "app.name")
+	assert.False(t, stored)
+}
+
+// TestService_ScheduleConfig_ChangedAfterApply 测试生效后该键又被修改时不恢复
+func TestService_ScheduleConfig_ChangedAfterApply(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(time.Hour)
+	schedule, err := service.ScheduleConfig(ctx, "app.name", "window", time.Now(), &expiresAt)
+	require.NoError(t, err)
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "manual"))
+
+	assert.Equal(t, 1, service.runDueSchedules(ctx, expiresAt.Add(time.Second)))
+	assert.Equal(t, "manual", service.GetConfig().App.Name)
+
+	completed, err := service.GetSchedule(ctx, schedule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduleStatusCompleted, completed.Status)
+	assert.Contains(t, completed.Message, "prior value not restored")
+}
+
+// TestService_ScheduleConfig_Invalid 测试创建计划时校验键、值与时间
+func TestService_ScheduleConfig_Invalid(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := service.ScheduleConfig(ctx, "database.host", "other", now.Add(time.Hour), nil)
+	assert.Error(t, err)
+
+	_, err = service.ScheduleConfig(ctx, "poc.enabled", "not-a-bool", now.Add(time.Hour), nil)
+	assert.Error(t, err)
+
+	earlier := now.Add(30 * time.Minute)
+	_, err = service.ScheduleConfig(ctx, "app.name", "window", now.Add(time.Hour), &earlier)
+	assert.EqualError(t, err, "expires_at must be after effective_at")
+
+	past := now.Add(-time.Minute)
+	_, err = service.ScheduleConfig(ctx, "app.name", "window", now.Add(-time.Hour), &past)
+	assert.EqualError(t, err, "expires_at must be in the future")
+
+	assert.Empty(t, mockProvider.schedules)
+}
+
+// TestService_CancelSchedule 测试取消未生效的计划，以及取消已生效的计划时立即恢复
+func TestService_CancelSchedule(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := WithActor(context.Background(), "bob")
+	now := time.Now()
+
+	pending, err := service.ScheduleConfig(ctx, "app.name", "later", now.Add(time.Hour), nil)
+	require.NoError(t, err)
+	cancelled, err := service.CancelSchedule(ctx, pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduleStatusCancelled, cancelled.Status)
+	assert.Equal(t, 0, service.runDueSchedules(ctx, now.Add(2*time.Hour)))
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+
+	expiresAt := now.Add(time.Hour)
+	active, err := service.ScheduleConfig(ctx, "app.name", "window", now, &expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, "window", service.GetConfig().App.Name)
+
+	cancelled, err = service.CancelSchedule(ctx, active.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduleStatusCancelled, cancelled.Status)
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+
+	_, err = service.CancelSchedule(ctx, active.ID)
+	assert.EqualError(t, err, "config schedule 2 is already cancelled")
+}
+
+// TestService_ScheduleConfig_Restart 测试计划保存在存储中，重启后由新的服务实例生效与恢复
+func TestService_ScheduleConfig_Restart(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+	storePath := filepath.Join(tmpDir, "config-store.json")
+
+	newService := func() *Service {
+		store, err := OpenProvider(&StoreConfig{Provider: StoreProviderFile, File: storePath}, nil)
+		require.NoError(t, err)
+		service, err := NewBootstrap(tmpDir).CreateServiceWithProvider(context.Background(), store)
+		require.NoError(t, err)
+		return service
+	}
+
+	ctx := context.Background()
+	now := time.Now()
+	expiresAt := now.Add(2 * time.Hour)
+	_, err := newService().ScheduleConfig(ctx, "app.name", "window", now.Add(time.Hour), &expiresAt)
+	require.NoError(t, err)
+
+	restarted := newService()
+	assert.Equal(t, 1, restarted.runDueSchedules(ctx, now.Add(90*time.Minute)))
+	assert.Equal(t, "window", restarted.GetConfig().App.Name)
+
+	restarted = newService()
+	assert.Equal(t, "window", restarted.GetConfig().App.Name)
+	assert.Equal(t, 1, restarted.runDueSchedules(ctx, now.Add(3*time.Hour)))
+	assert.Equal(t, "test-app", restarted.GetConfig().App.Name)
+
+	// 整个时间窗口在停机期间过去的计划不再生效
+	_, err = restarted.ScheduleConfig(ctx, "app.name", "missed", now.Add(time.Hour), &expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, 1, newService().runDueSchedules(ctx, now.Add(3*time.Hour)))
+
+	schedules, err := restarted.ListSchedules(ctx, ScheduleStatusCancelled)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "expired before it could be applied", schedules[0].Message)
+	assert.Equal(t, "test-app", newService().GetConfig().App.Name)
+}
+
+// TestService_ScheduleConfig_Secret 测试计划中的敏感值与生效前的值加密保存，API 响应脱敏
+func TestService_ScheduleConfig_Secret(t *testing.T) {
+	cipher, err := NewSecretCipher(newMasterKey(t))
+	require.NoError(t, err)
+	service, mockProvider := newSecretTestService(t, cipher)
+	ctx := context.Background()
+
+	const original = "original-api-key"
+	const window = "window-api-key"
+	require.NoError(t, service.UpdateConfig(ctx, "poc.api_key", original))
+
+	expiresAt := time.Now().Add(time.Hour)
+	schedule, err := service.ScheduleConfig(ctx, "poc.api_key", window, time.Now(), &expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, window, service.GetConfig().POC.APIKey)
+
+	stored := mockProvider.schedules[0]
+	assert.True(t, strings.HasPrefix(stored.Value, encryptedPrefix))
+	require.NotNil(t, stored.PriorValue)
+	assert.NotContains(t, *stored.PriorValue, original)
+
+	masked := service.MaskSchedule(*schedule)
+	assert.Equal(t, SecretMask, masked.Value)
+	assert.Equal(t, SecretMask, *masked.PriorValue)
+
+	assert.Equal(t, 1, service.runDueSchedules(ctx, expiresAt))
+	assert.Equal(t, original, service.GetConfig().POC.APIKey)
+}
@@ -0,0 +1,273 @@
+package config
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SchemaDialect is the JSON Schema draft the generated schema conforms to
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Non-standard keywords describing how a key may be changed
+const (
+	schemaKeywordDynamic  = "x-dynamic"  // db:"true", can be changed via the API
+	schemaKeywordSecret   = "x-secret"   // secret:"true", encrypted at rest and masked
+	schemaKeywordApproval = "x-approval" // approval:"required", API changes need a second operator's approval
+)
+
+// durationPattern matches Go duration strings such as "30s" or "1h30m"
+const durationPattern = `^-?([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+
+// Schema builds a JSON Schema for the application config and every
+// registered namespace from the tags parsed by walkStruct
+func (l *Loader) Schema() map[string]interface{} {
+	root := schemaObject()
+	root["$schema"] = SchemaDialect
+	root["title"] = "apprun configuration"
+
+	for _, key := range l.sortedKeys() {
+		meta := l.metadata[key]
+		parts := strings.Split(key, ".")
+
+		// Intermediate objects for nested structs and namespaces
+		parent := root
+		for _, part := range parts[:len(parts)-1] {
+			props := parent["properties"].(map[string]interface{})
+			child, ok := props[part].(map[string]interface{})
+			if !ok {
+				child = schemaObject()
+				props[part] = child
+			}
+			parent = child
+		}
+
+		name := parts[len(parts)-1]
+		parent["properties"].(map[string]interface{})[name] = fieldSchema(meta)
+		if meta.DefaultVal == "" && hasRule(meta.ValidateTag, "required") {
+			required, _ := parent["required"].([]string)
+			parent["required"] = append(required, name)
+		}
+	}
+
+	return root
+}
+
+func schemaObject() map[string]interface{} {
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{},
+	}
+}
+
+// fieldSchema describes a single config key
+func fieldSchema(meta *fieldMeta) map[string]interface{} {
+	schema := typeSchema(meta.Type)
+	schema[schemaKeywordDynamic] = meta.AllowDB
+	if meta.Secret {
+		schema[schemaKeywordSecret] = true
+		schema["writeOnly"] = true
+	}
+	if meta.Approval {
+		schema[schemaKeywordApproval] = true
+	}
+
+	if meta.DefaultVal != "" {
+		if typed, err := decodeValue(meta.Type, meta.DefaultVal); err == nil {
+			schema["default"] = jsonValue(typed)
+		}
+	}
+
+	applyRules(schema, meta.Type, meta.ValidateTag)
+	return schema
+}
+
+// typeSchema maps a Go type to its JSON Schema type
+func typeSchema(t reflect.Type) map[string]interface{} {
+	if t == durationType {
+		return map[string]interface{}{"type": "string", "pattern": durationPattern}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Ptr:
+		return typeSchema(t.Elem())
+	case reflect.Struct:
+		schema := schemaObject()
+		props := schema["properties"].(map[string]interface{})
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "" {
+				name = field.Name
+			}
+			if name != "-" {
+				props[name] = typeSchema(field.Type)
+			}
+		}
+		return schema
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// applyRules translates validate tag rules into schema keywords; rules after
+// "dive" apply to the items of a list
+func applyRules(schema map[string]interface{}, t reflect.Type, tag string) {
+	if tag == "" {
+		return
+	}
+
+	for _, rule := range strings.Split(tag, ",") {
+		if rule == "dive" {
+			items, ok := schema["items"].(map[string]interface{})
+			if !ok || t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+				return
+			}
+			schema, t = items, t.Elem()
+			continue
+		}
+
+		// Alternatives ("a|b") become anyOf
+		if alternatives := strings.Split(rule, "|"); len(alternatives) > 1 {
+			anyOf := make([]interface{}, 0, len(alternatives))
+			for _, alt := range alternatives {
+				sub := map[string]interface{}{}
+				applyRule(sub, t, alt)
+				anyOf = append(anyOf, sub)
+			}
+			schema["anyOf"] = anyOf
+			continue
+		}
+		applyRule(schema, t, rule)
+	}
+}
+
+// applyRule translates a single validator rule; unsupported rules are ignored
+func applyRule(schema map[string]interface{}, t reflect.Type, rule string) {
+	name, param, _ := strings.Cut(rule, "=")
+
+	switch name {
+	case "oneof":
+		values := []interface{}{}
+		for _, field := range strings.Fields(param) {
+			if typed, err := decodeValue(t, field); err == nil {
+				values = append(values, jsonValue(typed))
+			}
+		}
+		schema["enum"] = values
+	case "min", "gte", "max", "lte", "len":
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return
+		}
+		for _, keyword := range boundKeywords(t, name) {
+			schema[keyword] = n
+		}
+	case "gt", "lt":
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil || !isNumeric(t) {
+			return
+		}
+		keyword := "exclusiveMinimum"
+		if name == "lt" {
+			keyword = "exclusiveMaximum"
+		}
+		schema[keyword] = n
+	case "startswith":
+		schema["pattern"] = "^" + regexp.QuoteMeta(param)
+	case "endswith":
+		schema["pattern"] = regexp.QuoteMeta(param) + "$"
+	case "contains":
+		schema["pattern"] = regexp.QuoteMeta(param)
+	case "url", "uri":
+		schema["format"] = "uri"
+	case "email":
+		schema["format"] = "email"
+	case "hostname":
+		schema["format"] = "hostname"
+	case "ip":
+		schema["anyOf"] = []interface{}{
+			map[string]interface{}{"format": "ipv4"},
+			map[string]interface{}{"format": "ipv6"},
+		}
+	case "ipv4", "ipv6":
+		schema["format"] = name
+	case "required":
+		if t.Kind() == reflect.String {
+			schema["minLength"] = 1
+		}
+	}
+}
+
+// boundKeywords returns the length or value keywords a min/max rule maps to
+func boundKeywords(t reflect.Type, rule string) []string {
+	var lower, upper string
+	switch {
+	case t.Kind() == reflect.String:
+		lower, upper = "minLength", "maxLength"
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		lower, upper = "minItems", "maxItems"
+	case t.Kind() == reflect.Map:
+		lower, upper = "minProperties", "maxProperties"
+	case isNumeric(t):
+		lower, upper = "minimum", "maximum"
+	default:
+		return nil
+	}
+
+	switch rule {
+	case "min", "gte":
+		return []string{lower}
+	case "max", "lte":
+		return []string{upper}
+	default:
+		return []string{lower, upper}
+	}
+}
+
+func isNumeric(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return t != durationType
+	default:
+		return false
+	}
+}
+
+// hasRule reports whether the validate tag contains rule at the top level
+func hasRule(tag, rule string) bool {
+	for _, r := range strings.Split(tag, ",") {
+		if r == "dive" {
+			return false
+		}
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigSchema 返回全局配置与全部注册模块的 JSON Schema
+func (s *Service) ConfigSchema() map[string]interface{} {
+	return s.loader.Schema()
+}
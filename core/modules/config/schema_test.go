@@ -0,0 +1,100 @@
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// property 按路径获取 schema 中的属性
+func property(t *testing.T, schema map[string]interface{}, path ...string) map[string]interface{} {
+	t.Helper()
+
+	for _, name := range path {
+		props, ok := schema["properties"].(map[string]interface{})
+		require.True(t, ok, "no properties at %s", name)
+		schema, ok = props[name].(map[string]interface{})
+		require.True(t, ok, "property %s not found", name)
+	}
+	return schema
+}
+
+// TestLoader_Schema 测试由结构体标签生成 JSON Schema
+func TestLoader_Schema(t *testing.T) {
+	service, _ := newRegistryTestService(t)
+
+	// 经 JSON 编解码，与 API 返回一致
+	data, err := json.Marshal(service.ConfigSchema())
+	require.NoError(t, err)
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	assert.Equal(t, SchemaDialect, schema["$schema"])
+	assert.Equal(t, "object", schema["type"])
+
+	// 类型、默认值、动态标记
+	name := property(t, schema, "app", "name")
+	assert.Equal(t, "string", name["type"])
+	assert.Equal(t, "apprun", name["default"])
+	assert.Equal(t, true, name["x-dynamic"])
+	assert.Equal(t, float64(1), name["minLength"])
+	assert.Equal(t, false, property(t, schema, "app", "version")["x-dynamic"])
+
+	// min/max 与整数默认值
+	port := property(t, schema, "database", "port")
+	assert.Equal(t, "integer", port["type"])
+	assert.Equal(t, float64(5432), port["default"])
+	assert.Equal(t, float64(1), port["minimum"])
+	assert.Equal(t, float64(65535), port["maximum"])
+
+	// oneof 转为 enum
+	assert.Equal(t, []interface{}{"postgres", "mysql"}, property(t, schema, "database", "driver")["enum"])
+	assert.Equal(t, "boolean", property(t, schema, "poc", "enabled")["type"])
+	assert.Equal(t, "uri", property(t, schema, "poc", "database")["format"])
+	assert.Equal(t, true, property(t, schema, "poc", "api_key")["x-secret"])
+	assert.Equal(t, true, property(t, schema, "poc", "database")["x-approval"])
+	assert.NotContains(t, property(t, schema, "poc", "api_key"), "x-approval")
+
+	// 无默认值的必填项
+	assert.Contains(t, property(t, schema, "poc")["required"], "api_key")
+	assert.NotContains(t, property(t, schema, "poc")["required"], "enabled")
+
+	// 注册模块：列表类型与 dive 规则
+	assert.Equal(t, []interface{}{"debug", "info", "warn", "error"}, property(t, schema, "logger", "level")["enum"])
+	targets := property(t, schema, "logger", "output", "targets")
+	assert.Equal(t, "array", targets["type"])
+	assert.Equal(t, float64(1), targets["minItems"])
+	assert.Equal(t, []interface{}{"stdout"}, targets["default"])
+	items := targets["items"].(map[string]interface{})
+	assert.Equal(t, "string", items["type"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"enum": []interface{}{"stdout", "stderr"}},
+		map[string]interface{}{"pattern": "^file:"},
+	}, items["anyOf"])
+}
+
+// TestHandler_GetSchema 测试 GET /config/schema
+func TestHandler_GetSchema(t *testing.T) {
+	service, _ := newRegistryTestService(t)
+	handler := NewHandler(service)
+
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+
+	req := httptest.NewRequest(http.MethodGet, "/config/schema", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/schema+json", w.Header().Get("Content-Type"))
+
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &schema))
+	assert.Equal(t, SchemaDialect, schema["$schema"])
+	property(t, schema, "logger", "output", "targets")
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Dynamic config values can be stored per scope. A key resolves to the value
+// of the most specific scope that has one: user, then project, then global.
+//
+//	global                          all projects (rows created before scopes existed)
+//	project:<project>               one project
+//	project:<project>/user:<user>   one user within a project
+
+// GlobalScopeName is the stored name of the global scope
+const GlobalScopeName = "global"
+
+// scopeIDPattern restricts project and user IDs so scope names stay parseable
+var scopeIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._@-]*$`)
+
+// Scope identifies where a dynamic config value applies; the zero value is the global scope
+type Scope struct {
+	Project string
+	User    string // Only valid together with Project
+}
+
+// ParseScope parses a scope name; "" and "global" are the global scope
+func ParseScope(name string) (Scope, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == GlobalScopeName {
+		return Scope{}, nil
+	}
+
+	projectPart, userPart, hasUser := strings.Cut(name, "/")
+	project, ok := strings.CutPrefix(projectPart, "project:")
+	if !ok || !scopeIDPattern.MatchString(project) {
+		return Scope{}, fmt.Errorf("invalid scope %q: expected global, project:<id> or project:<id>/user:<id>", name)
+	}
+
+	scope := Scope{Project: project}
+	if hasUser {
+		user, ok := strings.CutPrefix(userPart, "user:")
+		if !ok || !scopeIDPattern.MatchString(user) {
+			return Scope{}, fmt.Errorf("invalid scope %q: expected global, project:<id> or project:<id>/user:<id>", name)
+		}
+		scope.User = user
+	}
+	return scope, nil
+}
+
+// String returns the stored name of the scope
+func (s Scope) String() string {
+	switch {
+	case s.Project == "":
+		return GlobalScopeName
+	case s.User == "":
+		return "project:" + s.Project
+	default:
+		return "project:" + s.Project + "/user:" + s.User
+	}
+}
+
+// IsGlobal reports whether s is the global scope
+func (s Scope) IsGlobal() bool {
+	return s.Project == ""
+}
+
+// Chain returns the scopes s inherits from, most general first, ending with s
+func (s Scope) Chain() []Scope {
+	chain := []Scope{{}}
+	if s.Project != "" {
+		chain = append(chain, Scope{Project: s.Project})
+	}
+	if s.User != "" {
+		chain = append(chain, s)
+	}
+	return chain
+}
+
+type scopeContextKey struct{}
+
+// WithScope returns a context whose dynamic config reads and writes use scope
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext returns the scope stored in ctx, or the global scope
+func ScopeFromContext(ctx context.Context) Scope {
+	scope, _ := ctx.Value(scopeContextKey{}).(Scope)
+	return scope
+}
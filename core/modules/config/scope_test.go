@@ -0,0 +1,199 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseScope 测试作用域名称解析
+func TestParseScope(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Scope
+		wantErr  bool
+	}{
+		{"", Scope{}, false},
+		{"global", Scope{}, false},
+		{"project:alpha", Scope{Project: "alpha"}, false},
+		{"project:alpha/user:bob@example.com", Scope{Project: "alpha", User: "bob@example.com"}, false},
+		{"user:bob", Scope{}, true},
+		{"project:", Scope{}, true},
+		{"project:alpha/user:", Scope{}, true},
+		{"project:a/b", Scope{}, true},
+		{"project:../etc", Scope{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			scope, err := ParseScope(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, scope)
+
+			// 名称可往返解析
+			again, err := ParseScope(scope.String())
+			require.NoError(t, err)
+			assert.Equal(t, scope, again)
+		})
+	}
+
+	user := Scope{Project: "alpha", User: "bob"}
+	assert.Equal(t, []Scope{{}, {Project: "alpha"}, user}, user.Chain())
+	assert.Equal(t, []Scope{{}}, Scope{}.Chain())
+	assert.Equal(t, GlobalScopeName, ScopeFromContext(context.Background()).String())
+}
+
+// TestService_ScopedOverrides 测试按作用域解析最具体的值
+func TestService_ScopedOverrides(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	base := context.Background()
+	project := WithScope(base, Scope{Project: "alpha"})
+	user := WithScope(base, Scope{Project: "alpha", User: "bob"})
+	other := WithScope(base, Scope{Project: "beta", User: "bob"})
+
+	var events, scoped []ChangeEvent
+	service.Watch("", func(e ChangeEvent) { events = append(events, e) })
+	service.watchAllScopes("", func(e ChangeEvent) { scoped = append(scoped, e) })
+
+	require.NoError(t, service.UpdateConfig(base, "app.name", "global-app"))
+	require.NoError(t, service.UpdateConfig(project, "app.name", "alpha-app"))
+	require.NoError(t, service.UpdateConfig(user, "poc.enabled", "true"))
+
+	// 全局缓存不受作用域写入影响，作用域写入只通知订阅全部作用域的订阅者
+	assert.Equal(t, "global-app", service.GetConfig().App.Name)
+	assert.False(t, service.GetConfig().POC.Enabled)
+	require.Len(t, events, 1)
+	assert.Equal(t, GlobalScopeName, events[0].Scope)
+	require.Len(t, scoped, 3)
+	assert.Equal(t, "project:alpha", scoped[1].Scope)
+	assert.Equal(t, "global-app", scoped[1].OldValue)
+	assert.Equal(t, "alpha-app", scoped[1].NewValue)
+	assert.Equal(t, "project:alpha/user:bob", scoped[2].Scope)
+
+	// 逐级回退：user → project → global
+	value, source, from, err := service.lookupConfigValue(user, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "alpha-app", value)
+	assert.Equal(t, "database", source)
+	assert.Equal(t, Scope{Project: "alpha"}, from)
+
+	value, _, from, err = service.lookupConfigValue(other, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "global-app", value)
+	assert.True(t, from.IsGlobal())
+
+	cfg, err := service.ScopedConfig(base, Scope{Project: "alpha", User: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, "alpha-app", cfg.App.Name)
+	assert.True(t, cfg.POC.Enabled)
+
+	cfg, err = service.ScopedConfig(base, Scope{Project: "alpha"})
+	require.NoError(t, err)
+	assert.Equal(t, "alpha-app", cfg.App.Name)
+	assert.False(t, cfg.POC.Enabled)
+
+	// 每个作用域各自的修订号与历史
+	revision, err := service.GetConfigRevision(project, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, 1, revision)
+	history, err := service.ListConfigHistory(project, "app.name", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "project:alpha", history[0].Scope)
+	assert.Equal(t, "global-app", mockProvider.configs["app.name"])
+
+	// 回滚只能在记录所属的作用域中进行
+	_, err = service.RollbackConfig(base, "app.name", history[0].Revision)
+	assert.ErrorContains(t, err, "belongs to scope")
+
+	// 作用域写入同样经过校验
+	assert.Error(t, service.UpdateConfig(project, "app.name", ""))
+
+	// 删除作用域值后回退到上级作用域
+	require.NoError(t, service.DeleteDynamicConfig(project, "app.name"))
+	value, _, err = service.GetConfigValue(user, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "global-app", value)
+	assert.Equal(t, "global-app", service.GetConfig().App.Name)
+}
+
+// TestService_ReencryptScopedSecrets 测试重新加密覆盖全部作用域
+func TestService_ReencryptScopedSecrets(t *testing.T) {
+	oldKey, newKey := newMasterKey(t), newMasterKey(t)
+	oldCipher, err := NewSecretCipher(oldKey)
+	require.NoError(t, err)
+	service, mockProvider := newSecretTestService(t, oldCipher)
+	ctx := withApprovedChange(context.Background()) // poc.database 需要审批，直接以已批准的变更写入
+
+	require.NoError(t, service.UpdateConfig(WithScope(ctx, Scope{Project: "alpha"}), "poc.database", "postgres://alpha@db:5432/poc"))
+	assert.True(t, strings.HasPrefix(mockProvider.configs["project:alpha|poc.database"], encryptedPrefix))
+
+	rotated, err := NewSecretCipher(newKey, oldKey)
+	require.NoError(t, err)
+	service.provider.(*secretProvider).cipher = rotated
+
+	keys, err := service.ReencryptSecrets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"poc.database@project:alpha"}, keys)
+	assert.True(t, strings.HasPrefix(mockProvider.configs["project:alpha|poc.database"], encryptedPrefix+rotated.KeyID()+":"))
+}
+
+// TestHandler_Scope 测试 API 的 scope 参数
+func TestHandler_Scope(t *testing.T) {
+	service, _ := newTestService(t)
+	handler := NewHandler(service)
+
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodPut, "/config?scope=project:alpha", `{"key":"app.name","value":"alpha-app"}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+
+	var getResp GetConfigResponse
+	decodeData(t, do(http.MethodGet, "/config?key=app.name&scope=project:alpha/user:bob", ""), &getResp)
+	assert.Equal(t, "alpha-app", getResp.Value)
+	assert.Equal(t, "database", getResp.Source)
+	assert.Equal(t, "project:alpha/user:bob", getResp.Scope)
+	assert.Equal(t, "project:alpha", getResp.FromScope)
+	assert.Equal(t, 0, getResp.Revision)
+
+	var globalResp GetConfigResponse
+	decodeData(t, do(http.MethodGet, "/config?key=app.name", ""), &globalResp)
+	assert.Equal(t, "test-app", globalResp.Value)
+	assert.Equal(t, "global", globalResp.Scope)
+	assert.Empty(t, globalResp.FromScope)
+
+	var listResp ListConfigsResponse
+	decodeData(t, do(http.MethodGet, "/config/list?scope=project:alpha", ""), &listResp)
+	assert.Equal(t, "project:alpha", listResp.Scope)
+	assert.Equal(t, map[string]interface{}{"app.name": "alpha-app"}, listResp.Configs)
+	var globalList ListConfigsResponse
+	decodeData(t, do(http.MethodGet, "/config/list", ""), &globalList)
+	assert.Empty(t, globalList.Configs)
+
+	var explainResp ExplainConfigResponse
+	decodeData(t, do(http.MethodGet, "/config/explain?key=app.name&scope=project:alpha/user:bob", ""), &explainResp)
+	assert.Equal(t, "alpha-app", explainResp.Value)
+	assert.True(t, layerBySource(t, &explainResp, "configitems@project:alpha").Effective)
+
+	require.Equal(t, http.StatusOK, do(http.MethodDelete, "/config?key=app.name&scope=project:alpha", "").Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, do(http.MethodGet, "/config?key=app.name&scope=user:bob", "").Code)
+}
@@ -0,0 +1,597 @@
+package config
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"apprun/pkg/env"
+	"apprun/pkg/logger"
+)
+
+// SecretMask replaces secret values in API responses
+const SecretMask = "******"
+
+// Environment variables holding the master key used to encrypt secret values.
+// Keys are base64-encoded 32-byte AES-256 keys; previous keys (comma separated)
+// are only used for decryption while rotating.
+const (
+	MasterKeyEnv         = "CONFIG_MASTER_KEY"
+	PreviousMasterKeyEnv = "CONFIG_MASTER_KEY_PREVIOUS"
+)
+
+// reencryptHistoryBatch is how many history records are read per query while re-encrypting
+const reencryptHistoryBatch = 500
+
+// encryptedPrefix marks a value encrypted by SecretCipher: enc:v1:<key id>:<base64(nonce|ciphertext)>
+const encryptedPrefix = "enc:v1:"
+
+// SecretCipher encrypts secret config values with AES-256-GCM
+type SecretCipher struct {
+	currentID string
+	keys      map[string]cipher.AEAD // key id -> AEAD
+}
+
+// NewSecretCipher creates a cipher from a base64 master key; previous keys
+// remain usable for decrypting values written before a key rotation
+func NewSecretCipher(masterKey string, previousKeys ...string) (*SecretCipher, error) {
+	c := &SecretCipher{keys: make(map[string]cipher.AEAD)}
+
+	id, err := c.addKey(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key: %w", err)
+	}
+	c.currentID = id
+
+	for i, key := range previousKeys {
+		if _, err := c.addKey(key); err != nil {
+			return nil, fmt.Errorf("invalid previous master key #%d: %w", i+1, err)
+		}
+	}
+
+	return c, nil
+}
+
+// NewSecretCipherFromEnv creates a cipher from CONFIG_MASTER_KEY and
+// CONFIG_MASTER_KEY_PREVIOUS; it returns nil when no master key is set
+func NewSecretCipherFromEnv() (*SecretCipher, error) {
+	masterKey := env.Get(MasterKeyEnv, "")
+	if masterKey == "" {
+		return nil, nil
+	}
+
+	var previous []string
+	for _, key := range strings.Split(env.Get(PreviousMasterKeyEnv, ""), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			previous = append(previous, key)
+		}
+	}
+
+	return NewSecretCipher(masterKey, previous...)
+}
+
+func (c *SecretCipher) addKey(encoded string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", fmt.Errorf("must be base64 encoded: %w", err)
+	}
+	if len(raw) != 32 {
+		return "", fmt.Errorf("must be 32 bytes, got %d", len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return "", err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+	id := hex.EncodeToString(sum[:4])
+	c.keys[id] = aead
+	return id, nil
+}
+
+// KeyID returns the identifier of the current master key
+func (c *SecretCipher) KeyID() string {
+	return c.currentID
+}
+
+// Encrypt encrypts plaintext with the current master key
+func (c *SecretCipher) Encrypt(plaintext string) (string, error) {
+	aead := c.keys[c.currentID]
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(c.currentID))
+	return encryptedPrefix + c.currentID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value produced by Encrypt; plaintext values written
+// before encryption was enabled are returned unchanged
+func (c *SecretCipher) Decrypt(value string) (string, error) {
+	id, payload, ok := parseEncrypted(value)
+	if !ok {
+		return value, nil
+	}
+
+	aead, exists := c.keys[id]
+	if !exists {
+		return "", fmt.Errorf("unknown master key id %s", id)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("malformed encrypted value")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value with key %s: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+// needsReencrypt reports whether value is plaintext or encrypted with an old key
+func (c *SecretCipher) needsReencrypt(value string) bool {
+	id, _, ok := parseEncrypted(value)
+	return !ok || id != c.currentID
+}
+
+// parseEncrypted splits an encrypted value into key id and payload
+func parseEncrypted(value string) (id string, payload string, ok bool) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return "", "", false
+	}
+	id, payload, ok = strings.Cut(strings.TrimPrefix(value, encryptedPrefix), ":")
+	return id, payload, ok
+}
+
+// secretProvider wraps a ConfigProvider and transparently encrypts secret
+// keys on write and decrypts them on read, including history records.
+// With a nil cipher values are stored as given, but encrypted values
+// found in storage cannot be read.
+type secretProvider struct {
+	ConfigProvider
+	cipher   *SecretCipher
+	isSecret func(key string) bool
+}
+
+// newSecretProvider wraps inner with secret value encryption
+func newSecretProvider(inner ConfigProvider, c *SecretCipher, isSecret func(key string) bool) *secretProvider {
+	return &secretProvider{ConfigProvider: inner, cipher: c, isSecret: isSecret}
+}
+
+func (p *secretProvider) encrypt(key, value string) (string, error) {
+	if p.cipher == nil || !p.isSecret(key) {
+		return value, nil
+	}
+	encrypted, err := p.cipher.Encrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret config '%s': %w", key, err)
+	}
+	return encrypted, nil
+}
+
+func (p *secretProvider) decrypt(key, value string) (string, error) {
+	if _, _, ok := parseEncrypted(value); !ok {
+		return value, nil
+	}
+	if p.cipher == nil {
+		return "", fmt.Errorf("secret config '%s' is encrypted but %s is not set", key, MasterKeyEnv)
+	}
+	plaintext, err := p.cipher.Decrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret config '%s': %w", key, err)
+	}
+	return plaintext, nil
+}
+
+func (p *secretProvider) GetConfig(ctx context.Context, key string) (string, bool, error) {
+	value, exists, err := p.ConfigProvider.GetConfig(ctx, key)
+	if err != nil || !exists {
+		return value, exists, err
+	}
+	value, err = p.decrypt(key, value)
+	return value, exists, err
+}
+
+func (p *secretProvider) SetConfig(ctx context.Context, key string, value string) error {
+	encrypted, err := p.encrypt(key, value)
+	if err != nil {
+		return err
+	}
+	return p.ConfigProvider.SetConfig(ctx, key, encrypted)
+}
+
+func (p *secretProvider) SetConfigIfRevision(ctx context.Context, key string, value string, expected int) error {
+	encrypted, err := p.encrypt(key, value)
+	if err != nil {
+		return err
+	}
+	return p.ConfigProvider.SetConfigIfRevision(ctx, key, encrypted, expected)
+}
+
+func (p *secretProvider) SetConfigs(ctx context.Context, items map[string]string) error {
+	encrypted := make(map[string]string, len(items))
+	for key, value := range items {
+		v, err := p.encrypt(key, value)
+		if err != nil {
+			return err
+		}
+		encrypted[key] = v
+	}
+	return p.ConfigProvider.SetConfigs(ctx, encrypted)
+}
+
+func (p *secretProvider) ListDynamicConfigs(ctx context.Context) (map[string]string, error) {
+	configs, err := p.ConfigProvider.ListDynamicConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range configs {
+		if configs[key], err = p.decrypt(key, value); err != nil {
+			return nil, err
+		}
+	}
+	return configs, nil
+}
+
+func (p *secretProvider) ListHistory(ctx context.Context, key string, limit int) ([]ChangeRecord, error) {
+	records, err := p.ConfigProvider.ListHistory(ctx, key, limit)
+	if err != nil {
+		return nil, err
+	}
+	p.decryptRecords(records)
+	return records, nil
+}
+
+func (p *secretProvider) GetHistory(ctx context.Context, revision int) (*ChangeRecord, error) {
+	record, err := p.ConfigProvider.GetHistory(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+	if record.OldValue, err = p.decryptPtr(record.Key, record.OldValue); err != nil {
+		return nil, err
+	}
+	if record.NewValue, err = p.decryptPtr(record.Key, record.NewValue); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (p *secretProvider) ListChangesSince(ctx context.Context, prefix string, revision int, limit int) ([]ChangeRecord, error) {
+	records, err := p.ConfigProvider.ListChangesSince(ctx, prefix, revision, limit)
+	if err != nil {
+		return nil, err
+	}
+	p.decryptRecords(records)
+	return records, nil
+}
+
+func (p *secretProvider) CreateSchedule(ctx context.Context, schedule *Schedule) error {
+	stored := *schedule
+	var err error
+	if stored.Value, err = p.encrypt(schedule.Key, schedule.Value); err != nil {
+		return err
+	}
+	if err := p.ConfigProvider.CreateSchedule(ctx, &stored); err != nil {
+		return err
+	}
+	stored.Value = schedule.Value
+	*schedule = stored
+	return nil
+}
+
+func (p *secretProvider) GetSchedule(ctx context.Context, id int) (*Schedule, error) {
+	schedule, err := p.ConfigProvider.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Value, err = p.decrypt(schedule.Key, schedule.Value); err != nil {
+		return nil, err
+	}
+	if schedule.PriorValue, err = p.decryptPtr(schedule.Key, schedule.PriorValue); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// ListSchedules decrypts schedule values; values that cannot be decrypted are
+// replaced by SecretMask (the scheduler re-reads each schedule with GetSchedule)
+func (p *secretProvider) ListSchedules(ctx context.Context, statuses ...string) ([]Schedule, error) {
+	schedules, err := p.ConfigProvider.ListSchedules(ctx, statuses...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range schedules {
+		schedules[i].Value = *p.decryptOrMask(schedules[i].Key, &schedules[i].Value)
+		schedules[i].PriorValue = p.decryptOrMask(schedules[i].Key, schedules[i].PriorValue)
+	}
+	return schedules, nil
+}
+
+func (p *secretProvider) UpdateSchedule(ctx context.Context, schedule *Schedule, expected string) error {
+	stored := *schedule
+	if schedule.PriorValue != nil {
+		prior, err := p.encrypt(schedule.Key, *schedule.PriorValue)
+		if err != nil {
+			return err
+		}
+		stored.PriorValue = &prior
+	}
+	return p.ConfigProvider.UpdateSchedule(ctx, &stored, expected)
+}
+
+func (p *secretProvider) CreateChangeRequest(ctx context.Context, request *ChangeRequest) error {
+	stored := *request
+	var err error
+	if stored.Value, err = p.encrypt(request.Key, request.Value); err != nil {
+		return err
+	}
+	if err := p.ConfigProvider.CreateChangeRequest(ctx, &stored); err != nil {
+		return err
+	}
+	stored.Value = request.Value
+	*request = stored
+	return nil
+}
+
+func (p *secretProvider) GetChangeRequest(ctx context.Context, id int) (*ChangeRequest, error) {
+	request, err := p.ConfigProvider.GetChangeRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Value, err = p.decrypt(request.Key, request.Value); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// ListChangeRequests decrypts requested values; values that cannot be decrypted
+// are replaced by SecretMask (approval re-reads the request with GetChangeRequest)
+func (p *secretProvider) ListChangeRequests(ctx context.Context, statuses ...string) ([]ChangeRequest, error) {
+	requests, err := p.ConfigProvider.ListChangeRequests(ctx, statuses...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range requests {
+		requests[i].Value = *p.decryptOrMask(requests[i].Key, &requests[i].Value)
+	}
+	return requests, nil
+}
+
+// decryptRecords decrypts history values in place; values encrypted with a
+// retired master key cannot be recovered and are replaced by SecretMask
+func (p *secretProvider) decryptRecords(records []ChangeRecord) {
+	for i := range records {
+		records[i].OldValue = p.decryptOrMask(records[i].Key, records[i].OldValue)
+		records[i].NewValue = p.decryptOrMask(records[i].Key, records[i].NewValue)
+	}
+}
+
+func (p *secretProvider) decryptPtr(key string, value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	plaintext, err := p.decrypt(key, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &plaintext, nil
+}
+
+func (p *secretProvider) decryptOrMask(key string, value *string) *string {
+	plaintext, err := p.decryptPtr(key, value)
+	if err != nil {
+		mask := SecretMask
+		return &mask
+	}
+	return plaintext
+}
+
+// reencrypt rewrites every stored secret that is plaintext or encrypted with
+// a previous master key using the current key, one batch per scope, then the
+// history records of secret keys (see reencryptHistory). Keys of non-global
+// scopes are returned as "<key>@<scope>"
+func (p *secretProvider) reencrypt(ctx context.Context) ([]string, error) {
+	if p.cipher == nil {
+		return nil, fmt.Errorf("%s is not set, cannot encrypt secrets", MasterKeyEnv)
+	}
+
+	scopes, err := p.ConfigProvider.ListScopes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config scopes: %w", err)
+	}
+
+	var keys []string
+	for _, name := range scopes {
+		scope, err := ParseScope(name)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := p.reencryptScope(WithScope(ctx, scope))
+		if err != nil {
+			return nil, fmt.Errorf("scope %s: %w", name, err)
+		}
+		for _, key := range updated {
+			if !scope.IsGlobal() {
+				key += "@" + name
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	// 最后处理历史：上面的写入本身记录的旧值（明文或旧密钥密文）也一并改写
+	records, err := p.reencryptHistory(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-encrypt config history: %w", err)
+	}
+	if records > 0 {
+		logger.Info("re-encrypted secret config history",
+			logger.Field{Key: "records", Value: records},
+			logger.Field{Key: "key_id", Value: p.cipher.KeyID()})
+	}
+	return keys, nil
+}
+
+// reencryptHistory 以当前主密钥改写敏感配置项变更记录中的明文或旧密钥密文，返回改写的记录数
+// 已退役密钥加密、无法解密的值保持不变（不是明文，且已无法恢复）
+func (p *secretProvider) reencryptHistory(ctx context.Context) (int, error) {
+	rewritten := 0
+	for revision := 0; ; {
+		records, err := p.ConfigProvider.ListChangesSince(ctx, "", revision, reencryptHistoryBatch)
+		if err != nil {
+			return rewritten, err
+		}
+
+		for _, record := range records {
+			revision = record.Revision
+			if !p.isSecret(record.Key) {
+				continue
+			}
+
+			oldValue, oldChanged := p.reencryptPtr(record.Key, record.OldValue)
+			newValue, newChanged := p.reencryptPtr(record.Key, record.NewValue)
+			if !oldChanged && !newChanged {
+				continue
+			}
+			if err := p.ConfigProvider.UpdateHistoryValues(ctx, record.Revision, oldValue, newValue); err != nil {
+				return rewritten, err
+			}
+			rewritten++
+		}
+
+		if len(records) < reencryptHistoryBatch {
+			return rewritten, nil
+		}
+	}
+}
+
+// reencryptPtr 返回以当前主密钥加密的值，值已是当前密钥密文或无法解密时原样返回（changed 为 false）
+func (p *secretProvider) reencryptPtr(key string, value *string) (result *string, changed bool) {
+	if value == nil || !p.cipher.needsReencrypt(*value) {
+		return value, false
+	}
+	plaintext, err := p.decrypt(key, *value)
+	if err != nil {
+		return value, false
+	}
+	encrypted, err := p.encrypt(key, plaintext)
+	if err != nil {
+		return value, false
+	}
+	return &encrypted, true
+}
+
+// reencryptScope 重新加密 ctx 作用域中的敏感值，返回已处理的键（按字典序）
+func (p *secretProvider) reencryptScope(ctx context.Context) ([]string, error) {
+	stored, err := p.ConfigProvider.ListDynamicConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configs: %w", err)
+	}
+
+	updates := make(map[string]string)
+	for key, value := range stored {
+		if !p.isSecret(key) || !p.cipher.needsReencrypt(value) {
+			continue
+		}
+		plaintext, err := p.decrypt(key, value)
+		if err != nil {
+			return nil, err
+		}
+		if updates[key], err = p.encrypt(key, plaintext); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	if err := p.ConfigProvider.SetConfigs(withChangeAction(ctx, ChangeActionReencrypt), updates); err != nil {
+		return nil, fmt.Errorf("failed to store re-encrypted secrets: %w", err)
+	}
+
+	keys := make([]string, 0, len(updates))
+	for key := range updates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// IsSecret 判断配置项是否为敏感值（secret:"true"）
+func (s *Service) IsSecret(key string) bool {
+	return s.loader.IsSecret(key)
+}
+
+// MaskValue 对敏感配置项的值脱敏，用于 API 响应
+func (s *Service) MaskValue(key, value string) string {
+	if value == "" || !s.IsSecret(key) {
+		return value
+	}
+	return SecretMask
+}
+
+// MaskRecords 返回对敏感配置项脱敏后的变更记录副本
+func (s *Service) MaskRecords(records []ChangeRecord) []ChangeRecord {
+	masked := make([]ChangeRecord, len(records))
+	for i, record := range records {
+		masked[i] = s.MaskRecord(record)
+	}
+	return masked
+}
+
+// MaskRecord 返回对敏感配置项脱敏后的变更记录
+func (s *Service) MaskRecord(record ChangeRecord) ChangeRecord {
+	if !s.IsSecret(record.Key) {
+		return record
+	}
+	record.OldValue = s.maskPtr(record.Key, record.OldValue)
+	record.NewValue = s.maskPtr(record.Key, record.NewValue)
+	return record
+}
+
+// maskEvent 返回对敏感配置项脱敏后的变更事件（事件发布给订阅者，可能被写入日志）
+func (s *Service) maskEvent(event ChangeEvent) ChangeEvent {
+	event.OldValue = s.MaskValue(event.Key, event.OldValue)
+	event.NewValue = s.MaskValue(event.Key, event.NewValue)
+	return event
+}
+
+func (s *Service) maskPtr(key string, value *string) *string {
+	if value == nil {
+		return nil
+	}
+	masked := s.MaskValue(key, *value)
+	return &masked
+}
+
+// ReencryptSecrets 使用当前主密钥重新加密数据库中的全部敏感配置项（明文或旧密钥加密的值）及其变更历史
+// 用于启用加密或轮换主密钥：先将旧密钥放入 CONFIG_MASTER_KEY_PREVIOUS，执行后即可移除
+// 返回被重新加密的键；有效值不变，因此不发布变更通知
+func (s *Service) ReencryptSecrets(ctx context.Context) ([]string, error) {
+	sp, ok := s.provider.(*secretProvider)
+	if !ok {
+		return nil, fmt.Errorf("secret encryption is not enabled for this config service")
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return sp.reencrypt(ctx)
+}
@@ -0,0 +1,309 @@
+package config
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMasterKey 生成随机的 base64 主密钥
+func newMasterKey(t *testing.T) string {
+	t.Helper()
+
+	raw := make([]byte, 32)
+	_, err := rand.Read(raw)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+// newSecretTestService 创建启用敏感值加密的配置服务（底层为 mock provider）
+func newSecretTestService(t *testing.T, cipher *SecretCipher) (*Service, *mockConfigProvider) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644)
+	require.NoError(t, err)
+
+	mockProvider := newMockProvider()
+	loader, err := NewLoader(tmpDir, nil)
+	require.NoError(t, err)
+
+	provider := newSecretProvider(mockProvider, cipher, loader.IsSecret)
+	loader.provider = provider
+
+	service := NewService(loader, provider)
+	_, err = service.LoadConfig(context.Background())
+	require.NoError(t, err)
+
+	return service, mockProvider
+}
+
+// TestSecretCipher_RoundTrip 测试加解密与密钥轮换
+func TestSecretCipher_RoundTrip(t *testing.T) {
+	oldKey, newKey := newMasterKey(t), newMasterKey(t)
+
+	oldCipher, err := NewSecretCipher(oldKey)
+	require.NoError(t, err)
+
+	encrypted, err := oldCipher.Encrypt("s3cret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encrypted, encryptedPrefix+oldCipher.KeyID()+":"))
+	assert.NotContains(t, encrypted, "s3cret")
+
+	plaintext, err := oldCipher.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", plaintext)
+
+	// 明文值（启用加密前写入）原样返回
+	plaintext, err = oldCipher.Decrypt("legacy")
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", plaintext)
+
+	// 新密钥 + 旧密钥：可解密旧值
+	rotated, err := NewSecretCipher(newKey, oldKey)
+	require.NoError(t, err)
+	plaintext, err = rotated.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", plaintext)
+	assert.True(t, rotated.needsReencrypt(encrypted))
+
+	// 仅新密钥：无法解密
+	newOnly, err := NewSecretCipher(newKey)
+	require.NoError(t, err)
+	_, err = newOnly.Decrypt(encrypted)
+	assert.Error(t, err)
+
+	// 非法密钥
+	_, err = NewSecretCipher("not-base64!")
+	assert.Error(t, err)
+	_, err = NewSecretCipher(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(t, err)
+}
+
+// TestSecretCipherFromEnv 测试从环境变量创建加密器
+func TestSecretCipherFromEnv(t *testing.T) {
+	t.Setenv(MasterKeyEnv, "")
+	c, err := NewSecretCipherFromEnv()
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	t.Setenv(MasterKeyEnv, newMasterKey(t))
+	t.Setenv(PreviousMasterKeyEnv, newMasterKey(t)+", "+newMasterKey(t))
+	c, err = NewSecretCipherFromEnv()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Len(t, c.keys, 3)
+}
+
+// TestLoader_SecretTag 测试 secret 标签解析
+func TestLoader_SecretTag(t *testing.T) {
+	loader, err := NewLoader(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	assert.True(t, loader.IsSecret("poc.api_key"))
+	assert.True(t, loader.IsSecret("poc.database"))
+	assert.False(t, loader.IsSecret("poc.enabled"))
+	assert.False(t, loader.IsSecret("unknown.key"))
+}
+
+// TestService_SecretEncryptedAtRest 测试敏感值加密存储、解密加载
+func TestService_SecretEncryptedAtRest(t *testing.T) {
+	cipher, err := NewSecretCipher(newMasterKey(t))
+	require.NoError(t, err)
+	service, mockProvider := newSecretTestService(t, cipher)
+	ctx := withApprovedChange(context.Background()) // poc.database 需要审批，直接以已批准的变更写入
+
+	const dsn = "postgres://admin:hunter2@db:5432/poc"
+	require.NoError(t, service.UpdateConfig(ctx, "poc.database", dsn))
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "plain-app"))
+
+	// 存储层只保存密文，非敏感值保持明文
+	stored := mockProvider.configs["poc.database"]
+	assert.True(t, strings.HasPrefix(stored, encryptedPrefix))
+	assert.NotContains(t, stored, "hunter2")
+	assert.Equal(t, "plain-app", mockProvider.configs["app.name"])
+	require.NotNil(t, mockProvider.history[0].NewValue)
+	assert.NotContains(t, *mockProvider.history[0].NewValue, "hunter2")
+
+	// 加载后为明文
+	assert.Equal(t, dsn, service.GetConfig().POC.Database)
+	value, source, err := service.GetConfigValue(ctx, "poc.database")
+	require.NoError(t, err)
+	assert.Equal(t, dsn, value)
+	assert.Equal(t, "database", source)
+
+	// 历史解密后可回滚
+	require.NoError(t, service.UpdateConfig(ctx, "poc.database", "postgres://other@db:5432/poc"))
+	history, err := service.ListConfigHistory(ctx, "poc.database", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, dsn, *history[0].OldValue)
+
+	_, err = service.RollbackConfig(ctx, "poc.database", history[1].Revision)
+	require.NoError(t, err)
+	assert.Equal(t, dsn, service.GetConfig().POC.Database)
+}
+
+// TestService_ReencryptSecrets 测试启用加密与主密钥轮换
+func TestService_ReencryptSecrets(t *testing.T) {
+	oldKey, newKey := newMasterKey(t), newMasterKey(t)
+	oldCipher, err := NewSecretCipher(oldKey)
+	require.NoError(t, err)
+
+	service, mockProvider := newSecretTestService(t, oldCipher)
+	ctx := withApprovedChange(context.Background()) // poc.database 需要审批，直接以已批准的变更写入
+	const dsn = "postgres://admin:hunter2@db:5432/poc"
+	require.NoError(t, service.UpdateConfig(ctx, "poc.database", dsn))
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "plain-app"))
+
+	// 轮换：新密钥为当前密钥，旧密钥仅用于解密
+	rotated, err := NewSecretCipher(newKey, oldKey)
+	require.NoError(t, err)
+	service.provider.(*secretProvider).cipher = rotated
+
+	keys, err := service.ReencryptSecrets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"poc.database"}, keys)
+	assert.True(t, strings.HasPrefix(mockProvider.configs["poc.database"], encryptedPrefix+rotated.KeyID()+":"))
+	assert.Equal(t, "plain-app", mockProvider.configs["app.name"])
+
+	last := mockProvider.history[len(mockProvider.history)-1]
+	assert.Equal(t, ChangeActionReencrypt, last.Action)
+
+	// 再次执行无需处理
+	keys, err = service.ReencryptSecrets(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// 移除旧密钥后当前值仍可加载
+	newOnly, err := NewSecretCipher(newKey)
+	require.NoError(t, err)
+	service.provider.(*secretProvider).cipher = newOnly
+	_, err = service.LoadConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, dsn, service.GetConfig().POC.Database)
+
+	// 未启用加密的服务无法重新加密
+	plainService, _ := newTestService(t)
+	_, err = plainService.ReencryptSecrets(ctx)
+	assert.Error(t, err)
+}
+
+// TestService_ReencryptSecrets_History 测试重新加密同时改写变更历史：
+// 启用加密前写入的明文与重新加密本身记录的旧值都不再以明文保存在 confighistory 中
+func TestService_ReencryptSecrets_History(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+	repo := newTestRepository(t)
+	loader, err := NewLoader(tmpDir, nil)
+	require.NoError(t, err)
+	provider := newSecretProvider(repo, nil, loader.IsSecret)
+	loader.provider = provider
+	service := NewService(loader, provider)
+	_, err = service.LoadConfig(context.Background())
+	require.NoError(t, err)
+
+	// 未启用加密时写入明文
+	ctx := withApprovedChange(context.Background()) // poc.database 需要审批，直接以已批准的变更写入
+	const first, second = "postgres://admin:hunter1@db:5432/poc", "postgres://admin:hunter2@db:5432/poc"
+	require.NoError(t, service.UpdateConfig(ctx, "poc.database", first))
+	require.NoError(t, service.UpdateConfig(ctx, "poc.database", second))
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "plain-app"))
+
+	cipher, err := NewSecretCipher(newMasterKey(t))
+	require.NoError(t, err)
+	provider.cipher = cipher
+	keys, err := service.ReencryptSecrets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"poc.database"}, keys)
+
+	rows, err := repo.client.ConfigHistory.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	for _, row := range rows {
+		for _, value := range []*string{row.OldValue, row.NewValue} {
+			if value == nil {
+				continue
+			}
+			assert.NotContains(t, *value, "hunter", "revision %d", row.ID)
+			if row.Key == "poc.database" {
+				assert.True(t, strings.HasPrefix(*value, encryptedPrefix+cipher.KeyID()+":"), "revision %d", row.ID)
+			}
+		}
+	}
+
+	// 通过服务读取的历史仍为明文，可用于回滚
+	history, err := service.ListConfigHistory(ctx, "poc.database", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, ChangeActionReencrypt, history[0].Action)
+	assert.Equal(t, second, *history[0].OldValue)
+	assert.Equal(t, first, *history[1].OldValue)
+	assert.Equal(t, first, *history[2].NewValue)
+
+	// 再次执行无需改写
+	records, err := provider.reencryptHistory(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, records)
+}
+
+// TestHandler_SecretMasking 测试 API 响应中敏感值脱敏
+func TestHandler_SecretMasking(t *testing.T) {
+	cipher, err := NewSecretCipher(newMasterKey(t))
+	require.NoError(t, err)
+	service, _ := newSecretTestService(t, cipher)
+	handler := NewHandler(service)
+
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+
+	const apiKey = "hunter2-api-key"
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodPut, "/config", `{"key":"poc.api_key","value":"`+apiKey+`"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "hunter2")
+
+	w = do(http.MethodPut, "/config/batch", `{"items":[{"key":"poc.api_key","value":"`+apiKey+`"},{"key":"app.name","value":"visible"}]}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "hunter2")
+	assert.Contains(t, w.Body.String(), "visible")
+
+	var getResp GetConfigResponse
+	decodeData(t, do(http.MethodGet, "/config?key=poc.api_key", ""), &getResp)
+	assert.Equal(t, SecretMask, getResp.Value)
+
+	var listResp ListConfigsResponse
+	decodeData(t, do(http.MethodGet, "/config/list", ""), &listResp)
+	assert.Equal(t, SecretMask, listResp.Configs["poc.api_key"])
+	assert.Equal(t, "visible", listResp.Configs["app.name"])
+
+	for _, url := range []string{
+		"/config/history?key=poc.api_key",
+		"/config/watch/poll?revision=0",
+	} {
+		w = do(http.MethodGet, url, "")
+		require.Equal(t, http.StatusOK, w.Code, url)
+		assert.NotContains(t, w.Body.String(), "hunter2", url)
+		assert.Contains(t, w.Body.String(), SecretMask, url)
+	}
+
+	w = do(http.MethodPost, "/config/history/rollback", `{"key":"poc.api_key","revision":1}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "hunter2")
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"reflect"
-	"strings"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
 
 	"apprun/internal/config"
 
@@ -17,7 +19,12 @@ type Service struct {
 	loader    *Loader
 	provider  ConfigProvider
 	validator *validator.Validate
-	cfg       *config.Config // 缓存的配置实例
+	snapshot  atomic.Pointer[Snapshot] // 当前配置快照（不可变，整体原子替换，读取无锁）
+	writeMu   sync.Mutex               // 串行化写入、重新加载与快照替换
+	watchers  *watcherRegistry         // 配置变更订阅
+	reload    reloadTracker            // 文件热加载统计
+	sync      syncTracker              // 多实例变更同步状态
+	except    []string                 // 全局配置中不校验的字段（文件存储无需数据库时为 Database）
 }
 
 // NewService 创建配置服务
@@ -26,207 +33,518 @@ func NewService(loader *Loader, provider ConfigProvider) *Service {
 		loader:    loader,
 		provider:  provider,
 		validator: validator.New(),
+		watchers:  newWatcherRegistry(),
 	}
 }
 
+// Watch 订阅配置变更，变更成功提交并重新加载后同步回调 handler
+// pattern 为完整键（如 "logger.level"）或以 "." 结尾的前缀（如 "logger."），空字符串订阅全部
+// 只接收 global 作用域的变更（订阅者读取的是 global 配置）；返回的函数用于取消订阅
+func (s *Service) Watch(pattern string, handler ChangeHandler) (cancel func()) {
+	return s.watchers.add(pattern, false, handler)
+}
+
+// watchAllScopes 与 Watch 相同，但接收全部作用域的变更（用于唤醒 /config/watch 的订阅者）
+func (s *Service) watchAllScopes(pattern string, handler ChangeHandler) (cancel func()) {
+	return s.watchers.add(pattern, true, handler)
+}
+
+// valueChange 变更前后的生效值，在写锁内读取，避免读到之后其他写入的结果
+type valueChange struct {
+	oldValue string
+	newValue string
+}
+
+// notifyChange 向订阅者发布变更事件（change 由 apply* 在持有写锁时记录，敏感值脱敏）
+// 作用域内的变更只发布给 watchAllScopes 的订阅者，值为该作用域的生效值
+func (s *Service) notifyChange(ctx context.Context, key string, change valueChange, defaultAction string) {
+	s.watchers.notify(s.maskEvent(ChangeEvent{
+		Key:      key,
+		Scope:    ScopeFromContext(ctx).String(),
+		OldValue: change.oldValue,
+		NewValue: change.newValue,
+		Action:   changeActionFromContext(ctx, defaultAction),
+		Actor:    ActorFromContext(ctx),
+	}))
+}
+
 // LoadConfig 加载配置（启动时调用）
 func (s *Service) LoadConfig(ctx context.Context) (*config.Config, error) {
-	cfg, err := s.loader.Load(ctx)
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	// 加载前记录已提交的变更（最新修订号之前 syncRescanWindow 个），之后提交的变更由 SyncChanges 补上
+	var committed []ChangeRecord
+	if s.provider != nil {
+		if revision, err := s.provider.LatestRevision(ctx); err == nil {
+			committed, _ = s.provider.ListChangesSince(ctx, "", max(revision-syncRescanWindow, 0), 0)
+		}
+	}
+
+	cfg, modules, err := s.loader.loadAll(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
 	// 验证配置
-	if err := s.validator.Struct(cfg); err != nil {
+	if err := s.validate(cfg, modules); err != nil {
 		return cfg, fmt.Errorf("config validation failed: %w", err)
 	}
 
-	s.cfg = cfg
+	s.store(cfg, modules)
+	s.sync.markApplied(committed)
 	return cfg, nil
 }
 
-// GetConfig 获取当前配置（用于 API）
-func (s *Service) GetConfig() *config.Config {
-	return s.cfg
-}
+// validate 验证全局配置及每个注册模块的配置
+func (s *Service) validate(cfg *config.Config, modules moduleConfigs) error {
+	if err := s.validateGlobal(cfg); err != nil {
+		return err
+	}
 
-// GetConfigValue retrieves config value by key with source information
-func (s *Service) GetConfigValue(ctx context.Context, key string) (string, string, error) {
-	// Try to get from database first (for dynamic configs)
-	value, isDynamic, err := s.provider.GetConfig(ctx, key)
-	if err == nil && isDynamic {
-		return value, "database", nil
+	namespaces := make([]string, 0, len(modules))
+	for namespace := range modules {
+		namespaces = append(namespaces, namespace)
 	}
+	sort.Strings(namespaces)
 
-	// Get from loaded config instance (file, env, or defaults)
-	if s.cfg != nil {
-		if val := s.getValueFromConfig(key); val != "" {
-			return val, "file", nil
+	for _, namespace := range namespaces {
+		if err := s.validator.Struct(modules[namespace]); err != nil {
+			return fmt.Errorf("module '%s': %w", namespace, err)
 		}
 	}
+	return nil
+}
 
-	// Fallback to tag default value
-	meta, exists := s.loader.GetMetadata(key)
-	if exists && meta.DefaultVal != "" {
-		return meta.DefaultVal, "default", nil
+// validateGlobal 验证全局配置，跳过 except 中的字段
+func (s *Service) validateGlobal(cfg *config.Config) error {
+	if len(s.except) > 0 {
+		return s.validator.StructExcept(cfg, s.except...)
 	}
-
-	return "", "", fmt.Errorf("config key has no value: %s", key)
+	return s.validator.Struct(cfg)
 }
 
-// getValueFromConfig extracts value from loaded config using reflection
-func (s *Service) getValueFromConfig(key string) string {
-	if s.cfg == nil {
-		return ""
+// store 以 cfg 与 modules 构建新快照（修订号加 1）并原子替换当前快照（调用方需持有 writeMu）
+func (s *Service) store(cfg *config.Config, modules moduleConfigs) {
+	var revision uint64
+	if current := s.snapshot.Load(); current != nil {
+		revision = current.revision
 	}
+	s.snapshot.Store(newSnapshot(s.loader, revision+1, cfg, modules))
+}
 
-	parts := strings.Split(key, ".")
-	if len(parts) < 2 {
-		return ""
-	}
+// Snapshot 返回当前配置快照，未加载时为 nil
+// 同一快照中的全局配置、模块配置与键值彼此一致；需要读取多个值时应先取快照再读取
+func (s *Service) Snapshot() *Snapshot {
+	return s.snapshot.Load()
+}
 
-	v := reflect.ValueOf(s.cfg).Elem()
+// storeIfGlobal 仅当 ctx 为 global 作用域时替换缓存；作用域配置不缓存，按需由 ScopedConfig 加载
+func (s *Service) storeIfGlobal(ctx context.Context, cfg *config.Config, modules moduleConfigs) {
+	if ScopeFromContext(ctx).IsGlobal() {
+		s.store(cfg, modules)
+	}
+}
 
-	// Navigate through nested structs
-	for i, part := range parts {
-		// Find field by matching yaml tag or field name (case-insensitive)
-		field, found := s.findField(v, part)
-		if !found {
-			return ""
+// ScopedConfig 按作用域链（global → project → user）解析动态配置后返回完整配置
+// global 作用域直接返回缓存的配置
+func (s *Service) ScopedConfig(ctx context.Context, scope Scope) (*config.Config, error) {
+	if scope.IsGlobal() {
+		if snap := s.snapshot.Load(); snap != nil {
+			return snap.cfg, nil
 		}
+		return nil, fmt.Errorf("config not loaded")
+	}
 
-		// If last part, get the value
-		if i == len(parts)-1 {
-			return formatValue(field)
-		}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
 
-		// Continue to nested struct
-		if field.Kind() == reflect.Struct {
-			v = field
-		} else {
-			return ""
-		}
+	cfg, _, err := s.loader.loadAll(WithScope(ctx, scope), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config for scope %s: %w", scope, err)
 	}
+	return cfg, nil
+}
 
-	return ""
+// GetConfig 获取当前配置（用于 API）
+func (s *Service) GetConfig() *config.Config {
+	if snap := s.snapshot.Load(); snap != nil {
+		return snap.cfg
+	}
+	return nil
 }
 
-// findField finds a struct field by name (case-insensitive) or yaml tag
-func (s *Service) findField(v reflect.Value, name string) (reflect.Value, bool) {
-	t := v.Type()
-	nameLower := strings.ToLower(name)
+// GetConfigValue retrieves config value by key with source information
+// Source is "env", "database", "profile:<name>", "file" or "default"; use ExplainConfig for per-layer details.
+// Database values resolve from the most specific scope in ctx (see Scope.Chain).
+func (s *Service) GetConfigValue(ctx context.Context, key string) (string, string, error) {
+	value, source, _, err := s.lookupConfigValue(ctx, key)
+	return value, source, err
+}
+
+// lookupConfigValue is GetConfigValue that also returns the scope a database value was stored in
+func (s *Service) lookupConfigValue(ctx context.Context, key string) (string, string, Scope, error) {
+	meta, exists := s.loader.GetMetadata(key)
 
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		fieldValue := v.Field(i)
+	// Environment variables override every other layer
+	if envValue, ok := os.LookupEnv(envVarName(key)); ok && exists {
+		if text, _, err := normalizeValue(meta.Type, envValue); err == nil {
+			return text, "env", Scope{}, nil
+		}
+		return envValue, "env", Scope{}, nil
+	}
 
-		// Check yaml tag
-		if yamlTag := field.Tag.Get("yaml"); yamlTag != "" {
-			if strings.Split(yamlTag, ",")[0] == nameLower {
-				return fieldValue, true
+	// Try to get from database (only db:true keys are applied), most specific scope first
+	if s.loader.AllowDatabaseStorage(key) {
+		chain := ScopeFromContext(ctx).Chain()
+		for i := len(chain) - 1; i >= 0; i-- {
+			value, isDynamic, err := s.provider.GetConfig(WithScope(ctx, chain[i]), key)
+			if err == nil && isDynamic {
+				return value, "database", chain[i], nil
 			}
 		}
+	}
 
-		// Check field name (case-insensitive)
-		if strings.ToLower(field.Name) == nameLower {
-			return fieldValue, true
+	// Get from the current snapshot: global config or registered modules (file or defaults)
+	if snap := s.snapshot.Load(); snap != nil {
+		if val := snap.Value(key); val != "" {
+			return val, s.fileSource(snap, key), Scope{}, nil
+		}
+	}
+
+	// Fallback to tag default value
+	if exists && meta.DefaultVal != "" {
+		if text, _, err := normalizeValue(meta.Type, meta.DefaultVal); err == nil {
+			return text, "default", Scope{}, nil
 		}
+		return meta.DefaultVal, "default", Scope{}, nil
 	}
 
-	return reflect.Value{}, false
+	return "", "", Scope{}, fmt.Errorf("config key has no value: %s", key)
 }
 
-// formatValue converts reflect.Value to string
-func formatValue(v reflect.Value) string {
-	switch v.Kind() {
-	case reflect.String:
-		return v.String()
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return fmt.Sprintf("%d", v.Int())
-	case reflect.Bool:
-		return fmt.Sprintf("%t", v.Bool())
-	case reflect.Float32, reflect.Float64:
-		return fmt.Sprintf("%f", v.Float())
-	default:
-		return ""
+// fileSource returns "profile:<name>" for values taken from the active profile's files, "file" otherwise
+func (s *Service) fileSource(snap *Snapshot, key string) string {
+	if snap.fromProfile(key) {
+		return "profile:" + s.loader.Profile()
 	}
+	return "file"
 }
 
 // UpdateConfig 更新动态配置项
 func (s *Service) UpdateConfig(ctx context.Context, key string, value string) error {
-	// 验证 key 是否允许数据库存储
-	if !s.loader.AllowDatabaseStorage(key) {
-		return fmt.Errorf("config key '%s' is not allowed to be stored in database (db:false)", key)
+	return s.UpdateConfigIfMatch(ctx, key, value, anyRevision)
+}
+
+// UpdateConfigIfMatch 仅当数据库中的修订号等于 expected 时更新动态配置项（乐观并发控制）
+// expected 为 0 表示期望该键尚未存储在数据库中；冲突时返回 *RevisionConflictError
+// 需要审批的配置项返回 *ApprovalRequiredError（见 checkApproval）
+func (s *Service) UpdateConfigIfMatch(ctx context.Context, key string, value string, expected int) error {
+	if err := s.checkApproval(ctx, key); err != nil {
+		return err
+	}
+
+	value, err := s.normalizeDynamicValue(key, value)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.applyUpdate(ctx, key, value, expected)
+	if err != nil {
+		return err
+	}
+
+	s.notifyChange(ctx, key, change, ChangeActionSet)
+	return nil
+}
+
+// normalizeDynamicValue 校验单个动态配置项：允许数据库存储、值可解析为字段的 Go 类型且符合 validate 标签
+// 返回规范化后的存储形式（如列表统一为 JSON）
+func (s *Service) normalizeDynamicValue(key string, value string) (string, error) {
+	normalized, ferr := s.checkDynamicValue(key, value)
+	if ferr != nil {
+		return "", ferr.err
+	}
+	return normalized, nil
+}
+
+// DisplayValue 将存储形式的值转换为 API 返回的类型化 JSON 值（敏感值脱敏）
+func (s *Service) DisplayValue(key string, value string) interface{} {
+	if s.IsSecret(key) {
+		return s.MaskValue(key, value)
 	}
 
-	// 验证值是否符合规则
 	meta, exists := s.loader.GetMetadata(key)
 	if !exists {
-		return fmt.Errorf("unknown config key: %s", key)
+		return value
 	}
 
-	// 使用 validator 进行值验证（如果有 validate 标签）
-	if meta.ValidateTag != "" {
-		if err := s.validator.Var(value, meta.ValidateTag); err != nil {
-			return fmt.Errorf("validation failed for key '%s': %w", key, err)
+	typed, err := decodeValue(meta.Type, value)
+	if err != nil {
+		return value
+	}
+	return jsonValue(typed)
+}
+
+// UpdateConfigs 批量更新动态配置项
+// 先对合并后的完整配置验证一次，再在同一事务中写入全部键：要么全部生效，要么全部不生效
+// 含需要审批的配置项时不写入任何键，返回 *ApprovalRequiredError
+func (s *Service) UpdateConfigs(ctx context.Context, items map[string]string) error {
+	if len(items) == 0 {
+		return fmt.Errorf("no config items to update")
+	}
+
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]string, len(items))
+	for _, key := range keys {
+		if err := s.checkApproval(ctx, key); err != nil {
+			return err
 		}
+		value, err := s.normalizeDynamicValue(key, items[key])
+		if err != nil {
+			return err
+		}
+		normalized[key] = value
 	}
+	items = normalized
 
-	// 持久化到数据库
-	if err := s.provider.SetConfig(ctx, key, value); err != nil {
-		return fmt.Errorf("failed to update config: %w", err)
+	changes, err := s.applyBatchUpdate(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		s.notifyChange(ctx, key, changes[key], ChangeActionSet)
+	}
+	return nil
+}
+
+// applyBatchUpdate 验证合并结果后在事务中持久化（持有写锁），返回各键变更前后的有效值
+func (s *Service) applyBatchUpdate(ctx context.Context, items map[string]string) (map[string]valueChange, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	changes := make(map[string]valueChange, len(items))
+	for key := range items {
+		oldValue, _, _ := s.GetConfigValue(ctx, key)
+		changes[key] = valueChange{oldValue: oldValue}
 	}
 
-	// 重新加载配置以应用变更
-	newCfg, err := s.loader.Load(ctx)
+	// 在持久化之前验证合并后的完整配置
+	newCfg, modules, err := s.loader.loadAll(ctx, items)
 	if err != nil {
-		return fmt.Errorf("failed to reload config after update: %w", err)
+		return nil, fmt.Errorf("failed to load config with batch changes: %w", err)
+	}
+	if err := s.validate(newCfg, modules); err != nil {
+		return nil, fmt.Errorf("batch config validation failed, nothing applied: %w", err)
 	}
 
-	// 验证新配置
-	if err := s.validator.Struct(newCfg); err != nil {
-		// 回滚：删除刚刚设置的值
-		_ = s.provider.DeleteConfig(ctx, key)
-		return fmt.Errorf("new config validation failed, rolled back: %w", err)
+	if err := s.provider.SetConfigs(ctx, items); err != nil {
+		return nil, fmt.Errorf("failed to update configs: %w", err)
 	}
 
-	s.cfg = newCfg
-	return nil
+	// 重新加载，使缓存与已提交的数据保持一致
+	newCfg, modules, err = s.loader.loadAll(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload config after batch update: %w", err)
+	}
+
+	s.storeIfGlobal(ctx, newCfg, modules)
+	for key, change := range changes {
+		change.newValue, _, _ = s.GetConfigValue(ctx, key)
+		changes[key] = change
+	}
+	return changes, nil
 }
 
-// ListDynamicConfigs 列出所有动态配置项
+// applyUpdate 验证合并结果后持久化并重新加载配置（持有写锁），返回变更前后的有效值
+func (s *Service) applyUpdate(ctx context.Context, key string, value string, expected int) (valueChange, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	// 记录变更前的有效值（用于变更通知）
+	var change valueChange
+	change.oldValue, _, _ = s.GetConfigValue(ctx, key)
+
+	// 在持久化之前验证合并后的完整配置
+	newCfg, modules, err := s.loader.loadAll(ctx, map[string]string{key: value})
+	if err != nil {
+		return change, fmt.Errorf("failed to load config with change: %w", err)
+	}
+	if err := s.validate(newCfg, modules); err != nil {
+		return change, fmt.Errorf("new config validation failed, nothing applied: %w", err)
+	}
+
+	// 持久化到数据库
+	if err := s.setConfig(ctx, key, value, expected); err != nil {
+		return change, fmt.Errorf("failed to update config: %w", err)
+	}
+
+	// 重新加载，使缓存与已提交的数据保持一致
+	newCfg, modules, err = s.loader.loadAll(ctx, nil)
+	if err != nil {
+		return change, fmt.Errorf("failed to reload config after update: %w", err)
+	}
+
+	s.storeIfGlobal(ctx, newCfg, modules)
+	change.newValue, _, _ = s.GetConfigValue(ctx, key)
+	return change, nil
+}
+
+// setConfig 按 expected 选择无条件写入或带修订号条件写入
+func (s *Service) setConfig(ctx context.Context, key string, value string, expected int) error {
+	if expected == anyRevision {
+		return s.provider.SetConfig(ctx, key, value)
+	}
+	return s.provider.SetConfigIfRevision(ctx, key, value, expected)
+}
+
+// ListDynamicConfigs 列出 ctx 作用域中存储的动态配置项（不含从上级作用域继承的值）
 func (s *Service) ListDynamicConfigs(ctx context.Context) (map[string]string, error) {
 	return s.provider.ListDynamicConfigs(ctx)
 }
 
 // DeleteDynamicConfig 删除动态配置项
 func (s *Service) DeleteDynamicConfig(ctx context.Context, key string) error {
+	return s.DeleteDynamicConfigIfMatch(ctx, key, anyRevision)
+}
+
+// DeleteDynamicConfigIfMatch 仅当数据库中的修订号等于 expected 时删除动态配置项
+func (s *Service) DeleteDynamicConfigIfMatch(ctx context.Context, key string, expected int) error {
 	// 验证 key 是否允许数据库存储
 	if !s.loader.AllowDatabaseStorage(key) {
 		return fmt.Errorf("config key '%s' is not a dynamic config (db:false)", key)
 	}
+	if err := s.checkApproval(ctx, key); err != nil {
+		return err
+	}
+
+	change, err := s.applyDelete(ctx, key, expected)
+	if err != nil {
+		return err
+	}
+
+	s.notifyChange(ctx, key, change, ChangeActionDelete)
+	return nil
+}
+
+// applyDelete 删除并重新加载配置（持有写锁），返回变更前后的有效值
+func (s *Service) applyDelete(ctx context.Context, key string, expected int) (valueChange, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	var change valueChange
+	change.oldValue, _, _ = s.GetConfigValue(ctx, key)
 
-	if err := s.provider.DeleteConfig(ctx, key); err != nil {
-		return fmt.Errorf("failed to delete config: %w", err)
+	var err error
+	if expected == anyRevision {
+		err = s.provider.DeleteConfig(ctx, key)
+	} else {
+		err = s.provider.DeleteConfigIfRevision(ctx, key, expected)
+	}
+	if err != nil {
+		return change, fmt.Errorf("failed to delete config: %w", err)
 	}
 
 	// 重新加载配置
-	newCfg, err := s.loader.Load(ctx)
+	newCfg, modules, err := s.loader.loadAll(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to reload config after deletion: %w", err)
+		return change, fmt.Errorf("failed to reload config after deletion: %w", err)
 	}
 
-	s.cfg = newCfg
-	return nil
+	s.storeIfGlobal(ctx, newCfg, modules)
+	change.newValue, _, _ = s.GetConfigValue(ctx, key)
+	return change, nil
+}
+
+// GetConfigRevision 返回动态配置项在数据库中的修订号（未存储时为 0），用作 ETag
+func (s *Service) GetConfigRevision(ctx context.Context, key string) (int, error) {
+	revision, err := s.provider.GetRevision(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get config revision: %w", err)
+	}
+	return revision, nil
+}
+
+// ListConfigHistory 列出配置项的变更历史（按修订号倒序）
+func (s *Service) ListConfigHistory(ctx context.Context, key string, limit int) ([]ChangeRecord, error) {
+	if _, exists := s.loader.GetMetadata(key); !exists {
+		return nil, fmt.Errorf("unknown config key: %s", key)
+	}
+
+	history, err := s.provider.ListHistory(ctx, key, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config history: %w", err)
+	}
+	return history, nil
+}
+
+// RollbackConfig 将配置项恢复到指定修订号之后的状态
+// 恢复操作同样经过 UpdateConfig/DeleteDynamicConfig 的验证流程，并以 rollback 记录到历史
+func (s *Service) RollbackConfig(ctx context.Context, key string, revision int) (*ChangeRecord, error) {
+	if err := s.checkApproval(ctx, key); err != nil {
+		return nil, err
+	}
+
+	record, err := s.provider.GetHistory(ctx, revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config revision: %w", err)
+	}
+
+	if record.Key != key {
+		return nil, fmt.Errorf("revision %d does not belong to config key '%s'", revision, key)
+	}
+	if scope := ScopeFromContext(ctx).String(); record.Scope != "" && record.Scope != scope {
+		return nil, fmt.Errorf("revision %d belongs to scope '%s', not '%s'", revision, record.Scope, scope)
+	}
+
+	ctx = withChangeAction(ctx, ChangeActionRollback)
+
+	// 修订后配置不存在（删除操作），恢复方式为删除当前动态值
+	if record.NewValue == nil {
+		if err := s.DeleteDynamicConfig(ctx, key); err != nil {
+			return nil, err
+		}
+		return record, nil
+	}
+
+	if err := s.UpdateConfig(ctx, key, *record.NewValue); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ChangesSince 列出修订号大于 revision 且键匹配 prefix 的变更，用于外部服务增量同步
+func (s *Service) ChangesSince(ctx context.Context, prefix string, revision int, limit int) ([]ChangeRecord, error) {
+	changes, err := s.provider.ListChangesSince(ctx, prefix, revision, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config changes: %w", err)
+	}
+	return changes, nil
+}
+
+// LatestRevision 返回当前最新的配置修订号
+func (s *Service) LatestRevision(ctx context.Context) (int, error) {
+	revision, err := s.provider.LatestRevision(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest config revision: %w", err)
+	}
+	return revision, nil
 }
 
 // GetConfigAsJSON 获取完整配置的 JSON 表示
 func (s *Service) GetConfigAsJSON() (string, error) {
-	if s.cfg == nil {
+	cfg := s.GetConfig()
+	if cfg == nil {
 		return "", fmt.Errorf("config not loaded")
 	}
 
-	data, err := json.MarshalIndent(s.cfg, "", "  ")
+	data, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal config to JSON: %w", err)
 	}
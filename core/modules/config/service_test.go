@@ -25,11 +25,11 @@ database:
   port: 5432
   user: "testuser"
   password: "testpassword123"
-  dbname: "testdb"
+  db_name: "testdb"
 poc:
   enabled: true
   database: "http://localhost:5432/poc"
-  apikey: "test-api-key-12345"
+  api_key: "test-api-key-12345"
 `
 	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validYAML), 0644)
 	require.NoError(t, err)
@@ -69,7 +69,7 @@ database:
   port: 5432
   user: "testuser"
   password: "short"
-  dbname: "testdb"
+  db_name: "testdb"
 `
 	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(invalidYAML), 0644)
 	require.NoError(t, err)
@@ -100,11 +100,11 @@ database:
   port: 5432
   user: "testuser"
   password: "testpassword123"
-  dbname: "testdb"
+  db_name: "testdb"
 poc:
   enabled: false
   database: "http://localhost:5432/poc"
-  apikey: "test-api-key-12345"
+  api_key: "test-api-key-12345"
 `
 	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(defaultYAML), 0644)
 	require.NoError(t, err)
@@ -145,11 +145,11 @@ database:
   port: 5432
   user: "testuser"
   password: "testpassword123"
-  dbname: "testdb"
+  db_name: "testdb"
 poc:
   enabled: false
   database: "http://localhost:5432/poc"
-  apikey: "test-api-key-12345"
+  api_key: "test-api-key-12345"
 `
 	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(defaultYAML), 0644)
 	require.NoError(t, err)
@@ -185,11 +185,11 @@ database:
   port: 5432
   user: "testuser"
   password: "testpassword123"
-  dbname: "testdb"
+  db_name: "testdb"
 poc:
   enabled: false
   database: "http://localhost:5432/poc"
-  apikey: "test-api-key-12345"
+  api_key: "test-api-key-12345"
 `
 	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(defaultYAML), 0644)
 	require.NoError(t, err)
@@ -241,3 +241,213 @@ func TestService_GetAllowedDynamicKeys(t *testing.T) {
 	assert.NotContains(t, keys, "app.version")
 	assert.NotContains(t, keys, "database.password")
 }
+
+// validDefaultYAML 满足全部校验规则的基础配置（供历史/回滚等测试复用）
+const validDefaultYAML = `
+app:
+  name: "test-app"
+  version: "1.0.0"
+database:
+  driver: "postgres"
+  host: "localhost"
+  port: 5432
+  user: "testuser"
+  password: "testpassword123"
+  db_name: "testdb"
+poc:
+  enabled: false
+  database: "http://localhost:5432/poc"
+  api_key: "test-api-key-12345"
+`
+
+// newTestService 创建基于临时目录和 mock provider 的已加载配置服务
+func newTestService(t *testing.T) (*Service, *mockConfigProvider) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644)
+	require.NoError(t, err)
+
+	mockProvider := newMockProvider()
+	loader, err := NewLoader(tmpDir, mockProvider)
+	require.NoError(t, err)
+
+	service := NewService(loader, mockProvider)
+	_, err = service.LoadConfig(context.Background())
+	require.NoError(t, err)
+
+	return service, mockProvider
+}
+
+// TestService_ConfigHistory 测试配置变更历史记录
+func TestService_ConfigHistory(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := WithActor(context.Background(), "alice")
+
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "first"))
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "second"))
+	require.NoError(t, service.DeleteDynamicConfig(ctx, "app.name"))
+
+	history, err := service.ListConfigHistory(ctx, "app.name", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	// 最新的变更排在最前
+	assert.Equal(t, ChangeActionDelete, history[0].Action)
+	assert.Equal(t, "second", *history[0].OldValue)
+	assert.Nil(t, history[0].NewValue)
+
+	assert.Equal(t, ChangeActionSet, history[1].Action)
+	assert.Equal(t, "first", *history[1].OldValue)
+	assert.Equal(t, "second", *history[1].NewValue)
+
+	assert.Nil(t, history[2].OldValue)
+	assert.Equal(t, "alice", history[2].Actor)
+
+	// limit 生效
+	limited, err := service.ListConfigHistory(ctx, "app.name", 1)
+	require.NoError(t, err)
+	assert.Len(t, limited, 1)
+
+	// 未知键
+	_, err = service.ListConfigHistory(ctx, "unknown.key", 0)
+	assert.Error(t, err)
+}
+
+// TestService_UpdateConfig_ValidationFailureKeepsPrevious 测试验证失败时不写入：旧值保留，也不产生历史记录
+func TestService_UpdateConfig_ValidationFailureKeepsPrevious(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := withApprovedChange(context.Background()) // poc.database 需要审批，直接以已批准的变更写入
+
+	require.NoError(t, service.UpdateConfig(ctx, "poc.database", "http://localhost:5432/other"))
+
+	// 模拟数据库中已存在一条非法值，使得后续更新的整体校验失败
+	mockProvider.configs["app.timezone"] = "Invalid/Zone"
+
+	err := service.UpdateConfig(ctx, "poc.database", "http://localhost:5432/new")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nothing applied")
+
+	// 旧值保留，数据库中从未出现新值
+	assert.Equal(t, "http://localhost:5432/other", mockProvider.configs["poc.database"])
+
+	history, err := service.ListConfigHistory(ctx, "poc.database", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, ChangeActionSet, history[0].Action)
+}
+
+// TestService_RollbackConfig 测试回滚到指定修订号
+func TestService_RollbackConfig(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := WithActor(context.Background(), "bob")
+
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "v1"))
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "v2"))
+
+	history, err := service.ListConfigHistory(ctx, "app.name", 0)
+	require.NoError(t, err)
+	firstRevision := history[len(history)-1].Revision
+
+	record, err := service.RollbackConfig(ctx, "app.name", firstRevision)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", *record.NewValue)
+	assert.Equal(t, "v1", mockProvider.configs["app.name"])
+	assert.Equal(t, "v1", service.GetConfig().App.Name)
+
+	history, err = service.ListConfigHistory(ctx, "app.name", 1)
+	require.NoError(t, err)
+	assert.Equal(t, ChangeActionRollback, history[0].Action)
+	assert.Equal(t, "bob", history[0].Actor)
+
+	// 修订号与键不匹配
+	require.NoError(t, service.UpdateConfig(ctx, "poc.enabled", "true"))
+	pocHistory, err := service.ListConfigHistory(ctx, "poc.enabled", 1)
+	require.NoError(t, err)
+	_, err = service.RollbackConfig(ctx, "app.name", pocHistory[0].Revision)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "does not belong")
+}
+
+// TestService_UpdateConfigs 测试批量更新：合并后整体验证一次，全部成功或全部不生效
+func TestService_UpdateConfigs(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := context.Background()
+
+	// 数据库中存在一个非法值：单独修改任何键都会被整体校验拒绝
+	mockProvider.configs["app.timezone"] = "Invalid/Zone"
+	require.Error(t, service.UpdateConfig(ctx, "app.name", "paired-name"))
+
+	// 同时修正时区并修改名称：一起提交可以通过
+	err := service.UpdateConfigs(ctx, map[string]string{
+		"app.timezone": "UTC",
+		"app.name":     "paired-name",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", service.GetConfig().App.Timezone)
+	assert.Equal(t, "paired-name", service.GetConfig().App.Name)
+	assert.Equal(t, "paired-name", mockProvider.configs["app.name"])
+}
+
+// TestService_UpdateConfigs_AllOrNothing 测试批量更新中任一项失败时不写入任何键
+func TestService_UpdateConfigs_AllOrNothing(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := context.Background()
+
+	var events []ChangeEvent
+	service.Watch("", func(e ChangeEvent) { events = append(events, e) })
+
+	// 单项校验失败（db:false）
+	err := service.UpdateConfigs(ctx, map[string]string{
+		"app.name":    "batch-name",
+		"app.version": "2.0.0",
+	})
+	require.Error(t, err)
+
+	// 合并后整体校验失败
+	err = service.UpdateConfigs(ctx, map[string]string{
+		"app.name":     "batch-name",
+		"app.timezone": "Invalid/Zone",
+	})
+	require.Error(t, err)
+
+	assert.Empty(t, mockProvider.configs)
+	assert.Empty(t, mockProvider.history)
+	assert.Empty(t, events)
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+
+	// 空批次
+	assert.Error(t, service.UpdateConfigs(ctx, nil))
+}
+
+// TestService_UpdateConfigIfMatch 测试基于修订号的乐观并发控制
+func TestService_UpdateConfigIfMatch(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := context.Background()
+
+	// 尚未存储：修订号为 0
+	revision, err := service.GetConfigRevision(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, 0, revision)
+
+	require.NoError(t, service.UpdateConfigIfMatch(ctx, "app.name", "first", 0))
+	revision, err = service.GetConfigRevision(ctx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, 1, revision)
+
+	// 过期修订号：冲突且不写入
+	err = service.UpdateConfigIfMatch(ctx, "app.name", "stale", 0)
+	var conflict *RevisionConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, "app.name", conflict.Key)
+	assert.Equal(t, "first", mockProvider.configs["app.name"])
+	assert.Equal(t, "first", service.GetConfig().App.Name)
+
+	require.NoError(t, service.UpdateConfigIfMatch(ctx, "app.name", "second", 1))
+	assert.Equal(t, "second", service.GetConfig().App.Name)
+
+	// 删除同样检查修订号
+	require.ErrorAs(t, service.DeleteDynamicConfigIfMatch(ctx, "app.name", 1), &conflict)
+	require.NoError(t, service.DeleteDynamicConfigIfMatch(ctx, "app.name", 2))
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+}
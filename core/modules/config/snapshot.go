@@ -0,0 +1,85 @@
+package config
+
+import (
+	"reflect"
+
+	"apprun/internal/config"
+)
+
+// keyPath 配置键在根结构体（全局 Config 或注册模块的配置）中的位置
+type keyPath struct {
+	Module string // 注册模块的命名空间，全局 Config 为空
+	Index  []int  // reflect.Value.FieldByIndex 使用的字段索引
+}
+
+// Snapshot 一次成功加载的配置快照：全局配置、注册模块配置、各键的文本值与来源文件
+// 快照创建后不再修改，可在任意 goroutine 中无锁读取；写入方（持有 writeMu）
+// 构建新快照后整体原子替换，读取方不会看到全局配置与模块配置来自不同的加载
+type Snapshot struct {
+	revision uint64
+	cfg      *config.Config
+	modules  moduleConfigs
+	values   map[string]string     // 配置键 → 文本形式的值，构建时按路径索引求值，读取无需反射
+	sources  map[string]fileSource // 配置键 → 值最终取自的文件
+}
+
+// newSnapshot 按 loader 的路径索引对 cfg 与 modules 求值，构建修订号为 revision 的快照
+// cfg 与 modules 移交给快照，调用方之后不得修改
+func newSnapshot(loader *Loader, revision uint64, cfg *config.Config, modules moduleConfigs) *Snapshot {
+	snap := &Snapshot{
+		revision: revision,
+		cfg:      cfg,
+		modules:  modules,
+		values:   make(map[string]string, len(loader.paths)),
+	}
+	if sources := loader.fileSources.Load(); sources != nil {
+		snap.sources = *sources
+	}
+
+	roots := map[string]reflect.Value{"": reflect.ValueOf(cfg).Elem()}
+	for namespace, module := range modules {
+		roots[namespace] = reflect.ValueOf(module).Elem()
+	}
+
+	for key, path := range loader.paths {
+		root, ok := roots[path.Module]
+		if !ok {
+			continue
+		}
+		if value := formatValue(root.FieldByIndex(path.Index)); value != "" {
+			snap.values[key] = value
+		}
+	}
+	return snap
+}
+
+// Revision 返回快照的修订号：服务每次替换快照加 1，与配置历史的修订号无关
+func (s *Snapshot) Revision() uint64 {
+	return s.revision
+}
+
+// Config 返回快照中的全局配置（只读，调用方不得修改）
+func (s *Snapshot) Config() *config.Config {
+	return s.cfg
+}
+
+// Module 返回快照中注册模块的配置（指向注册类型的指针，只读）
+func (s *Snapshot) Module(namespace string) (interface{}, bool) {
+	cfg, exists := s.modules[namespace]
+	return cfg, exists
+}
+
+// Value 返回配置键在快照中的文本值（已合并文件、数据库与环境变量层），无值时为空
+func (s *Snapshot) Value(key string) string {
+	return s.values[key]
+}
+
+// SourceFile 返回配置键的值最终取自的文件（相对 configDir），不来自文件时为空
+func (s *Snapshot) SourceFile(key string) string {
+	return s.sources[key].File
+}
+
+// fromProfile 检查配置键的值是否取自 profile 文件
+func (s *Snapshot) fromProfile(key string) bool {
+	return s.sources[key].Profile
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"apprun/pkg/logger"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestService_Snapshot 测试写入后替换为新快照（修订号递增），已取得的快照保持不变
+func TestService_Snapshot(t *testing.T) {
+	service, _ := newRegistryTestService(t)
+	ctx := context.Background()
+
+	before := service.Snapshot()
+	require.NotNil(t, before)
+	assert.Equal(t, "test-app", before.Value("app.name"))
+	assert.Equal(t, "5432", before.Value("database.port"))
+	assert.Equal(t, `["stdout"]`, before.Value("logger.output.targets"))
+	assert.Equal(t, "default.yaml", before.SourceFile("app.name"))
+	assert.Empty(t, before.Value("unknown.key"))
+
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "after"))
+	require.NoError(t, service.UpdateConfig(ctx, "logger.level", "debug"))
+
+	after := service.Snapshot()
+	assert.Equal(t, before.Revision()+2, after.Revision())
+	assert.Equal(t, "after", after.Value("app.name"))
+	assert.Equal(t, "after", after.Config().App.Name)
+	module, ok := after.Module("logger")
+	require.True(t, ok)
+	assert.Equal(t, logger.LevelDebug, module.(*logger.Config).Level)
+
+	assert.Equal(t, "test-app", before.Value("app.name"))
+	assert.Equal(t, "test-app", before.Config().App.Name)
+	module, _ = before.Module("logger")
+	assert.Equal(t, logger.LevelInfo, module.(*logger.Config).Level)
+
+	// 作用域写入不替换全局快照
+	require.NoError(t, service.UpdateConfig(WithScope(ctx, Scope{Project: "alpha"}), "app.name", "alpha"))
+	assert.Equal(t, after.Revision(), service.Snapshot().Revision())
+
+	// 每个配置项都有预先计算的路径
+	for key := range service.loader.metadata {
+		_, indexed := service.loader.paths[key]
+		assert.True(t, indexed, key)
+	}
+}
+
+// TestHandler_ConcurrentReadWrite 测试并发 PUT 与 GET（配合 go test -race）
+// 读取方看到的快照修订号单调递增，值总是某次完整写入的结果
+func TestHandler_ConcurrentReadWrite(t *testing.T) {
+	service, _ := newRegistryTestService(t)
+	r := chi.NewRouter()
+	NewHandler(service).RegisterRoutes(r)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	const writes = 20
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		levels := []string{"debug", "info"}
+		for i := 0; i < writes; i++ {
+			w := do(http.MethodPut, "/config", fmt.Sprintf(`{"key":"app.name","value":"v-%d"}`, i))
+			assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			w = do(http.MethodPut, "/config", fmt.Sprintf(`{"key":"logger.level","value":"%s"}`, levels[i%2]))
+			assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		}
+	}()
+
+	for reader := 0; reader < 4; reader++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var lastRevision uint64
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				snap := service.Snapshot()
+				assert.GreaterOrEqual(t, snap.Revision(), lastRevision)
+				lastRevision = snap.Revision()
+				assert.Equal(t, snap.Config().App.Name, snap.Value("app.name"))
+
+				var resp GetConfigResponse
+				decodeData(t, do(http.MethodGet, "/config?key=app.name", ""), &resp)
+				name, _ := resp.Value.(string)
+				assert.True(t, name == "test-app" || strings.HasPrefix(name, "v-"), name)
+
+				assert.Equal(t, http.StatusOK, do(http.MethodGet, "/config/list", "").Code)
+				assert.Equal(t, http.StatusOK, do(http.MethodGet, "/config/explain?key=logger.level", "").Code)
+				assert.Equal(t, http.StatusOK, do(http.MethodGet, "/config/export", "").Code)
+
+				cfg, err := Module[logger.Config](service, "logger")
+				assert.NoError(t, err)
+				assert.Contains(t, []logger.Level{logger.LevelDebug, logger.LevelInfo}, cfg.Level)
+			}
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, fmt.Sprintf("v-%d", writes-1), service.GetConfig().App.Name)
+}
@@ -0,0 +1,221 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// keyDirSuffix marks a key-per-file directory in conf_d, e.g. a Kubernetes
+// ConfigMap or Secret mounted at conf_d/50-db.keys/: every file name is a key
+// path (database.password) and its content is the value
+const keyDirSuffix = ".keys"
+
+// configFileExts are the file formats merged from conf_d
+var configFileExts = []string{".yaml", ".yml", ".json", ".toml", ".env"}
+
+// configEntries returns the config sources in dir (relative to configDir):
+// files with a supported extension and *.keys directories, sorted by name.
+// Hidden entries (including Kubernetes' ..data links) and other subdirectories,
+// such as conf_d/<profile>/, are skipped; a missing dir has no entries
+func configEntries(configDir, dir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(configDir, dir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s directory: %w", filepath.Base(dir), err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		// Follow symlinks (mounted volumes link every entry)
+		info, err := os.Stat(filepath.Join(configDir, dir, name))
+		if err != nil {
+			continue
+		}
+		if info.IsDir() {
+			if strings.HasSuffix(name, keyDirSuffix) {
+				names = append(names, filepath.Join(dir, name))
+			}
+			continue
+		}
+		if isConfigFileName(name) {
+			names = append(names, filepath.Join(dir, name))
+		}
+	}
+	return names, nil
+}
+
+// isConfigFileName reports whether name has a supported config file extension
+func isConfigFileName(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, supported := range configFileExts {
+		if ext == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceFileName is the file recorded as the source of key read from name:
+// the file itself, or the key's file inside a key-per-file directory
+func sourceFileName(name, key string) string {
+	if strings.HasSuffix(name, keyDirSuffix) {
+		return path.Join(filepath.ToSlash(name), key)
+	}
+	return filepath.ToSlash(name)
+}
+
+// readSource reads a config source relative to configDir into a new viper
+// instance, or returns nil when it does not exist
+func (l *Loader) readSource(name string) (*viper.Viper, error) {
+	fpath := filepath.Join(l.configDir, name)
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return nil, nil
+	}
+
+	if info.IsDir() {
+		if !strings.HasSuffix(name, keyDirSuffix) {
+			return nil, nil
+		}
+		return l.readKeyDir(name)
+	}
+
+	if strings.EqualFold(filepath.Ext(name), ".env") {
+		return l.readDotEnv(name)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(fpath)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return v, nil
+}
+
+// readKeyDir reads a key-per-file directory: the file name (lowercased) is the
+// key, the content without the trailing newline is the value, typed by the field
+func (l *Loader) readKeyDir(name string) (*viper.Viper, error) {
+	entries, err := os.ReadDir(filepath.Join(l.configDir, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s directory: %w", name, err)
+	}
+
+	v := viper.New()
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		fpath := filepath.Join(l.configDir, name, entry.Name())
+		if info, err := os.Stat(fpath); err != nil || info.IsDir() {
+			continue
+		}
+
+		key := strings.ToLower(entry.Name())
+		if strings.HasPrefix(key, ".") || strings.HasSuffix(key, ".") || strings.Contains(key, "..") {
+			return nil, fmt.Errorf("invalid key file name %s", sourceFileName(name, entry.Name()))
+		}
+
+		data, err := os.ReadFile(fpath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", sourceFileName(name, entry.Name()), err)
+		}
+
+		value, err := l.typedValue(key, strings.TrimRight(string(data), "\r\n"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in %s: %w", sourceFileName(name, entry.Name()), err)
+		}
+		v.Set(key, value)
+	}
+	return v, nil
+}
+
+// readDotEnv reads KEY=VALUE lines. A name is either a config key (database.host)
+// or the env var name of a known key (DATABASE_HOST); other names are ignored so
+// the same file can be shared with docker compose
+func (l *Loader) readDotEnv(name string) (*viper.Viper, error) {
+	data, err := os.ReadFile(filepath.Join(l.configDir, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	envKeys := make(map[string]string, len(l.metadata))
+	for key := range l.metadata {
+		envKeys[envVarName(key)] = key
+	}
+
+	v := viper.New()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		envName, raw, ok := strings.Cut(line, "=")
+		envName = strings.TrimSpace(envName)
+		if !ok || envName == "" {
+			return nil, fmt.Errorf("%s:%d: expected KEY=VALUE", filepath.ToSlash(name), lineNo)
+		}
+
+		raw = strings.TrimSpace(raw)
+		if len(raw) >= 2 && raw[0] == '\'' && raw[len(raw)-1] == '\'' {
+			raw = raw[1 : len(raw)-1]
+		} else if len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"' {
+			unquoted, err := strconv.Unquote(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid quoted value: %w", filepath.ToSlash(name), lineNo, err)
+			}
+			raw = unquoted
+		}
+
+		key := strings.ToLower(envName)
+		if !strings.Contains(envName, ".") {
+			known, exists := envKeys[envName]
+			if !exists {
+				continue
+			}
+			key = known
+		}
+
+		value, err := l.typedValue(key, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filepath.ToSlash(name), lineNo, err)
+		}
+		v.Set(key, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return v, nil
+}
+
+// typedValue parses a text value by the field type of key; unknown keys stay text
+// Values containing ${...} stay text and are resolved by interpolate
+func (l *Loader) typedValue(key, text string) (interface{}, error) {
+	meta, exists := l.metadata[key]
+	if !exists || strings.Contains(text, "${") {
+		return text, nil
+	}
+
+	value, err := decodeValue(meta.Type, text)
+	if err != nil {
+		return nil, fmt.Errorf("config key '%s': %w", key, err)
+	}
+	return value, nil
+}
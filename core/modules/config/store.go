@@ -0,0 +1,117 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"apprun/pkg/database"
+)
+
+// InfraStoreNamespace is the infrastructure config section selecting the dynamic config store
+const InfraStoreNamespace = "config_store"
+
+// Dynamic config store providers
+const (
+	StoreProviderDatabase = "database" // PostgreSQL via ent (Repository), supports multiple instances
+	StoreProviderFile     = "file"     // Local JSON file (FileProvider), single node, no database required
+)
+
+// StoreConfig 动态配置存储（config_store 段），环境变量 CONFIG_STORE_PROVIDER / CONFIG_STORE_FILE
+type StoreConfig struct {
+	Provider string `yaml:"provider" validate:"oneof=database file" default:"database" db:"false"`
+	File     string `yaml:"file" validate:"required_if=Provider file" default:"./data/config-store.json" db:"false"`
+}
+
+// OpenProvider 按存储配置创建 ConfigProvider
+// database 需要 dbClient；file 不依赖数据库，dbClient 可以为 nil
+func OpenProvider(cfg *StoreConfig, dbClient database.Client) (ConfigProvider, error) {
+	switch cfg.Provider {
+	case StoreProviderDatabase, "":
+		if dbClient == nil {
+			return nil, fmt.Errorf("config store provider %q requires a database connection", StoreProviderDatabase)
+		}
+		return NewRepository(dbClient), nil
+	case StoreProviderFile:
+		return NewFileProvider(cfg.File)
+	default:
+		return nil, fmt.Errorf("unsupported config store provider: %s", cfg.Provider)
+	}
+}
+
+// MigrationResult 存储迁移结果
+type MigrationResult struct {
+	Scopes  int `json:"scopes"`  // Scopes copied from the source
+	Written int `json:"written"` // Values written to the target (new or changed)
+	Deleted int `json:"deleted"` // Values removed from the target because the source has none
+}
+
+// MigrateConfigs 将 from 中全部作用域的动态配置复制到 to，使 to 与 from 一致
+// 复制的是存储值：敏感配置的密文原样复制，两端需使用同一主密钥；
+// 值相同的键不会写入，重复执行是安全的；to 中多出的键会被删除
+// 变更以 migrate 动作记录在 to 的变更历史中，修订号由 to 重新分配
+func MigrateConfigs(ctx context.Context, from, to ConfigProvider) (*MigrationResult, error) {
+	ctx = withChangeAction(ctx, ChangeActionMigrate)
+
+	sourceScopes, err := from.ListScopes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source scopes: %w", err)
+	}
+	targetScopes, err := to.ListScopes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list target scopes: %w", err)
+	}
+
+	names := make(map[string]bool, len(sourceScopes)+len(targetScopes))
+	for _, name := range append(sourceScopes, targetScopes...) {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	result := &MigrationResult{Scopes: len(sourceScopes)}
+	for _, name := range sorted {
+		scope, err := ParseScope(name)
+		if err != nil {
+			return nil, err
+		}
+		scopeCtx := WithScope(ctx, scope)
+
+		source, err := from.ListDynamicConfigs(scopeCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list source configs in scope %s: %w", name, err)
+		}
+		target, err := to.ListDynamicConfigs(scopeCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list target configs in scope %s: %w", name, err)
+		}
+
+		updates := make(map[string]string)
+		for key, value := range source {
+			if current, exists := target[key]; !exists || current != value {
+				updates[key] = value
+			}
+		}
+		if len(updates) > 0 {
+			if err := to.SetConfigs(scopeCtx, updates); err != nil {
+				return nil, fmt.Errorf("failed to write configs in scope %s: %w", name, err)
+			}
+			result.Written += len(updates)
+		}
+
+		for key := range target {
+			if _, exists := source[key]; exists {
+				continue
+			}
+			if err := to.DeleteConfig(scopeCtx, key); err != nil {
+				return nil, fmt.Errorf("failed to delete config '%s' in scope %s: %w", key, name, err)
+			}
+			result.Deleted++
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrateConfigs 测试在存储之间迁移全部作用域，重复执行不产生写入
+func TestMigrateConfigs(t *testing.T) {
+	ctx := WithActor(context.Background(), "ops")
+	scope, err := ParseScope("project:alpha")
+	require.NoError(t, err)
+	projectCtx := WithScope(ctx, scope)
+
+	source := newMockProvider()
+	require.NoError(t, source.SetConfig(ctx, "app.name", "from-db"))
+	require.NoError(t, source.SetConfig(ctx, "poc.api_key", "enc:v1:ciphertext"))
+	require.NoError(t, source.SetConfig(projectCtx, "app.name", "alpha-app"))
+
+	target := newTestFileProvider(t)
+	require.NoError(t, target.SetConfig(ctx, "app.version", "stale"))
+
+	result, err := MigrateConfigs(ctx, source, target)
+	require.NoError(t, err)
+	assert.Equal(t, &MigrationResult{Scopes: 2, Written: 3, Deleted: 1}, result)
+
+	global, err := target.ListDynamicConfigs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.name": "from-db", "poc.api_key": "enc:v1:ciphertext"}, global)
+	project, err := target.ListDynamicConfigs(projectCtx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.name": "alpha-app"}, project)
+
+	history, err := target.ListHistory(ctx, "app.name", 1)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, ChangeActionMigrate, history[0].Action)
+	assert.Equal(t, "ops", history[0].Actor)
+
+	result, err = MigrateConfigs(ctx, source, target)
+	require.NoError(t, err)
+	assert.Equal(t, &MigrationResult{Scopes: 2}, result)
+
+	// 反向迁移
+	back := newMockProvider()
+	_, err = MigrateConfigs(ctx, target, back)
+	require.NoError(t, err)
+	value, _, err := back.GetConfig(projectCtx, "app.name")
+	require.NoError(t, err)
+	assert.Equal(t, "alpha-app", value)
+}
+
+// TestMigrateConfigs_Repository 测试从文件存储迁移到数据库存储（SQLite 内存库），
+// 迁移记录以 migrate 动作写入数据库的变更历史
+func TestMigrateConfigs_Repository(t *testing.T) {
+	ctx := WithActor(context.Background(), "ops")
+	scope, err := ParseScope("project:alpha")
+	require.NoError(t, err)
+	projectCtx := WithScope(ctx, scope)
+
+	source := newTestFileProvider(t)
+	require.NoError(t, source.SetConfig(ctx, "app.name", "from-file"))
+	require.NoError(t, source.SetConfig(projectCtx, "app.name", "alpha-app"))
+
+	target := newTestRepository(t)
+	require.NoError(t, target.SetConfig(ctx, "app.version", "stale"))
+
+	result, err := MigrateConfigs(ctx, source, target)
+	require.NoError(t, err)
+	assert.Equal(t, &MigrationResult{Scopes: 2, Written: 2, Deleted: 1}, result)
+
+	global, err := target.ListDynamicConfigs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.name": "from-file"}, global)
+	project, err := target.ListDynamicConfigs(projectCtx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.name": "alpha-app"}, project)
+
+	for _, key := range []string{"app.name", "app.version"} {
+		history, err := target.ListHistory(ctx, key, 1)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, ChangeActionMigrate, history[0].Action, key)
+	}
+
+	result, err = MigrateConfigs(ctx, source, target)
+	require.NoError(t, err)
+	assert.Equal(t, &MigrationResult{Scopes: 2}, result)
+}
@@ -0,0 +1,339 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"apprun/pkg/database"
+	"apprun/pkg/logger"
+)
+
+// ChangeChannel is the Postgres NOTIFY channel announcing committed dynamic config changes
+const ChangeChannel = "apprun_config_changes"
+
+// DefaultSyncInterval is how often instances reconcile with the database
+// to catch notifications missed while the listener was disconnected
+const DefaultSyncInterval = 30 * time.Second
+
+// syncRescanWindow is how many revisions below the latest applied one are re-scanned on each sync
+// Revisions (history IDs) are assigned when a row is inserted, not when its transaction commits:
+// a slow transaction can commit revision N after N+1 has been applied. Changes within the window
+// are deduplicated by revision, so late commits are picked up instead of being skipped by the cursor
+const syncRescanWindow = 1000
+
+// changeNotification is the NOTIFY payload published for each change record
+type changeNotification struct {
+	Revision int    `json:"revision"`
+	Key      string `json:"key"`
+	Scope    string `json:"scope"`
+}
+
+// SyncStatus reports the synchronization of database changes made by other instances
+type SyncStatus struct {
+	Revision    int        `json:"revision" example:"42"`                                   // Latest revision reflected by this instance
+	Syncs       int64      `json:"syncs" example:"3"`                                       // Reloads after new global changes were found in the database
+	Failures    int64      `json:"failures" example:"0"`                                    // Failed synchronizations (query, load or validation error)
+	LastSyncAt  *time.Time `json:"last_sync_at,omitempty" example:"2025-12-31T10:00:00Z"`   // Time of the last reload
+	LastError   string     `json:"last_error,omitempty" example:"config validation failed"` // Error of the last failed synchronization
+	LastErrorAt *time.Time `json:"last_error_at,omitempty" example:"2025-12-31T09:59:00Z"`  // Time of the last failed synchronization
+	Listening   bool       `json:"listening" example:"true"`                                // Whether change notifications are being received
+}
+
+// syncTracker keeps the synchronized revision and counters for a Service
+type syncTracker struct {
+	mu      sync.Mutex
+	status  SyncStatus
+	applied map[int]struct{} // Revisions within the rescan window already reflected
+}
+
+func (t *syncTracker) revision() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.status.Revision
+}
+
+// rescanFrom returns the revision after which changes are listed: the rescan window below the latest applied one
+func (t *syncTracker) rescanFrom() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return max(t.status.Revision-syncRescanWindow, 0)
+}
+
+// isApplied reports whether the change with revision is already reflected
+// Revisions below the rescan window are assumed committed and applied
+func (t *syncTracker) isApplied(revision int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if revision <= t.status.Revision-syncRescanWindow {
+		return true
+	}
+	_, ok := t.applied[revision]
+	return ok
+}
+
+// pending returns the changes not yet reflected
+func (t *syncTracker) pending(changes []ChangeRecord) []ChangeRecord {
+	var result []ChangeRecord
+	for _, change := range changes {
+		if !t.isApplied(change.Revision) {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// markApplied records changes as reflected, advancing the revision to the highest one
+// and forgetting revisions that fell out of the rescan window
+func (t *syncTracker) markApplied(changes []ChangeRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.applied == nil {
+		t.applied = make(map[int]struct{})
+	}
+	for _, change := range changes {
+		t.applied[change.Revision] = struct{}{}
+		t.status.Revision = max(t.status.Revision, change.Revision)
+	}
+	for revision := range t.applied {
+		if revision <= t.status.Revision-syncRescanWindow {
+			delete(t.applied, revision)
+		}
+	}
+}
+
+func (t *syncTracker) success(changes []ChangeRecord) {
+	t.markApplied(changes)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.status.Syncs++
+	t.status.LastSyncAt = &now
+}
+
+func (t *syncTracker) failure(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.status.Failures++
+	t.status.LastError = err.Error()
+	t.status.LastErrorAt = &now
+}
+
+func (t *syncTracker) setListening(listening bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.status.Listening = listening
+}
+
+func (t *syncTracker) snapshot() SyncStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.status
+}
+
+// SyncStatus 返回多实例变更同步的状态
+func (s *Service) SyncStatus() SyncStatus {
+	return s.sync.snapshot()
+}
+
+// SyncChanges 接收其他实例通过 ChangeChannel 发布的变更通知并重新加载配置
+// 每隔 interval 与数据库对账一次，监听连接重连后也会立即对账，补上断线期间遗漏的通知
+// listener 由调用方通过 database.Client.Listen(ChangeChannel) 创建，在 ctx 结束时关闭；
+// 为 nil 时只做定时对账（如 FileProvider，文件可能被其他进程修改）
+func (s *Service) SyncChanges(ctx context.Context, listener database.Listener, interval time.Duration) error {
+	if s.provider == nil {
+		return fmt.Errorf("config sync requires a config provider")
+	}
+	if interval <= 0 {
+		interval = DefaultSyncInterval
+	}
+
+	s.sync.setListening(listener != nil)
+	go s.runSync(ctx, listener, interval)
+	return nil
+}
+
+// runSync 事件循环：通知、重连与定时对账都归结为一次 syncChanges
+func (s *Service) runSync(ctx context.Context, listener database.Listener, interval time.Duration) {
+	var notifications <-chan database.Notification // nil：不接收通知，只定时对账
+	if listener != nil {
+		notifications = listener.Notifications()
+		defer func() {
+			listener.Close()
+			s.sync.setListening(false)
+		}()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case n, ok := <-notifications:
+			if !ok {
+				return
+			}
+
+			if n.Channel == "" {
+				logger.Info("config change listener reconnected, reconciling")
+			} else {
+				var notification changeNotification
+				if err := json.Unmarshal([]byte(n.Payload), &notification); err == nil &&
+					s.sync.isApplied(notification.Revision) {
+					continue
+				}
+			}
+			s.syncAndLog(ctx)
+
+		case <-ticker.C:
+			s.syncAndLog(ctx)
+		}
+	}
+}
+
+// syncAndLog 执行一次同步并记录结果
+func (s *Service) syncAndLog(ctx context.Context) {
+	keys, err := s.syncChanges(ctx)
+	if err != nil {
+		status := s.SyncStatus()
+		logger.Error("config sync failed",
+			logger.Field{Key: "revision", Value: status.Revision},
+			logger.Field{Key: "failures", Value: status.Failures},
+			logger.Field{Key: "error", Value: err})
+		return
+	}
+
+	if len(keys) > 0 {
+		logger.Info("config synced from store",
+			logger.Field{Key: "revision", Value: s.sync.revision()},
+			logger.Field{Key: "keys", Value: keys})
+	}
+}
+
+// syncChanges 读取尚未反映的变更（重新扫描已同步修订号之前 syncRescanWindow 个修订号，按修订号去重，
+// 修订号较小但提交较晚的事务不会被跳过）；global 作用域有变更时重新加载并验证，通过才替换缓存
+// 重新加载后向订阅者发布生效值发生变化的键（本实例写入的变更已发布过，值不再变化，不会重复发布）
+// 返回生效值发生变化的 global 键；失败时保留旧配置，下次同步重试
+func (s *Service) syncChanges(ctx context.Context) ([]string, error) {
+	events, err := s.applySync(ctx)
+	if err != nil {
+		s.sync.failure(err)
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(events))
+	for _, event := range events {
+		s.watchers.notify(event)
+		if event.Scope == GlobalScopeName {
+			keys = append(keys, event.Key)
+		}
+	}
+	return keys, nil
+}
+
+// applySync 重新加载并替换缓存（持有写锁），返回待发布的变更事件（敏感值已脱敏）
+func (s *Service) applySync(ctx context.Context) ([]ChangeEvent, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	changes, err := s.provider.ListChangesSince(ctx, "", s.sync.rescanFrom(), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config changes: %w", err)
+	}
+	changes = s.sync.pending(changes)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	// 作用域配置按需加载、不缓存，只有 global 变更需要重新加载；
+	// 作用域内的变更直接以变更记录中的值发布给 watchAllScopes 的订阅者
+	global := make(map[string]ChangeRecord)
+	var scoped []ChangeEvent
+	for _, change := range changes {
+		if change.Scope == "" || change.Scope == GlobalScopeName {
+			global[change.Key] = change
+			continue
+		}
+		scoped = append(scoped, s.maskEvent(ChangeEvent{
+			Key:      change.Key,
+			Scope:    change.Scope,
+			OldValue: derefString(change.OldValue),
+			NewValue: derefString(change.NewValue),
+			Action:   change.Action,
+			Actor:    change.Actor,
+		}))
+	}
+	if len(global) == 0 {
+		s.sync.markApplied(changes)
+		return scoped, nil
+	}
+
+	keys := make([]string, 0, len(global))
+	oldValues := make(map[string]string, len(global))
+	for key := range global {
+		keys = append(keys, key)
+		oldValues[key] = s.cachedValue(key)
+	}
+	sort.Strings(keys)
+
+	newCfg, modules, err := s.loader.loadAll(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload config: %w", err)
+	}
+	if err := s.validate(newCfg, modules); err != nil {
+		return nil, fmt.Errorf("config validation failed, keeping previous config: %w", err)
+	}
+
+	s.store(newCfg, modules)
+	s.sync.success(changes)
+
+	events := scoped
+	for _, key := range keys {
+		newValue := s.cachedValue(key)
+		if newValue == oldValues[key] {
+			continue
+		}
+		change := global[key]
+		events = append(events, s.maskEvent(ChangeEvent{
+			Key:      key,
+			Scope:    GlobalScopeName,
+			OldValue: oldValues[key],
+			NewValue: newValue,
+			Action:   change.Action,
+			Actor:    change.Actor,
+		}))
+	}
+	return events, nil
+}
+
+// derefString 返回 p 指向的字符串，nil 时返回空字符串
+func derefString(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+// cachedValue 从当前快照中读取键的生效值（已合并数据库层）
+func (s *Service) cachedValue(key string) string {
+	if snap := s.snapshot.Load(); snap != nil {
+		return snap.Value(key)
+	}
+	return ""
+}
@@ -0,0 +1,276 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"apprun/pkg/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newReplica 创建与 primary 共享配置目录和 provider 的第二个实例
+func newReplica(t *testing.T, primary *Service, provider *mockConfigProvider) *Service {
+	t.Helper()
+
+	loader, err := NewLoader(primary.loader.configDir, provider)
+	require.NoError(t, err)
+
+	replica := NewService(loader, provider)
+	_, err = replica.LoadConfig(context.Background())
+	require.NoError(t, err)
+	return replica
+}
+
+// fakeListener 模拟 database.Listener
+type fakeListener struct {
+	ch     chan database.Notification
+	once   sync.Once
+	closed chan struct{}
+}
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{
+		ch:     make(chan database.Notification, 8),
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *fakeListener) Notifications() <-chan database.Notification {
+	return l.ch
+}
+
+func (l *fakeListener) Close() error {
+	l.once.Do(func() { close(l.closed) })
+	return nil
+}
+
+// TestService_SyncChanges 测试其他实例提交的变更：重新加载并向订阅者发布
+func TestService_SyncChanges(t *testing.T) {
+	primary, provider := newTestService(t)
+	replica := newReplica(t, primary, provider)
+	ctx := WithActor(context.Background(), "alice")
+
+	var events []ChangeEvent
+	replica.Watch("app.", func(event ChangeEvent) { events = append(events, event) })
+
+	require.NoError(t, primary.UpdateConfig(ctx, "app.name", "from-primary"))
+	assert.Equal(t, "test-app", replica.GetConfig().App.Name)
+
+	keys, err := replica.syncChanges(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app.name"}, keys)
+	assert.Equal(t, "from-primary", replica.GetConfig().App.Name)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "test-app", events[0].OldValue)
+	assert.Equal(t, "from-primary", events[0].NewValue)
+	assert.Equal(t, ChangeActionSet, events[0].Action)
+	assert.Equal(t, "alice", events[0].Actor)
+
+	status := replica.SyncStatus()
+	assert.Equal(t, 1, status.Revision)
+	assert.Equal(t, int64(1), status.Syncs)
+
+	// 已同步：不再重新加载
+	keys, err = replica.syncChanges(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Equal(t, int64(1), replica.SyncStatus().Syncs)
+
+	// 本实例写入的变更已发布过，同步时不重复发布
+	var primaryEvents []ChangeEvent
+	primary.Watch("", func(event ChangeEvent) { primaryEvents = append(primaryEvents, event) })
+	keys, err = primary.syncChanges(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Empty(t, primaryEvents)
+	assert.Equal(t, 1, primary.SyncStatus().Revision)
+}
+
+// TestService_SyncChanges_MasksSecrets 测试同步发布的事件中敏感配置项的值已脱敏
+func TestService_SyncChanges_MasksSecrets(t *testing.T) {
+	primary, provider := newTestService(t)
+	replica := newReplica(t, primary, provider)
+	ctx := context.Background()
+
+	var events []ChangeEvent
+	replica.watchAllScopes("poc.", func(event ChangeEvent) { events = append(events, event) })
+
+	require.NoError(t, primary.UpdateConfig(ctx, "poc.api_key", "rotated-api-key-123"))
+	require.NoError(t, primary.UpdateConfig(WithScope(ctx, Scope{Project: "alpha"}), "poc.api_key", "alpha-api-key-123"))
+
+	keys, err := replica.syncChanges(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"poc.api_key"}, keys)
+	assert.Equal(t, "rotated-api-key-123", replica.GetConfig().POC.APIKey)
+
+	require.Len(t, events, 2)
+	for _, event := range events {
+		assert.Equal(t, SecretMask, event.NewValue, event.Scope)
+		assert.NotEqual(t, "test-api-key-12345", event.OldValue, event.Scope)
+	}
+}
+
+// TestService_SyncChanges_ScopedAndInvalid 测试作用域变更不重新加载、无效变更保留旧配置
+func TestService_SyncChanges_ScopedAndInvalid(t *testing.T) {
+	primary, provider := newTestService(t)
+	replica := newReplica(t, primary, provider)
+
+	scoped := WithScope(context.Background(), Scope{Project: "alpha"})
+	require.NoError(t, primary.UpdateConfig(scoped, "app.name", "alpha-app"))
+
+	keys, err := replica.syncChanges(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Equal(t, 1, replica.SyncStatus().Revision)
+	assert.Equal(t, int64(0), replica.SyncStatus().Syncs)
+
+	// 绕过校验直接写入无效值
+	require.NoError(t, provider.SetConfig(context.Background(), "poc.api_key", "short"))
+
+	_, err = replica.syncChanges(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "keeping previous config")
+	assert.Equal(t, "test-api-key-12345", replica.GetConfig().POC.APIKey)
+
+	status := replica.SyncStatus()
+	assert.Equal(t, 1, status.Revision)
+	assert.Equal(t, int64(1), status.Failures)
+	assert.NotEmpty(t, status.LastError)
+}
+
+// TestService_SyncChanges_OutOfOrder 测试修订号较小的事务晚于较大的事务提交：
+// 较晚提交的变更在下次同步时仍被发现，而不是被已同步的修订号跳过
+func TestService_SyncChanges_OutOfOrder(t *testing.T) {
+	primary, provider := newTestService(t)
+	replica := newReplica(t, primary, provider)
+	ctx := context.Background()
+
+	var events []ChangeEvent
+	replica.Watch("", func(event ChangeEvent) { events = append(events, event) })
+
+	// 事务 A 先分配修订号 1，事务 B 分配修订号 2 并先提交
+	require.NoError(t, provider.SetConfig(ctx, "app.name", "slow-commit"))
+	require.NoError(t, provider.SetConfig(ctx, "poc.api_key", "fast-commit-key-123"))
+	provider.mu.Lock()
+	slow := provider.history[0]
+	provider.history = provider.history[1:]
+	delete(provider.configs, "app.name")
+	provider.mu.Unlock()
+
+	keys, err := replica.syncChanges(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"poc.api_key"}, keys)
+	assert.Equal(t, 2, replica.SyncStatus().Revision)
+	assert.False(t, replica.sync.isApplied(1), "notification of revision 1 must not be skipped")
+
+	// 事务 A 提交
+	provider.mu.Lock()
+	provider.history = append([]ChangeRecord{slow}, provider.history...)
+	provider.configs["app.name"] = "slow-commit"
+	provider.mu.Unlock()
+
+	keys, err = replica.syncChanges(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app.name"}, keys)
+	assert.Equal(t, "slow-commit", replica.GetConfig().App.Name)
+	assert.Equal(t, 2, replica.SyncStatus().Revision)
+	assert.True(t, replica.sync.isApplied(1))
+	require.Len(t, events, 2)
+	assert.Equal(t, "app.name", events[1].Key)
+
+	// 两个修订号都已反映，不再重新加载
+	keys, err = replica.syncChanges(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Equal(t, int64(2), replica.SyncStatus().Syncs)
+}
+
+// TestService_SyncChanges_Loop 测试通知、重连与定时对账触发同步
+func TestService_SyncChanges_Loop(t *testing.T) {
+	primary, provider := newTestService(t)
+	replica := newReplica(t, primary, provider)
+	ctx := context.Background()
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	listener := newFakeListener()
+	require.NoError(t, replica.SyncChanges(runCtx, listener, time.Hour))
+	assert.True(t, replica.SyncStatus().Listening)
+
+	// 通知
+	require.NoError(t, primary.UpdateConfig(ctx, "app.name", "notified"))
+	listener.ch <- database.Notification{Channel: ChangeChannel, Payload: `{"revision":1,"key":"app.name","scope":"global"}`}
+	assert.Eventually(t, func() bool {
+		return replica.GetConfig().App.Name == "notified"
+	}, time.Second, 10*time.Millisecond)
+
+	// 断线期间遗漏的通知：重连后对账
+	require.NoError(t, primary.UpdateConfig(ctx, "app.name", "missed"))
+	listener.ch <- database.Notification{}
+	assert.Eventually(t, func() bool {
+		return replica.GetConfig().App.Name == "missed"
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-listener.closed:
+	case <-time.After(time.Second):
+		t.Fatal("listener not closed after context cancellation")
+	}
+	assert.Eventually(t, func() bool {
+		return !replica.SyncStatus().Listening
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestService_SyncChanges_Interval 测试没有通知时的定时对账
+func TestService_SyncChanges_Interval(t *testing.T) {
+	primary, provider := newTestService(t)
+	replica := newReplica(t, primary, provider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, replica.SyncChanges(ctx, newFakeListener(), 20*time.Millisecond))
+
+	for i := 1; i <= 2; i++ {
+		require.NoError(t, primary.UpdateConfig(context.Background(), "app.name", fmt.Sprintf("tick-%d", i)))
+	}
+	assert.Eventually(t, func() bool {
+		return replica.GetConfig().App.Name == "tick-2" && replica.SyncStatus().Revision == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestService_SyncChanges_FileStore 测试 FileProvider 不接收通知，定时对账其他进程写入的变更
+func TestService_SyncChanges_FileStore(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+	storePath := filepath.Join(tmpDir, "config-store.json")
+
+	newService := func() *Service {
+		store, err := NewFileProvider(storePath)
+		require.NoError(t, err)
+		service, err := NewBootstrap(tmpDir).CreateServiceWithProvider(context.Background(), store)
+		require.NoError(t, err)
+		return service
+	}
+	server, offline := newService(), newService()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, server.SyncChanges(ctx, nil, 20*time.Millisecond))
+	assert.False(t, server.SyncStatus().Listening)
+
+	require.NoError(t, offline.UpdateConfig(context.Background(), "app.name", "from-offline"))
+	assert.Eventually(t, func() bool {
+		return server.GetConfig().App.Name == "from-offline"
+	}, time.Second, 10*time.Millisecond)
+}
@@ -2,34 +2,375 @@ package config
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 )
 
 // mockConfigProvider 模拟配置提供者（测试辅助，共享给所有测试文件）
+// global 作用域的值以 key 存储，其他作用域以 "<scope>|<key>" 存储
 type mockConfigProvider struct {
-	configs map[string]string
+	mu        sync.Mutex
+	configs   map[string]string
+	revisions map[string]int
+	history   []ChangeRecord
+	schedules []Schedule
+	changes   []ChangeRequest
 }
 
 func newMockProvider() *mockConfigProvider {
 	return &mockConfigProvider{
-		configs: make(map[string]string),
+		configs:   make(map[string]string),
+		revisions: make(map[string]int),
 	}
 }
 
 func (m *mockConfigProvider) GetConfig(ctx context.Context, key string) (string, bool, error) {
-	val, exists := m.configs[key]
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	val, exists := m.configs[mockSlot(ctx, key)]
 	return val, exists, nil
 }
 
 func (m *mockConfigProvider) SetConfig(ctx context.Context, key string, value string) error {
-	m.configs[key] = value
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.set(ctx, key, value)
+	return nil
+}
+
+func (m *mockConfigProvider) SetConfigIfRevision(ctx context.Context, key string, value string, expected int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.revisions[mockSlot(ctx, key)] != expected {
+		return &RevisionConflictError{Key: key, Expected: expected}
+	}
+	m.set(ctx, key, value)
+	return nil
+}
+
+func (m *mockConfigProvider) SetConfigs(ctx context.Context, items map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		m.set(ctx, key, items[key])
+	}
 	return nil
 }
 
 func (m *mockConfigProvider) ListDynamicConfigs(ctx context.Context) (map[string]string, error) {
-	return m.configs, nil
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make(map[string]string, len(m.configs))
+	for slot, v := range m.configs {
+		if key, ok := mockSlotKey(ctx, slot); ok {
+			result[key] = v
+		}
+	}
+	return result, nil
+}
+
+func (m *mockConfigProvider) ListScopes(ctx context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seen := map[string]bool{}
+	for slot := range m.configs {
+		scope := GlobalScopeName
+		if name, _, ok := strings.Cut(slot, "|"); ok {
+			scope = name
+		}
+		seen[scope] = true
+	}
+
+	scopes := make([]string, 0, len(seen))
+	for scope := range seen {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	return scopes, nil
 }
 
 func (m *mockConfigProvider) DeleteConfig(ctx context.Context, key string) error {
-	delete(m.configs, key)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.delete(ctx, key)
 	return nil
 }
+
+func (m *mockConfigProvider) DeleteConfigIfRevision(ctx context.Context, key string, expected int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.revisions[mockSlot(ctx, key)] != expected {
+		return &RevisionConflictError{Key: key, Expected: expected}
+	}
+	m.delete(ctx, key)
+	return nil
+}
+
+func (m *mockConfigProvider) GetRevision(ctx context.Context, key string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.revisions[mockSlot(ctx, key)], nil
+}
+
+func (m *mockConfigProvider) ListHistory(ctx context.Context, key string, limit int) ([]ChangeRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	scope := ScopeFromContext(ctx).String()
+	var result []ChangeRecord
+	for i := len(m.history) - 1; i >= 0; i-- {
+		if m.history[i].Key != key || m.history[i].Scope != scope {
+			continue
+		}
+		result = append(result, m.history[i])
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (m *mockConfigProvider) GetHistory(ctx context.Context, revision int) (*ChangeRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if revision < 1 || revision > len(m.history) {
+		return nil, fmt.Errorf("config revision not found: %d", revision)
+	}
+	record := m.history[revision-1]
+	return &record, nil
+}
+
+func (m *mockConfigProvider) ListChangesSince(ctx context.Context, prefix string, revision int, limit int) ([]ChangeRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []ChangeRecord
+	for _, record := range m.history {
+		if record.Revision <= revision || !strings.HasPrefix(record.Key, prefix) {
+			continue
+		}
+		result = append(result, record)
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (m *mockConfigProvider) LatestRevision(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.history), nil
+}
+
+func (m *mockConfigProvider) UpdateHistoryValues(ctx context.Context, revision int, oldValue, newValue *string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.history {
+		if m.history[i].Revision == revision {
+			m.history[i].OldValue = oldValue
+			m.history[i].NewValue = newValue
+			return nil
+		}
+	}
+	return fmt.Errorf("config revision not found: %d", revision)
+}
+
+func (m *mockConfigProvider) CreateSchedule(ctx context.Context, schedule *Schedule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	schedule.ID = len(m.schedules) + 1
+	schedule.Scope = ScopeFromContext(ctx).String()
+	schedule.Status = ScheduleStatusPending
+	schedule.Actor = ActorFromContext(ctx)
+	schedule.RequestID = RequestIDFromContext(ctx)
+	schedule.CreatedAt = now
+	schedule.UpdatedAt = now
+	m.schedules = append(m.schedules, *schedule)
+	return nil
+}
+
+func (m *mockConfigProvider) GetSchedule(ctx context.Context, id int) (*Schedule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if id < 1 || id > len(m.schedules) {
+		return nil, fmt.Errorf("config schedule not found: %d", id)
+	}
+	schedule := m.schedules[id-1]
+	return &schedule, nil
+}
+
+func (m *mockConfigProvider) ListSchedules(ctx context.Context, statuses ...string) ([]Schedule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []Schedule
+	for _, schedule := range m.schedules {
+		if len(statuses) == 0 || slices.Contains(statuses, schedule.Status) {
+			result = append(result, schedule)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockConfigProvider) UpdateSchedule(ctx context.Context, schedule *Schedule, expected string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if schedule.ID < 1 || schedule.ID > len(m.schedules) {
+		return fmt.Errorf("config schedule not found: %d", schedule.ID)
+	}
+	current := &m.schedules[schedule.ID-1]
+	if current.Status != expected {
+		return &ScheduleConflictError{ID: schedule.ID, Expected: expected}
+	}
+	current.Status = schedule.Status
+	current.PriorValue = schedule.PriorValue
+	current.AppliedRevision = schedule.AppliedRevision
+	current.Message = schedule.Message
+	current.UpdatedAt = time.Now()
+	return nil
+}
+
+func (m *mockConfigProvider) CreateChangeRequest(ctx context.Context, request *ChangeRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	request.ID = len(m.changes) + 1
+	request.Scope = ScopeFromContext(ctx).String()
+	request.Status = ChangeRequestStatusPending
+	request.RequestedBy = ActorFromContext(ctx)
+	request.RequestID = RequestIDFromContext(ctx)
+	request.CreatedAt = now
+	request.UpdatedAt = now
+	m.changes = append(m.changes, *request)
+	return nil
+}
+
+func (m *mockConfigProvider) GetChangeRequest(ctx context.Context, id int) (*ChangeRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if id < 1 || id > len(m.changes) {
+		return nil, fmt.Errorf("config change request not found: %d", id)
+	}
+	request := m.changes[id-1]
+	return &request, nil
+}
+
+func (m *mockConfigProvider) ListChangeRequests(ctx context.Context, statuses ...string) ([]ChangeRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []ChangeRequest
+	for _, request := range m.changes {
+		if len(statuses) == 0 || slices.Contains(statuses, request.Status) {
+			result = append(result, request)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockConfigProvider) UpdateChangeRequest(ctx context.Context, request *ChangeRequest, expected string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if request.ID < 1 || request.ID > len(m.changes) {
+		return fmt.Errorf("config change request not found: %d", request.ID)
+	}
+	current := &m.changes[request.ID-1]
+	if current.Status != expected {
+		return &ChangeRequestConflictError{ID: request.ID, Expected: expected}
+	}
+	current.Status = request.Status
+	current.ReviewedBy = request.ReviewedBy
+	current.Comment = request.Comment
+	current.Message = request.Message
+	current.UpdatedAt = time.Now()
+	return nil
+}
+
+// set 写入配置并递增修订号（调用方需持有 m.mu）
+func (m *mockConfigProvider) set(ctx context.Context, key string, value string) {
+	slot := mockSlot(ctx, key)
+	var oldValue *string
+	if old, exists := m.configs[slot]; exists {
+		oldValue = &old
+	}
+	m.configs[slot] = value
+	if m.revisions == nil {
+		m.revisions = make(map[string]int)
+	}
+	m.revisions[slot]++
+	m.recordChange(ctx, key, oldValue, &value, changeActionFromContext(ctx, ChangeActionSet))
+}
+
+// delete 删除配置及其修订号（调用方需持有 m.mu）
+func (m *mockConfigProvider) delete(ctx context.Context, key string) {
+	slot := mockSlot(ctx, key)
+	if old, exists := m.configs[slot]; exists {
+		m.recordChange(ctx, key, &old, nil, changeActionFromContext(ctx, ChangeActionDelete))
+	}
+	delete(m.configs, slot)
+	delete(m.revisions, slot)
+}
+
+// mockSlot 返回 key 在 ctx 作用域中的存储位置
+func mockSlot(ctx context.Context, key string) string {
+	scope := ScopeFromContext(ctx)
+	if scope.IsGlobal() {
+		return key
+	}
+	return scope.String() + "|" + key
+}
+
+// mockSlotKey 若存储位置属于 ctx 作用域，返回其中的 key
+func mockSlotKey(ctx context.Context, slot string) (string, bool) {
+	scope := ScopeFromContext(ctx)
+	name, key, scoped := strings.Cut(slot, "|")
+	if !scoped {
+		return slot, scope.IsGlobal()
+	}
+	return key, name == scope.String()
+}
+
+// recordChange 追加一条历史记录（调用方需持有 m.mu）
+func (m *mockConfigProvider) recordChange(ctx context.Context, key string, oldValue, newValue *string, action string) {
+	m.history = append(m.history, ChangeRecord{
+		Revision:  len(m.history) + 1,
+		Key:       key,
+		Scope:     ScopeFromContext(ctx).String(),
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		Action:    action,
+		Actor:     ActorFromContext(ctx),
+		RequestID: RequestIDFromContext(ctx),
+		CreatedAt: time.Now(),
+	})
+}
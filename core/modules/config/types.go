@@ -2,10 +2,13 @@ package config
 
 import (
 	"context"
+	"fmt"
+	"time"
 )
 
 // ConfigProvider 定义配置持久化接口
 // 实现反腐层模式，隔离 Ent 模型
+// 动态配置的读写作用于 ctx 中的作用域（ScopeFromContext，默认 global），不做逐级回退
 type ConfigProvider interface {
 	// GetConfig 根据 key 获取配置项
 	GetConfig(ctx context.Context, key string) (value string, isDynamic bool, err error)
@@ -13,35 +16,356 @@ type ConfigProvider interface {
 	// SetConfig 设置动态配置项（只允许 db:true 的项）
 	SetConfig(ctx context.Context, key string, value string) error
 
+	// SetConfigIfRevision 仅当配置项当前修订号等于 expected 时写入（0 表示期望数据库中尚不存在）
+	// 修订号不匹配时返回 *RevisionConflictError
+	SetConfigIfRevision(ctx context.Context, key string, value string, expected int) error
+
+	// SetConfigs 在同一事务中设置多个动态配置项（全部成功或全部失败）
+	SetConfigs(ctx context.Context, items map[string]string) error
+
 	// ListDynamicConfigs 列出所有动态配置项
 	ListDynamicConfigs(ctx context.Context) (map[string]string, error)
 
+	// ListScopes 列出存储了动态配置的全部作用域名称（按名称排序）
+	ListScopes(ctx context.Context) ([]string, error)
+
 	// DeleteConfig 删除动态配置项
 	DeleteConfig(ctx context.Context, key string) error
+
+	// DeleteConfigIfRevision 仅当配置项当前修订号等于 expected 时删除
+	// 修订号不匹配时返回 *RevisionConflictError
+	DeleteConfigIfRevision(ctx context.Context, key string, expected int) error
+
+	// GetRevision 返回动态配置项的当前修订号（数据库中不存在时为 0）
+	GetRevision(ctx context.Context, key string) (int, error)
+
+	// ListHistory 列出配置项在 ctx 作用域中的变更历史（按修订号倒序，limit <= 0 表示不限制）
+	ListHistory(ctx context.Context, key string, limit int) ([]ChangeRecord, error)
+
+	// GetHistory 根据修订号获取单条变更记录
+	GetHistory(ctx context.Context, revision int) (*ChangeRecord, error)
+
+	// ListChangesSince 列出修订号大于 revision 且键匹配 prefix 的变更（全部作用域，按修订号正序）
+	ListChangesSince(ctx context.Context, prefix string, revision int, limit int) ([]ChangeRecord, error)
+
+	// LatestRevision 返回当前最大的修订号（无变更时为 0）
+	LatestRevision(ctx context.Context) (int, error)
+
+	// UpdateHistoryValues 改写变更记录的旧值与新值（nil 表示无值），只用于重新加密敏感值，不产生新的变更记录
+	UpdateHistoryValues(ctx context.Context, revision int, oldValue, newValue *string) error
+
+	// CreateSchedule 保存计划变更（作用域、操作人与请求 ID 取自 ctx），回填 ID 与时间
+	CreateSchedule(ctx context.Context, schedule *Schedule) error
+
+	// GetSchedule 根据 ID 获取计划变更
+	GetSchedule(ctx context.Context, id int) (*Schedule, error)
+
+	// ListSchedules 列出状态属于 statuses 的计划变更（全部作用域，按 ID 正序；statuses 为空时列出全部）
+	ListSchedules(ctx context.Context, statuses ...string) ([]Schedule, error)
+
+	// UpdateSchedule 仅当计划当前状态等于 expected 时保存其状态、生效前的值、修订号与说明
+	// 状态不匹配时返回 *ScheduleConflictError（已被其他实例处理或已取消）
+	UpdateSchedule(ctx context.Context, schedule *Schedule, expected string) error
+
+	// CreateChangeRequest 保存待审批的变更申请（作用域、申请人与请求 ID 取自 ctx），回填 ID 与时间
+	CreateChangeRequest(ctx context.Context, request *ChangeRequest) error
+
+	// GetChangeRequest 根据 ID 获取变更申请
+	GetChangeRequest(ctx context.Context, id int) (*ChangeRequest, error)
+
+	// ListChangeRequests 列出状态属于 statuses 的变更申请（全部作用域，按 ID 正序；statuses 为空时列出全部）
+	ListChangeRequests(ctx context.Context, statuses ...string) ([]ChangeRequest, error)
+
+	// UpdateChangeRequest 仅当申请当前状态等于 expected 时保存其状态、审批人、审批意见与说明
+	// 状态不匹配时返回 *ChangeRequestConflictError（已被他人审批或已过期）
+	UpdateChangeRequest(ctx context.Context, request *ChangeRequest, expected string) error
+}
+
+// anyRevision 表示写入时不检查修订号（无条件写入）
+const anyRevision = -1
+
+// RevisionConflictError 乐观并发冲突：写入方持有的修订号已过期
+type RevisionConflictError struct {
+	Key      string
+	Expected int
+}
+
+func (e *RevisionConflictError) Error() string {
+	return fmt.Sprintf("config key '%s' was modified concurrently (expected revision %d)", e.Key, e.Expected)
+}
+
+// ScheduleConflictError 计划变更的状态已被其他实例或请求修改
+type ScheduleConflictError struct {
+	ID       int
+	Expected string
+}
+
+func (e *ScheduleConflictError) Error() string {
+	return fmt.Sprintf("config schedule %d is no longer %s", e.ID, e.Expected)
+}
+
+// ChangeRequestConflictError 变更申请的状态已被其他请求修改
+type ChangeRequestConflictError struct {
+	ID       int
+	Expected string
+}
+
+func (e *ChangeRequestConflictError) Error() string {
+	return fmt.Sprintf("config change request %d is no longer %s", e.ID, e.Expected)
+}
+
+// ApprovalRequiredError 配置项需要审批（approval:"required"），不能直接写入
+type ApprovalRequiredError struct {
+	Key string
+}
+
+func (e *ApprovalRequiredError) Error() string {
+	return fmt.Sprintf("config key %s requires approval, submit it with PUT /config and have another operator approve it", e.Key)
+}
+
+// ReviewForbiddenError 操作人不能审批该变更申请（申请人本人或未指定操作人）
+type ReviewForbiddenError struct {
+	ID     int
+	Reason string
+}
+
+func (e *ReviewForbiddenError) Error() string {
+	return fmt.Sprintf("cannot review config change request %d: %s", e.ID, e.Reason)
+}
+
+// Change actions recorded in config history
+const (
+	ChangeActionSet       = "set"
+	ChangeActionDelete    = "delete"
+	ChangeActionRollback  = "rollback"
+	ChangeActionReencrypt = "reencrypt"
+	ChangeActionMigrate   = "migrate"
+	ChangeActionRevert    = "revert"
+)
+
+// Schedule statuses
+const (
+	ScheduleStatusPending   = "pending"   // 等待生效
+	ScheduleStatusActive    = "active"    // 已生效，等待到期恢复
+	ScheduleStatusCompleted = "completed" // 已生效且无需恢复，或已到期处理
+	ScheduleStatusCancelled = "cancelled" // 已取消（生效后取消会立即恢复）
+	ScheduleStatusFailed    = "failed"    // 生效或恢复时验证失败
+)
+
+// ChangeRecord 配置变更历史记录
+// OldValue/NewValue 为 nil 表示变更前/后该动态配置不存在
+type ChangeRecord struct {
+	Revision  int       `json:"revision" example:"42"`                          // Revision number of the change
+	Key       string    `json:"key" example:"poc.enabled"`                      // Configuration key
+	Scope     string    `json:"scope" example:"global"`                         // Scope of the changed value
+	OldValue  *string   `json:"old_value" example:"false"`                      // Value before the change (null if not set)
+	NewValue  *string   `json:"new_value" example:"true"`                       // Value after the change (null if deleted)
+	Action    string    `json:"action" example:"set"`                           // Change action: set, delete, rollback, reencrypt, migrate, revert
+	Actor     string    `json:"actor" example:"admin"`                          // Who made the change
+	RequestID string    `json:"request_id,omitempty" example:"host/abc-000001"` // Request ID that triggered the change
+	CreatedAt time.Time `json:"created_at" example:"2025-12-31T10:00:00+08:00"` // When the change happened
+}
+
+// Change request statuses
+const (
+	ChangeRequestStatusPending  = "pending"  // 等待审批
+	ChangeRequestStatusApproved = "approved" // 已批准并写入
+	ChangeRequestStatusRejected = "rejected" // 已拒绝
+	ChangeRequestStatusExpired  = "expired"  // 过期未审批
+	ChangeRequestStatusFailed   = "failed"   // 已批准但写入时验证失败
+)
+
+// ChangeRequest 对 approval:"required" 配置项的修改申请，须由申请人以外的操作人批准后才写入
+type ChangeRequest struct {
+	ID          int       `json:"id" example:"3"`
+	Key         string    `json:"key" example:"poc.database"`
+	Scope       string    `json:"scope" example:"global"`
+	Value       string    `json:"value" example:"postgres://user:pass@db:5432/poc"`     // Requested value (secrets masked)
+	Status      string    `json:"status" example:"pending"`                             // pending, approved, rejected, expired or failed
+	RequestedBy string    `json:"requested_by" example:"alice"`                         // Operator who requested the change
+	RequestID   string    `json:"request_id,omitempty" example:"host/abc-000001"`       // Request ID that created the change request
+	ReviewedBy  string    `json:"reviewed_by,omitempty" example:"bob"`                  // Operator who approved or rejected it
+	Comment     string    `json:"comment,omitempty" example:"checked with the DBA"`     // Reviewer's comment
+	Message     string    `json:"message,omitempty" example:"config validation failed"` // Why an approved change could not be applied
+	ExpiresAt   time.Time `json:"expires_at" example:"2026-01-01T10:00:00Z"`            // Pending requests can no longer be reviewed after this time
+	CreatedAt   time.Time `json:"created_at" example:"2025-12-31T10:00:00Z"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2025-12-31T10:05:00Z"`
+}
+
+// Schedule 计划变更：在 EffectiveAt 写入 Value，ExpiresAt 不为空时到期恢复生效前的值
+// PriorValue 为 nil 表示生效前该作用域中未存储该键（恢复方式为删除）
+type Schedule struct {
+	ID              int        `json:"id" example:"7"`
+	Key             string     `json:"key" example:"logger.level"`
+	Scope           string     `json:"scope" example:"global"`
+	Value           string     `json:"value" example:"debug"`                                // Value written at effective_at
+	PriorValue      *string    `json:"prior_value,omitempty" example:"info"`                 // Stored value replaced at effective_at (null if not stored), restored at expiry
+	AppliedRevision int        `json:"applied_revision,omitempty" example:"5"`               // Revision written at effective_at; a later change skips the revert
+	EffectiveAt     time.Time  `json:"effective_at" example:"2025-12-31T22:00:00Z"`          // When the change is applied
+	ExpiresAt       *time.Time `json:"expires_at,omitempty" example:"2025-12-31T22:30:00Z"`  // When the prior value is restored (null: kept)
+	Status          string     `json:"status" example:"pending"`                             // pending, active, completed, cancelled or failed
+	Message         string     `json:"message,omitempty" example:"config validation failed"` // Failure reason, or why the prior value was not restored
+	Actor           string     `json:"actor" example:"admin"`                                // Who scheduled the change
+	RequestID       string     `json:"request_id,omitempty" example:"host/abc-000001"`       // Request ID that created the schedule
+	CreatedAt       time.Time  `json:"created_at" example:"2025-12-31T21:00:00Z"`            // When the schedule was created
+	UpdatedAt       time.Time  `json:"updated_at" example:"2025-12-31T22:00:01Z"`            // Last status change
 }
 
 // GetConfigResponse GET /api/config 响应
 type GetConfigResponse struct {
-	Key       string `json:"key" example:"app.name"`     // Configuration key
-	Value     string `json:"value" example:"apprun"`     // Configuration value
-	IsDynamic bool   `json:"is_dynamic" example:"false"` // Whether it's a dynamic configuration
-	Source    string `json:"source" example:"default"`   // Source: "database", "file", "env", "default"
+	Key        string      `json:"key" example:"app.name"`                            // Configuration key
+	Value      interface{} `json:"value" swaggertype:"object"`                        // Configuration value, typed by the field (string, number, bool, list, map; durations as "30s")
+	IsDynamic  bool        `json:"is_dynamic" example:"false"`                        // Whether it's a dynamic configuration
+	Source     string      `json:"source" example:"default"`                          // Source: "database", "file", "profile:<name>", "env", "default"
+	SourceFile string      `json:"source_file,omitempty" example:"conf_d/10-db.yaml"` // File that supplied the value (source "file" or "profile:<name>"), relative to the config dir
+	Scope      string      `json:"scope" example:"global"`                            // Requested scope
+	FromScope  string      `json:"from_scope,omitempty" example:"project:alpha"`      // Scope that supplied the value (source "database")
+	Revision   int         `json:"revision" example:"3"`                              // Database revision in the requested scope (0 if not stored), also returned as ETag
+}
+
+// ConflictDetails 409 冲突响应中的当前状态，客户端可据此重新提交
+type ConflictDetails struct {
+	Key             string      `json:"key" example:"poc.enabled"`
+	CurrentValue    interface{} `json:"current_value" swaggertype:"object"`
+	CurrentRevision int         `json:"current_revision" example:"4"`
 }
 
 // UpdateConfigRequest PUT /api/config 请求体
 type UpdateConfigRequest struct {
-	Key   string `json:"key" validate:"required" example:"poc.enabled"` // Configuration key
-	Value string `json:"value" validate:"required" example:"true"`      // New value
+	Key         string      `json:"key" validate:"required" example:"poc.enabled"`         // Configuration key
+	Value       interface{} `json:"value" validate:"required" swaggertype:"object"`        // New value: JSON value of the field type (e.g. true, 8080, "30s", ["stdout"]); strings are parsed as the field type
+	EffectiveAt *time.Time  `json:"effective_at,omitempty" example:"2025-12-31T22:00:00Z"` // Apply the change at this time instead of now (PUT /config only)
+	ExpiresAt   *time.Time  `json:"expires_at,omitempty" example:"2025-12-31T22:30:00Z"`   // Restore the prior value at this time (PUT /config only)
 }
 
 // UpdateConfigResponse PUT /api/config 响应
 type UpdateConfigResponse struct {
-	Key   string `json:"key" example:"poc.enabled"`
-	Value string `json:"value" example:"true"`
+	Key   string      `json:"key" example:"poc.enabled"`
+	Value interface{} `json:"value" swaggertype:"object"`
 }
 
 // ListConfigsResponse GET /api/configs 响应（列出所有动态配置）
 type ListConfigsResponse struct {
-	Configs map[string]string `json:"configs"`           // Key-value mapping of dynamic configurations
-	Count   int               `json:"count" example:"3"` // Number of configuration items
+	Scope   string                 `json:"scope" example:"global"` // Requested scope
+	Configs map[string]interface{} `json:"configs"`                // Key to typed value mapping of dynamic configurations
+	Count   int                    `json:"count" example:"3"`      // Number of configuration items
+}
+
+// ListHistoryResponse GET /api/config/history 响应
+type ListHistoryResponse struct {
+	Key     string         `json:"key" example:"poc.enabled"` // Configuration key
+	History []ChangeRecord `json:"history"`                   // Changes, newest first
+	Count   int            `json:"count" example:"2"`         // Number of changes returned
+}
+
+// RollbackConfigRequest POST /api/config/history/rollback 请求体
+type RollbackConfigRequest struct {
+	Key      string `json:"key" validate:"required" example:"poc.enabled"` // Configuration key
+	Revision int    `json:"revision" validate:"required" example:"42"`     // Revision to restore
+}
+
+// RollbackConfigResponse POST /api/config/history/rollback 响应
+type RollbackConfigResponse struct {
+	Key      string  `json:"key" example:"poc.enabled"`
+	Revision int     `json:"revision" example:"42"` // Revision that was restored
+	Value    *string `json:"value" example:"true"`  // Restored value (null if the key was removed)
+}
+
+// ListSchedulesResponse GET /api/config/schedules 响应
+type ListSchedulesResponse struct {
+	Schedules []Schedule `json:"schedules"`         // Scheduled changes, oldest first
+	Count     int        `json:"count" example:"1"` // Number of schedules returned
+}
+
+// ListChangeRequestsResponse GET /api/config/changes 响应
+type ListChangeRequestsResponse struct {
+	Changes []ChangeRequest `json:"changes"`           // Change requests, oldest first
+	Count   int             `json:"count" example:"1"` // Number of change requests returned
+}
+
+// ReviewChangeRequest POST /api/config/changes/{id}/approve|reject 请求体（可省略）
+type ReviewChangeRequest struct {
+	Comment string `json:"comment,omitempty" example:"checked with the DBA"` // Reviewer's comment
+}
+
+// WatchPollResponse GET /api/config/watch/poll 响应
+type WatchPollResponse struct {
+	Revision int            `json:"revision" example:"42"` // Revision to pass on the next poll
+	Changes  []ChangeRecord `json:"changes"`               // Changes after the requested revision, oldest first
+	Count    int            `json:"count" example:"1"`     // Number of changes returned
+}
+
+// BatchUpdateConfigRequest PUT /api/config/batch 请求体
+type BatchUpdateConfigRequest struct {
+	Items []UpdateConfigRequest `json:"items" validate:"required,min=1"` // Configuration items to update together
+}
+
+// BatchUpdateConfigResponse PUT /api/config/batch 响应
+type BatchUpdateConfigResponse struct {
+	Items []UpdateConfigResponse `json:"items"`             // Updated configuration items
+	Count int                    `json:"count" example:"3"` // Number of updated items
+}
+
+// ValidateConfigRequest POST /api/config/validate 请求体
+type ValidateConfigRequest struct {
+	Items []UpdateConfigRequest `json:"items" validate:"required,min=1"` // Configuration items to validate together
+}
+
+// FieldError 结构化的配置校验错误
+type FieldError struct {
+	Key     string      `json:"key" example:"poc.api_key"`                    // Configuration key (validator namespace if it maps to no key)
+	Stage   string      `json:"stage" example:"value"`                        // key, value or config (merged struct)
+	Rule    string      `json:"rule,omitempty" example:"min"`                 // Failed rule: validate tag, or unknown, db, type
+	Param   string      `json:"param,omitempty" example:"10"`                 // Rule parameter (field type for rule "type")
+	Value   interface{} `json:"value,omitempty" swaggertype:"object"`         // Offending value (secrets masked)
+	Message string      `json:"message" example:"failed 'min=10' validation"` // Human readable description
+	err     error       // 写入路径返回的错误
+}
+
+// ValidateConfigResponse POST /api/config/validate 响应
+type ValidateConfigResponse struct {
+	Valid   bool         `json:"valid" example:"false"` // Whether the changes would be accepted
+	Checked int          `json:"checked" example:"2"`   // Number of submitted keys
+	Errors  []FieldError `json:"errors"`                // Every field error, per-key checks first
+}
+
+// ExplainLayer 某一配置层为配置项提供的值
+type ExplainLayer struct {
+	Layer         int         `json:"layer" example:"4"`                           // Layer number, 1 (lowest) to 6 (highest)
+	Name          string      `json:"name" example:"conf_d"`                       // tag_default, default_yaml, specialized_file, conf_d, database, env
+	Source        string      `json:"source" example:"conf_d/10-poc.yaml"`         // File name, env var name, "configitems[@<scope>]" or "default tag"
+	Set           bool        `json:"set" example:"true"`                          // Whether this layer sets the key
+	Value         interface{} `json:"value,omitempty" swaggertype:"object"`        // Value contributed by this layer, with ${...} expressions resolved
+	RawValue      interface{} `json:"raw_value,omitempty" swaggertype:"object"`    // Value as written in the file, when it contains ${...} expressions
+	Effective     bool        `json:"effective" example:"true"`                    // Whether this is the value in effect
+	Ignored       bool        `json:"ignored,omitempty" example:"false"`           // Set but not applied
+	IgnoredReason string      `json:"ignored_reason,omitempty" example:"db:false"` // Why the value was not applied
+}
+
+// ExplainConfigResponse GET /api/config/explain 响应
+type ExplainConfigResponse struct {
+	Key       string         `json:"key" example:"poc.enabled"`
+	Profile   string         `json:"profile,omitempty" example:"staging"`  // Active APP_ENV profile
+	Value     interface{}    `json:"value,omitempty" swaggertype:"object"` // Effective value
+	Source    string         `json:"source,omitempty" example:"database"`  // Name of the effective layer
+	IsDynamic bool           `json:"is_dynamic" example:"true"`
+	Secret    bool           `json:"secret" example:"false"`
+	Approval  bool           `json:"approval" example:"false"` // Whether API changes need a second operator's approval (approval:"required")
+	Layers    []ExplainLayer `json:"layers"`                   // Every examined layer, lowest precedence first
+}
+
+// ImportChange 导入文档中单个键的差异
+type ImportChange struct {
+	Key      string      `json:"key" example:"poc.enabled"`
+	Action   string      `json:"action" example:"update"`                  // update, unchanged, skipped or invalid
+	OldValue interface{} `json:"old_value,omitempty" swaggertype:"object"` // Effective value before import
+	NewValue interface{} `json:"new_value,omitempty" swaggertype:"object"` // Imported value
+	Reason   string      `json:"reason,omitempty" example:"db:false"`      // Why the key is skipped or invalid
+}
+
+// ImportConfigResponse POST /api/config/import 响应
+type ImportConfigResponse struct {
+	DryRun  bool           `json:"dry_run" example:"true"`
+	Applied int            `json:"applied" example:"2"` // Number of keys written to the database
+	Changes []ImportChange `json:"changes"`
 }
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Validation stages reported in FieldError.Stage
+const (
+	ValidationStageKey    = "key"    // key is unknown or not allowed in the database (db:false)
+	ValidationStageValue  = "value"  // value does not parse as the field type or breaks its validate tag
+	ValidationStageConfig = "config" // merged config fails validator.Struct
+)
+
+// Rules reported in FieldError.Rule for checks that are not validate tags
+const (
+	ruleUnknownKey = "unknown"
+	ruleDatabase   = "db"
+	ruleType       = "type"
+)
+
+// checkDynamicValue runs the per-key checks of a dynamic config write: the key
+// must allow database storage and the value must parse as the field type and
+// satisfy its validate tag. It returns the normalized stored form.
+func (s *Service) checkDynamicValue(key string, value string) (string, *FieldError) {
+	// 验证 key 是否允许数据库存储
+	if !s.loader.AllowDatabaseStorage(key) {
+		if _, exists := s.loader.GetMetadata(key); !exists {
+			return "", &FieldError{
+				Key: key, Stage: ValidationStageKey, Rule: ruleUnknownKey,
+				Message: "unknown config key",
+				err:     fmt.Errorf("unknown config key: %s", key),
+			}
+		}
+		return "", &FieldError{
+			Key: key, Stage: ValidationStageKey, Rule: ruleDatabase,
+			Message: "not allowed to be stored in database (db:false)",
+			err:     fmt.Errorf("config key '%s' is not allowed to be stored in database (db:false)", key),
+		}
+	}
+	meta, _ := s.loader.GetMetadata(key)
+
+	// 按字段的真实类型解析
+	normalized, typed, err := normalizeValue(meta.Type, value)
+	if err != nil {
+		return "", &FieldError{
+			Key: key, Stage: ValidationStageValue, Rule: ruleType, Param: meta.Type.String(),
+			Value:   s.MaskValue(key, value),
+			Message: err.Error(),
+			err:     fmt.Errorf("invalid value for key '%s': %w", key, err),
+		}
+	}
+
+	// 使用 validator 进行值验证（如果有 validate 标签）
+	// 引用其他字段的规则无法单独校验，由合并后的完整配置校验覆盖
+	if rules := valueRules(meta.ValidateTag); rules != "" {
+		if err := s.validator.Var(typed, rules); err != nil {
+			ferr := &FieldError{
+				Key: key, Stage: ValidationStageValue,
+				Value:   s.MaskValue(key, value),
+				Message: err.Error(),
+				err:     fmt.Errorf("validation failed for key '%s': %w", key, err),
+			}
+			var verrs validator.ValidationErrors
+			if errors.As(err, &verrs) && len(verrs) > 0 {
+				ferr.Rule, ferr.Param = verrs[0].Tag(), verrs[0].Param()
+				ferr.Message = ruleMessage(verrs[0])
+			}
+			return "", ferr
+		}
+	}
+
+	return normalized, nil
+}
+
+// crossFieldRules are validate rules that compare against other struct fields;
+// validator.Var cannot evaluate them on a single value
+var crossFieldRules = []string{
+	"eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield",
+	"eqcsfield", "necsfield", "gtcsfield", "gtecsfield", "ltcsfield", "ltecsfield",
+	"fieldcontains", "fieldexcludes",
+	"required_if", "required_unless", "required_with", "required_with_all",
+	"required_without", "required_without_all",
+	"excluded_if", "excluded_unless", "excluded_with", "excluded_with_all",
+	"excluded_without", "excluded_without_all",
+}
+
+// valueRules returns tag without its cross-field rules
+func valueRules(tag string) string {
+	if tag == "" {
+		return ""
+	}
+
+	rules := strings.Split(tag, ",")
+	kept := rules[:0]
+	for _, rule := range rules {
+		name, _, _ := strings.Cut(rule, "=")
+		if !slices.Contains(crossFieldRules, name) {
+			kept = append(kept, rule)
+		}
+	}
+	return strings.Join(kept, ",")
+}
+
+// ValidateConfigs 试运行校验：对每个键执行与写入相同的检查，再对合并后的完整配置
+// （含全部注册模块）执行 validator.Struct，返回全部字段错误，不写入任何数据
+func (s *Service) ValidateConfigs(ctx context.Context, items map[string]string) (*ValidateConfigResponse, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no config items to validate")
+	}
+
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resp := &ValidateConfigResponse{Checked: len(keys), Errors: []FieldError{}}
+	normalized := make(map[string]string, len(items))
+	for _, key := range keys {
+		value, ferr := s.checkDynamicValue(key, items[key])
+		if ferr != nil {
+			resp.Errors = append(resp.Errors, *ferr)
+			continue
+		}
+		normalized[key] = value
+	}
+
+	// 合并通过单项检查的值后校验完整配置（持有写锁，loadAll 会修改 Viper 状态）
+	structErrors, err := s.validateMerged(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+	resp.Errors = append(resp.Errors, structErrors...)
+	resp.Valid = len(resp.Errors) == 0
+	return resp, nil
+}
+
+// validateMerged loads the config with overrides applied and returns every
+// validator.Struct error of the global config and each registered module
+func (s *Service) validateMerged(ctx context.Context, overrides map[string]string) ([]FieldError, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	cfg, modules, err := s.loader.loadAll(ctx, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config with changes: %w", err)
+	}
+
+	result := toFieldErrors(s.loader, "", s.validateGlobal(cfg))
+
+	namespaces := make([]string, 0, len(modules))
+	for namespace := range modules {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		result = append(result, s.structErrors(namespace, modules[namespace])...)
+	}
+	return result, nil
+}
+
+// structErrors converts the validator.Struct errors of cfg into FieldErrors
+// keyed by config key; namespace is the registered module ("" for Config)
+func (s *Service) structErrors(namespace string, cfg interface{}) []FieldError {
+	return fieldErrors(s.loader, s.validator, namespace, cfg)
+}
+
+// fieldErrors validates cfg with validate and converts the errors into
+// FieldErrors, resolving keys and secret fields through loader
+func fieldErrors(loader *Loader, validate *validator.Validate, namespace string, cfg interface{}) []FieldError {
+	return toFieldErrors(loader, namespace, validate.Struct(cfg))
+}
+
+// toFieldErrors converts a validator.Struct error into FieldErrors
+func toFieldErrors(loader *Loader, namespace string, err error) []FieldError {
+	if err == nil {
+		return nil
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return []FieldError{{Key: namespace, Stage: ValidationStageConfig, Message: err.Error()}}
+	}
+
+	result := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		key, ok := loader.keyForField(namespace, fe.StructNamespace())
+		if !ok {
+			key = fe.Namespace()
+		}
+		value := fmt.Sprint(fe.Value())
+		if value != "" && loader.IsSecret(key) {
+			value = SecretMask
+		}
+		result = append(result, FieldError{
+			Key:     key,
+			Stage:   ValidationStageConfig,
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Value:   value,
+			Message: ruleMessage(fe),
+		})
+	}
+	return result
+}
+
+// ConfigErrors reports every field error of a config that failed validation
+type ConfigErrors []FieldError
+
+func (e ConfigErrors) Error() string {
+	problems := make([]string, 0, len(e))
+	for _, fe := range e {
+		problems = append(problems, fmt.Sprintf("%s (env %s): %s", fe.Key, envVarName(fe.Key), fe.Message))
+	}
+	return fmt.Sprintf("%d config error(s): %s", len(e), strings.Join(problems, "; "))
+}
+
+// ruleMessage describes the failed rule of a validator error
+func ruleMessage(fe validator.FieldError) string {
+	if fe.Param() != "" {
+		return fmt.Sprintf("failed '%s=%s' validation", fe.Tag(), fe.Param())
+	}
+	return fmt.Sprintf("failed '%s' validation", fe.Tag())
+}
@@ -0,0 +1,158 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rangeModuleConfig 带跨字段规则的测试模块：单项检查无法发现 max < min
+type rangeModuleConfig struct {
+	Min int `validate:"min=0" default:"1" db:"true"`
+	Max int `validate:"gtefield=Min" default:"10" db:"true"`
+}
+
+// newValidateTestService 创建注册了 rangeModuleConfig 的测试服务
+func newValidateTestService(t *testing.T) (*Service, *mockConfigProvider) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644))
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Register("limits", &rangeModuleConfig{}))
+
+	mockProvider := newMockProvider()
+	loader, err := NewLoaderWithRegistry(tmpDir, mockProvider, registry)
+	require.NoError(t, err)
+
+	service := NewService(loader, mockProvider)
+	_, err = service.LoadConfig(context.Background())
+	require.NoError(t, err)
+	return service, mockProvider
+}
+
+// errorByKey 按配置键查找字段错误
+func errorByKey(t *testing.T, resp *ValidateConfigResponse, key string) FieldError {
+	t.Helper()
+
+	for _, fe := range resp.Errors {
+		if fe.Key == key {
+			return fe
+		}
+	}
+	require.Failf(t, "field error not found", "key %s in %+v", key, resp.Errors)
+	return FieldError{}
+}
+
+// TestService_ValidateConfigs 测试试运行校验返回全部结构化错误且不写入
+func TestService_ValidateConfigs(t *testing.T) {
+	service, mockProvider := newValidateTestService(t)
+	ctx := context.Background()
+
+	resp, err := service.ValidateConfigs(ctx, map[string]string{
+		"app.name":     "new-name",
+		"app.version":  "2.0.0",
+		"missing.key":  "x",
+		"poc.enabled":  "maybe",
+		"poc.api_key":  "short",
+		"limits.max":   "0",
+		"app.timezone": "Mars/Olympus",
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Valid)
+	assert.Equal(t, 7, resp.Checked)
+	require.Len(t, resp.Errors, 6)
+
+	// 单项检查按键排序在前，完整结构体校验在后
+	assert.Equal(t, "app.timezone", resp.Errors[0].Key)
+	assert.Equal(t, "limits.max", resp.Errors[len(resp.Errors)-1].Key)
+
+	fe := errorByKey(t, resp, "app.version")
+	assert.Equal(t, ValidationStageKey, fe.Stage)
+	assert.Equal(t, "db", fe.Rule)
+
+	fe = errorByKey(t, resp, "missing.key")
+	assert.Equal(t, ValidationStageKey, fe.Stage)
+	assert.Equal(t, "unknown", fe.Rule)
+
+	fe = errorByKey(t, resp, "poc.enabled")
+	assert.Equal(t, ValidationStageValue, fe.Stage)
+	assert.Equal(t, "type", fe.Rule)
+	assert.Equal(t, "bool", fe.Param)
+
+	// 敏感值脱敏
+	fe = errorByKey(t, resp, "poc.api_key")
+	assert.Equal(t, ValidationStageValue, fe.Stage)
+	assert.Equal(t, "min", fe.Rule)
+	assert.Equal(t, "10", fe.Param)
+	assert.Equal(t, SecretMask, fe.Value)
+	assert.Equal(t, "failed 'min=10' validation", fe.Message)
+
+	fe = errorByKey(t, resp, "limits.max")
+	assert.Equal(t, ValidationStageConfig, fe.Stage)
+	assert.Equal(t, "gtefield", fe.Rule)
+	assert.Equal(t, "Min", fe.Param)
+	assert.Equal(t, "0", fe.Value)
+
+	// 未写入任何数据，缓存配置不变
+	assert.Empty(t, mockProvider.configs)
+	assert.Equal(t, "test-app", service.GetConfig().App.Name)
+
+	resp, err = service.ValidateConfigs(ctx, map[string]string{"limits.max": "5", "app.name": "ok"})
+	require.NoError(t, err)
+	assert.True(t, resp.Valid)
+	assert.Empty(t, resp.Errors)
+
+	_, err = service.ValidateConfigs(ctx, nil)
+	assert.Error(t, err)
+
+	// 写入路径同样跳过单项无法判断的跨字段规则，由完整配置校验拒绝
+	require.NoError(t, service.UpdateConfig(ctx, "limits.max", "5"))
+	assert.ErrorContains(t, service.UpdateConfig(ctx, "limits.max", "0"), "gtefield")
+	assert.Equal(t, "", valueRules("gtefield=Min"))
+	assert.Equal(t, "required,min=1", valueRules("required,required_with=Max,min=1"))
+}
+
+// TestHandler_ValidateConfig 测试 POST /config/validate
+func TestHandler_ValidateConfig(t *testing.T) {
+	service, mockProvider := newValidateTestService(t)
+	r := chi.NewRouter()
+	NewHandler(service).RegisterRoutes(r)
+
+	do := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/config/validate", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(`{"items":[{"key":"app.name","value":""},{"key":"limits.min","value":20}]}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp ValidateConfigResponse
+	decodeData(t, w, &resp)
+	assert.False(t, resp.Valid)
+	require.Len(t, resp.Errors, 2)
+	assert.Equal(t, FieldError{Key: "app.name", Stage: ValidationStageValue, Rule: "required", Value: "", Message: "failed 'required' validation"}, resp.Errors[0])
+	assert.Equal(t, "limits.max", resp.Errors[1].Key)
+	assert.Equal(t, "10", resp.Errors[1].Value)
+
+	var ok ValidateConfigResponse
+	decodeData(t, do(`{"items":[{"key":"poc.enabled","value":true}]}`), &ok)
+	assert.True(t, ok.Valid)
+	assert.Equal(t, 1, ok.Checked)
+	assert.Empty(t, mockProvider.configs)
+
+	assert.Equal(t, http.StatusBadRequest, do(`{`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, do(`{"items":[]}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, do(`{"items":[{"key":"app.name"}]}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, do(`{"items":[{"key":"app.name","value":"a"},{"key":"app.name","value":"b"}]}`).Code)
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dynamic values are stored as text. The stored form depends on the Go type of
+// the target field, so existing rows written as plain strings stay valid:
+//
+//	string              as is
+//	bool, ints, floats  strconv text ("true", "8080", "0.5")
+//	time.Duration       Go duration ("30s")
+//	slices, maps, ...   JSON ("[\"stdout\",\"file:/var/log/a.log\"]")
+//
+// API responses decode the stored form back into typed JSON values.
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// decodeValue parses the stored text form into a value of type t
+func decodeValue(t reflect.Type, text string) (interface{}, error) {
+	if t == nil {
+		return text, nil
+	}
+
+	if t == durationType {
+		d, err := time.ParseDuration(strings.TrimSpace(text))
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q", text)
+		}
+		return d, nil
+	}
+
+	ptr := reflect.New(t)
+	v := ptr.Elem()
+	trimmed := strings.TrimSpace(text)
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bool %q", text)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(trimmed, 10, t.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", text)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(trimmed, 10, t.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("invalid unsigned integer %q", text)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(trimmed, t.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", text)
+		}
+		v.SetFloat(f)
+	default:
+		if err := json.Unmarshal([]byte(trimmed), ptr.Interface()); err != nil {
+			// Tag defaults and legacy rows use comma separated lists, e.g. default:"stdout"
+			if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String && !strings.HasPrefix(trimmed, "[") {
+				return splitList(trimmed), nil
+			}
+			return nil, fmt.Errorf("invalid %s value: %w", t, err)
+		}
+	}
+
+	return v.Interface(), nil
+}
+
+// splitList splits a comma separated list into trimmed items
+func splitList(text string) []string {
+	items := []string{}
+	for _, item := range strings.Split(text, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// normalizeValue parses text as type t and returns its canonical stored form
+// together with the typed value (used for validation)
+func normalizeValue(t reflect.Type, text string) (string, interface{}, error) {
+	typed, err := decodeValue(t, text)
+	if err != nil {
+		return "", nil, err
+	}
+	return formatValue(reflect.ValueOf(typed)), typed, nil
+}
+
+// formatValue converts reflect.Value to its stored text form
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+	case reflect.Slice, reflect.Map, reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		fallthrough
+	case reflect.Array, reflect.Struct:
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	default:
+		return ""
+	}
+}
+
+// jsonValue converts a typed value into what the API returns: durations are
+// rendered as Go duration strings, everything else is encoded as-is
+func jsonValue(typed interface{}) interface{} {
+	if d, ok := typed.(time.Duration); ok {
+		return d.String()
+	}
+	return typed
+}
+
+// valueText converts a JSON request value into text form: JSON strings are
+// used verbatim (so "true" and true are equivalent), other values as JSON
+func valueText(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid value: %w", err)
+	}
+	return string(data), nil
+}
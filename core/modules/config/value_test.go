@@ -0,0 +1,174 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"apprun/pkg/logger"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNormalizeValue 测试按字段类型解析并规范化存储形式
+func TestNormalizeValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      reflect.Type
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"string", reflect.TypeOf(""), " keep spaces ", " keep spaces ", false},
+		{"bool", reflect.TypeOf(false), "true", "true", false},
+		{"invalid bool", reflect.TypeOf(false), "yes", "", true},
+		{"int", reflect.TypeOf(0), "8080", "8080", false},
+		{"invalid int", reflect.TypeOf(0), "80.5", "", true},
+		{"float", reflect.TypeOf(0.0), "0.50", "0.5", false},
+		{"duration", reflect.TypeOf(time.Duration(0)), "1m30s", "1m30s", false},
+		{"invalid duration", reflect.TypeOf(time.Duration(0)), "90", "", true},
+		{"string list json", reflect.TypeOf([]string{}), `["stdout", "file:/var/log/a.log"]`, `["stdout","file:/var/log/a.log"]`, false},
+		{"string list comma", reflect.TypeOf([]string{}), "stdout, stderr", `["stdout","stderr"]`, false},
+		{"invalid list json", reflect.TypeOf([]string{}), `["stdout"`, "", true},
+		{"map", reflect.TypeOf(map[string]int{}), `{"b":2,"a":1}`, `{"a":1,"b":2}`, false},
+		{"nested object", reflect.TypeOf([]struct {
+			Name string `json:"name"`
+		}{}), `[{"name":"x"}]`, `[{"name":"x"}]`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, _, err := normalizeValue(tt.typ, tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, text)
+		})
+	}
+}
+
+// TestValueText 测试请求中的 JSON 值转换为文本形式
+func TestValueText(t *testing.T) {
+	for input, expected := range map[interface{}]string{
+		"true":    "true",
+		true:      "true",
+		8080.0:    "8080",
+		"30s":     "30s",
+		"a,b":     "a,b",
+		nil:       "null",
+		"[\"x\"]": `["x"]`,
+	} {
+		text, err := valueText(input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, text)
+	}
+
+	text, err := valueText([]interface{}{"stdout", "file:/var/log/a.log"})
+	require.NoError(t, err)
+	assert.Equal(t, `["stdout","file:/var/log/a.log"]`, text)
+}
+
+// newRegistryTestService 创建注册了 logger 模块的配置服务
+func newRegistryTestService(t *testing.T) (*Service, *mockConfigProvider) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "default.yaml"), []byte(validDefaultYAML), 0644)
+	require.NoError(t, err)
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Register("logger", &logger.Config{}))
+
+	mockProvider := newMockProvider()
+	loader, err := NewLoaderWithRegistry(tmpDir, mockProvider, registry)
+	require.NoError(t, err)
+
+	service := NewService(loader, mockProvider)
+	_, err = service.LoadConfig(context.Background())
+	require.NoError(t, err)
+
+	return service, mockProvider
+}
+
+// TestService_TypedListValue 测试列表类型配置的读取、写入与验证
+func TestService_TypedListValue(t *testing.T) {
+	service, mockProvider := newRegistryTestService(t)
+	ctx := context.Background()
+
+	// 标签默认值（已绑定到 logger 模块）按字段类型返回
+	value, source, err := service.GetConfigValue(ctx, "logger.output.targets")
+	require.NoError(t, err)
+	assert.Equal(t, "file", source)
+	assert.Equal(t, `["stdout"]`, value)
+	assert.Equal(t, []string{"stdout"}, service.DisplayValue("logger.output.targets", value))
+
+	// 列表值按真实类型验证（dive 到每个元素）
+	require.NoError(t, service.UpdateConfig(ctx, "logger.output.targets", `["stdout","file:/var/log/a.log"]`))
+	assert.Equal(t, `["stdout","file:/var/log/a.log"]`, mockProvider.configs["logger.output.targets"])
+
+	err = service.UpdateConfig(ctx, "logger.output.targets", `["syslog"]`)
+	assert.ErrorContains(t, err, "validation failed")
+	err = service.UpdateConfig(ctx, "logger.output.targets", `[]`)
+	assert.ErrorContains(t, err, "validation failed")
+	err = service.UpdateConfig(ctx, "logger.output.targets", `["stdout"`)
+	assert.ErrorContains(t, err, "invalid value")
+
+	// 标量值同样按类型解析
+	assert.ErrorContains(t, service.UpdateConfig(ctx, "poc.enabled", "yes"), "invalid value")
+	require.NoError(t, service.UpdateConfig(ctx, "poc.enabled", "TRUE"))
+	assert.Equal(t, "true", mockProvider.configs["poc.enabled"])
+	assert.True(t, service.GetConfig().POC.Enabled)
+	assert.Equal(t, true, service.DisplayValue("poc.enabled", "true"))
+}
+
+// TestHandler_TypedValues 测试 API 接收和返回类型化 JSON 值
+func TestHandler_TypedValues(t *testing.T) {
+	service, _ := newRegistryTestService(t)
+	handler := NewHandler(service)
+
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodPut, "/config", `{"key":"logger.output.targets","value":["stdout","file:/var/log/a.log"]}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updateResp UpdateConfigResponse
+	decodeData(t, w, &updateResp)
+	assert.Equal(t, []interface{}{"stdout", "file:/var/log/a.log"}, updateResp.Value)
+
+	var getResp GetConfigResponse
+	decodeData(t, do(http.MethodGet, "/config?key=logger.output.targets", ""), &getResp)
+	assert.Equal(t, "database", getResp.Source)
+	assert.Equal(t, []interface{}{"stdout", "file:/var/log/a.log"}, getResp.Value)
+
+	// 原生 JSON 布尔值与字符串形式等价
+	require.Equal(t, http.StatusOK, do(http.MethodPut, "/config", `{"key":"poc.enabled","value":true}`).Code)
+	decodeData(t, do(http.MethodGet, "/config?key=poc.enabled", ""), &getResp)
+	assert.Equal(t, true, getResp.Value)
+
+	var listResp ListConfigsResponse
+	decodeData(t, do(http.MethodGet, "/config/list", ""), &listResp)
+	assert.Equal(t, true, listResp.Configs["poc.enabled"])
+	assert.Equal(t, []interface{}{"stdout", "file:/var/log/a.log"}, listResp.Configs["logger.output.targets"])
+
+	// 类型不匹配或缺失
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPut, "/config", `{"key":"poc.enabled","value":[1]}`).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPut, "/config", `{"key":"logger.output.targets","value":["syslog"]}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, do(http.MethodPut, "/config", `{"key":"poc.enabled"}`).Code)
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"apprun/pkg/logger"
+)
+
+// ChangeEvent describes a committed change of a dynamic config key
+// OldValue/NewValue are the effective values before and after the change,
+// so a deleted key reports the file or default value it falls back to.
+type ChangeEvent struct {
+	Key      string `json:"key" example:"logger.level"`
+	Scope    string `json:"scope" example:"global"` // Scope of the change; file reloads are global
+	OldValue string `json:"old_value" example:"info"`
+	NewValue string `json:"new_value" example:"debug"`
+	Action   string `json:"action" example:"set"` // set, delete, rollback, or reload for config file changes
+	Actor    string `json:"actor" example:"admin"`
+}
+
+// ChangeActionReload is the action of change events published when a config
+// file reload changes effective values; file reloads are not recorded in history
+const ChangeActionReload = "reload"
+
+// ChangeHandler receives change events; it is called synchronously after the
+// change is committed and must not block
+type ChangeHandler func(event ChangeEvent)
+
+// watcher is a single subscription
+type watcher struct {
+	id        int
+	pattern   string
+	allScopes bool // receive changes of every scope, not only global
+	handler   ChangeHandler
+}
+
+// matches reports whether event is covered by the subscription: the scope
+// must be global unless allScopes is set, and the key must match the pattern
+// ("" matches every key, a pattern ending with "." matches a key prefix,
+// anything else must match the key exactly)
+func (w *watcher) matches(event ChangeEvent) bool {
+	if !w.allScopes && event.Scope != GlobalScopeName {
+		return false
+	}
+	key := event.Key
+	if w.pattern == "" {
+		return true
+	}
+	if strings.HasSuffix(w.pattern, ".") {
+		return strings.HasPrefix(key, w.pattern)
+	}
+	return key == w.pattern
+}
+
+// watcherRegistry keeps the active subscriptions of a Service
+type watcherRegistry struct {
+	mu       sync.RWMutex
+	nextID   int
+	watchers map[int]*watcher
+}
+
+func newWatcherRegistry() *watcherRegistry {
+	return &watcherRegistry{
+		watchers: make(map[int]*watcher),
+	}
+}
+
+// add registers a subscription and returns a function that removes it
+func (r *watcherRegistry) add(pattern string, allScopes bool, handler ChangeHandler) func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	id := r.nextID
+	r.watchers[id] = &watcher{id: id, pattern: pattern, allScopes: allScopes, handler: handler}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			delete(r.watchers, id)
+		})
+	}
+}
+
+// notify delivers event to every matching subscription in registration order
+func (r *watcherRegistry) notify(event ChangeEvent) {
+	r.mu.RLock()
+	matched := make([]*watcher, 0, len(r.watchers))
+	for _, w := range r.watchers {
+		if w.matches(event) {
+			matched = append(matched, w)
+		}
+	}
+	r.mu.RUnlock()
+
+	// map iteration order is random; keep delivery deterministic
+	sort.Slice(matched, func(i, j int) bool { return matched[i].id < matched[j].id })
+
+	for _, w := range matched {
+		r.dispatch(w, event)
+	}
+}
+
+// dispatch calls a single handler, isolating the writer from handler panics
+func (r *watcherRegistry) dispatch(w *watcher, event ChangeEvent) {
+	defer func() {
+		if v := recover(); v != nil {
+			logger.Error("config watcher panicked",
+				logger.Field{Key: "pattern", Value: w.pattern},
+				logger.Field{Key: "key", Value: event.Key},
+				logger.Field{Key: "panic", Value: v})
+		}
+	}()
+	w.handler(event)
+}
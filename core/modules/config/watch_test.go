@@ -0,0 +1,126 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestService_Watch 测试按键和前缀订阅配置变更
+func TestService_Watch(t *testing.T) {
+	service, _ := newTestService(t)
+	ctx := WithActor(context.Background(), "alice")
+
+	var exact, prefix, all []ChangeEvent
+	service.Watch("app.name", func(e ChangeEvent) { exact = append(exact, e) })
+	service.Watch("poc.", func(e ChangeEvent) { prefix = append(prefix, e) })
+	cancelAll := service.Watch("", func(e ChangeEvent) { all = append(all, e) })
+
+	require.NoError(t, service.UpdateConfig(ctx, "app.name", "watched-app"))
+	require.NoError(t, service.UpdateConfig(ctx, "poc.enabled", "true"))
+
+	require.Len(t, exact, 1)
+	assert.Equal(t, "app.name", exact[0].Key)
+	assert.Equal(t, "test-app", exact[0].OldValue)
+	assert.Equal(t, "watched-app", exact[0].NewValue)
+	assert.Equal(t, ChangeActionSet, exact[0].Action)
+	assert.Equal(t, "alice", exact[0].Actor)
+
+	require.Len(t, prefix, 1)
+	assert.Equal(t, "poc.enabled", prefix[0].Key)
+	assert.Equal(t, "false", prefix[0].OldValue)
+	assert.Equal(t, "true", prefix[0].NewValue)
+
+	assert.Len(t, all, 2)
+
+	// 取消订阅后不再收到事件；删除回退到文件值
+	cancelAll()
+	require.NoError(t, service.DeleteDynamicConfig(ctx, "app.name"))
+	assert.Len(t, all, 2)
+	require.Len(t, exact, 2)
+	assert.Equal(t, ChangeActionDelete, exact[1].Action)
+	assert.Equal(t, "test-app", exact[1].NewValue)
+}
+
+// TestService_Watch_MasksSecrets 测试敏感配置项的变更事件不包含明文
+func TestService_Watch_MasksSecrets(t *testing.T) {
+	service, _ := newTestService(t)
+
+	var events []ChangeEvent
+	service.Watch("poc.api_key", func(e ChangeEvent) { events = append(events, e) })
+
+	require.NoError(t, service.UpdateConfig(context.Background(), "poc.api_key", "rotated-api-key-123"))
+	require.Len(t, events, 1)
+	assert.Equal(t, SecretMask, events[0].OldValue)
+	assert.Equal(t, SecretMask, events[0].NewValue)
+}
+
+// TestService_Watch_NotNotifiedOnFailure 测试失败的变更不会通知订阅者
+func TestService_Watch_NotNotifiedOnFailure(t *testing.T) {
+	service, mockProvider := newTestService(t)
+	ctx := context.Background()
+
+	var events []ChangeEvent
+	service.Watch("", func(e ChangeEvent) { events = append(events, e) })
+
+	// db:false 键被拒绝
+	assert.Error(t, service.UpdateConfig(ctx, "app.version", "2.0.0"))
+
+	// 整体校验失败被回滚
+	mockProvider.configs["app.timezone"] = "Invalid/Zone"
+	assert.Error(t, service.UpdateConfig(ctx, "app.name", "other"))
+
+	assert.Empty(t, events)
+}
+
+// TestService_Watch_HandlerPanic 测试订阅者 panic 不影响写入方和其他订阅者
+func TestService_Watch_HandlerPanic(t *testing.T) {
+	service, _ := newTestService(t)
+
+	service.Watch("app.", func(e ChangeEvent) { panic("boom") })
+	delivered := false
+	service.Watch("app.", func(e ChangeEvent) { delivered = true })
+
+	require.NoError(t, service.UpdateConfig(context.Background(), "app.name", "still-works"))
+	assert.True(t, delivered)
+}
+
+// TestService_Watch_ConcurrentWrites 测试并发写入时每个事件的新值都是本次写入的值
+func TestService_Watch_ConcurrentWrites(t *testing.T) {
+	service, _ := newTestService(t)
+
+	var mu sync.Mutex
+	var events []ChangeEvent
+	service.Watch("app.name", func(e ChangeEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, e)
+	})
+
+	const writers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, service.UpdateConfig(context.Background(), "app.name", fmt.Sprintf("app-%d", i)))
+		}(i)
+	}
+	wg.Wait()
+
+	require.Len(t, events, writers)
+	newValues := make(map[string]bool, writers)
+	oldValues := make(map[string]bool, writers)
+	for _, e := range events {
+		newValues[e.NewValue] = true
+		oldValues[e.OldValue] = true
+	}
+	// 写入串行化：新值各不相同，旧值依次衔接成一条链
+	assert.Len(t, newValues, writers)
+	assert.Len(t, oldValues, writers)
+	assert.True(t, oldValues["test-app"])
+}
@@ -0,0 +1,276 @@
+package flags
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// flagKeyPattern restricts flag keys to lowercase dotted names, e.g. checkout.new_flow
+var flagKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,127}$`)
+
+// normalize fills the defaults of f and validates it.
+// Boolean flags (no variants) get on=true/off=false with "off" as default;
+// off_variant defaults to default_variant. The regular expressions of
+// matches conditions are compiled and kept on the conditions.
+func normalize(f *Flag) error {
+	if !flagKeyPattern.MatchString(f.Key) {
+		return fmt.Errorf("invalid flag key %q: must match %s", f.Key, flagKeyPattern)
+	}
+
+	if len(f.Variants) == 0 {
+		f.Variants = map[string]interface{}{VariantOn: true, VariantOff: false}
+		if f.DefaultVariant == "" {
+			f.DefaultVariant = VariantOff
+		}
+		if f.OffVariant == "" {
+			f.OffVariant = VariantOff
+		}
+	}
+	if f.DefaultVariant == "" {
+		return fmt.Errorf("default_variant is required when variants are defined")
+	}
+	if f.OffVariant == "" {
+		f.OffVariant = f.DefaultVariant
+	}
+
+	if err := f.checkVariant("default_variant", f.DefaultVariant); err != nil {
+		return err
+	}
+	if err := f.checkVariant("off_variant", f.OffVariant); err != nil {
+		return err
+	}
+	if err := f.checkRollout("rollout", f.Rollout); err != nil {
+		return err
+	}
+
+	for i, rule := range f.Rules {
+		name := fmt.Sprintf("rules[%d]", i)
+		if len(rule.Conditions) == 0 {
+			return fmt.Errorf("%s: at least one condition is required", name)
+		}
+		for j := range rule.Conditions {
+			if err := rule.Conditions[j].compile(); err != nil {
+				return fmt.Errorf("%s.conditions[%d]: %w", name, j, err)
+			}
+		}
+
+		switch {
+		case rule.Variant != "" && len(rule.Rollout) > 0:
+			return fmt.Errorf("%s: variant and rollout are mutually exclusive", name)
+		case rule.Variant != "":
+			if err := f.checkVariant(name+".variant", rule.Variant); err != nil {
+				return err
+			}
+		case len(rule.Rollout) > 0:
+			if err := f.checkRollout(name+".rollout", rule.Rollout); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%s: variant or rollout is required", name)
+		}
+	}
+
+	return nil
+}
+
+// checkVariant reports whether variant is one of the flag's variants
+func (f *Flag) checkVariant(field, variant string) error {
+	if _, ok := f.Variants[variant]; !ok {
+		return fmt.Errorf("%s: unknown variant %q (defined: %s)", field, variant, strings.Join(f.variantNames(), ", "))
+	}
+	return nil
+}
+
+// checkRollout validates that a rollout uses known variants and its weights sum to 100
+func (f *Flag) checkRollout(field string, rollout []WeightedVariant) error {
+	if len(rollout) == 0 {
+		return nil
+	}
+
+	total := 0
+	for _, wv := range rollout {
+		if err := f.checkVariant(field, wv.Variant); err != nil {
+			return err
+		}
+		if wv.Weight < 0 {
+			return fmt.Errorf("%s: weight of %q must not be negative", field, wv.Variant)
+		}
+		total += wv.Weight
+	}
+	if total != 100 {
+		return fmt.Errorf("%s: weights must sum to 100, got %d", field, total)
+	}
+	return nil
+}
+
+func (f *Flag) variantNames() []string {
+	names := make([]string, 0, len(f.Variants))
+	for name := range f.Variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// compile validates the attribute, operator and values of the condition
+// and compiles the regular expressions of a matches condition
+func (c *Condition) compile() error {
+	if c.Attribute == "" {
+		return fmt.Errorf("attribute is required")
+	}
+	if len(c.Values) == 0 {
+		return fmt.Errorf("at least one value is required")
+	}
+
+	c.patterns = nil
+	switch c.Operator {
+	case OperatorIn, OperatorNotIn, OperatorStartsWith, OperatorEndsWith, OperatorContains:
+	case OperatorMatches:
+		patterns := make([]*regexp.Regexp, len(c.Values))
+		for i, v := range c.Values {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return fmt.Errorf("invalid regular expression %q: %w", v, err)
+			}
+			patterns[i] = re
+		}
+		c.patterns = patterns
+	default:
+		return fmt.Errorf("unknown operator %q", c.Operator)
+	}
+	return nil
+}
+
+// evaluate serves a variant of f for ec: off_variant when disabled, otherwise
+// the first matching rule, otherwise the rollout or default_variant
+func evaluate(f *Flag, ec EvaluationContext) Evaluation {
+	if !f.Enabled {
+		return served(f, f.OffVariant, ReasonDisabled, 0)
+	}
+
+	for i, rule := range f.Rules {
+		if !rule.matches(ec) {
+			continue
+		}
+		if len(rule.Rollout) > 0 {
+			return served(f, bucketVariant(f.Key, ec, rule.Rollout), ReasonRule, i+1)
+		}
+		return served(f, rule.Variant, ReasonRule, i+1)
+	}
+
+	if len(f.Rollout) > 0 {
+		return served(f, bucketVariant(f.Key, ec, f.Rollout), ReasonDefault, 0)
+	}
+	return served(f, f.DefaultVariant, ReasonDefault, 0)
+}
+
+// served builds the evaluation result for variant
+func served(f *Flag, variant, reason string, rule int) Evaluation {
+	return Evaluation{
+		Key:     f.Key,
+		Value:   f.Variants[variant],
+		Variant: variant,
+		Reason:  reason,
+		Rule:    rule,
+	}
+}
+
+// matches reports whether every condition of the rule holds for ec
+func (r Rule) matches(ec EvaluationContext) bool {
+	for _, cond := range r.Conditions {
+		if !cond.matches(ec.attribute(cond.Attribute)) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether value satisfies the condition.
+// A missing attribute only satisfies not_in. A matches condition uses the
+// patterns compiled by normalize and never matches before it is normalized.
+func (c Condition) matches(value string) bool {
+	if c.Operator == OperatorNotIn {
+		for _, v := range c.Values {
+			if value == v {
+				return false
+			}
+		}
+		return true
+	}
+	if value == "" {
+		return false
+	}
+
+	if c.Operator == OperatorMatches {
+		for _, re := range c.patterns {
+			if re.MatchString(value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, v := range c.Values {
+		var ok bool
+		switch c.Operator {
+		case OperatorIn:
+			ok = value == v
+		case OperatorStartsWith:
+			ok = strings.HasPrefix(value, v)
+		case OperatorEndsWith:
+			ok = strings.HasSuffix(value, v)
+		case OperatorContains:
+			ok = strings.Contains(value, v)
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// attribute returns the named attribute of the subject ("user" and "project" are built in)
+func (ec EvaluationContext) attribute(name string) string {
+	switch name {
+	case AttributeUser:
+		return ec.User
+	case AttributeProject:
+		return ec.Project
+	}
+	return ec.Attributes[name]
+}
+
+// subjectID returns the ID used to bucket the subject: the user, or the project if no user is set
+func (ec EvaluationContext) subjectID() string {
+	if ec.User != "" {
+		return ec.User
+	}
+	return ec.Project
+}
+
+// bucketVariant picks a rollout variant by hashing the flag key and subject ID,
+// so a subject keeps its variant while weights only grow
+func bucketVariant(flagKey string, ec EvaluationContext, rollout []WeightedVariant) string {
+	bucket := int(bucketOf(flagKey, ec.subjectID()))
+
+	cumulative := 0
+	for _, wv := range rollout {
+		cumulative += wv.Weight
+		if bucket < cumulative {
+			return wv.Variant
+		}
+	}
+	return rollout[len(rollout)-1].Variant
+}
+
+// bucketOf maps flagKey and subject to a stable bucket in [0, 100)
+func bucketOf(flagKey, subject string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(flagKey))
+	h.Write([]byte{0})
+	h.Write([]byte(subject))
+	return h.Sum32() % 100
+}
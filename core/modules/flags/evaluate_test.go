@@ -0,0 +1,154 @@
+package flags
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNormalize 测试 Flag 默认值填充与校验
+func TestNormalize(t *testing.T) {
+	f := Flag{Key: "checkout.new_flow"}
+	require.NoError(t, normalize(&f))
+	assert.Equal(t, map[string]interface{}{VariantOn: true, VariantOff: false}, f.Variants)
+	assert.Equal(t, VariantOff, f.DefaultVariant)
+	assert.Equal(t, VariantOff, f.OffVariant)
+
+	f = Flag{Key: "theme", Variants: map[string]interface{}{"light": "#fff", "dark": "#000"}, DefaultVariant: "light"}
+	require.NoError(t, normalize(&f))
+	assert.Equal(t, "light", f.OffVariant)
+
+	invalid := []struct {
+		name string
+		flag Flag
+	}{
+		{"bad key", Flag{Key: "Checkout Flow"}},
+		{"missing default", Flag{Key: "a", Variants: map[string]interface{}{"x": 1}}},
+		{"unknown default", Flag{Key: "a", DefaultVariant: "maybe"}},
+		{"weights", Flag{Key: "a", Rollout: []WeightedVariant{{VariantOn, 30}, {VariantOff, 60}}}},
+		{"rollout variant", Flag{Key: "a", Rollout: []WeightedVariant{{"maybe", 100}}}},
+		{"rule without conditions", Flag{Key: "a", Rules: []Rule{{Variant: VariantOn}}}},
+		{"rule without variant", Flag{Key: "a", Rules: []Rule{{Conditions: []Condition{{Attribute: AttributeUser, Operator: OperatorIn, Values: []string{"bob"}}}}}}},
+		{"rule variant and rollout", Flag{Key: "a", Rules: []Rule{{
+			Conditions: []Condition{{Attribute: AttributeUser, Operator: OperatorIn, Values: []string{"bob"}}},
+			Variant:    VariantOn,
+			Rollout:    []WeightedVariant{{VariantOn, 100}},
+		}}}},
+		{"unknown operator", Flag{Key: "a", Rules: []Rule{{Conditions: []Condition{{Attribute: AttributeUser, Operator: "gt", Values: []string{"1"}}}, Variant: VariantOn}}}},
+		{"bad regexp", Flag{Key: "a", Rules: []Rule{{Conditions: []Condition{{Attribute: AttributeUser, Operator: OperatorMatches, Values: []string{"("}}}, Variant: VariantOn}}}},
+		{"no values", Flag{Key: "a", Rules: []Rule{{Conditions: []Condition{{Attribute: AttributeUser, Operator: OperatorIn, Values: nil}}, Variant: VariantOn}}}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, normalize(&tt.flag))
+		})
+	}
+}
+
+// TestEvaluate 测试开关、定向规则与默认变体
+func TestEvaluate(t *testing.T) {
+	f := Flag{
+		Key:     "checkout.new_flow",
+		Enabled: true,
+		Rules: []Rule{
+			{Conditions: []Condition{{Attribute: AttributeUser, Operator: OperatorIn, Values: []string{"blocked"}}}, Variant: VariantOff},
+			{Conditions: []Condition{
+				{Attribute: AttributeProject, Operator: OperatorStartsWith, Values: []string{"beta-"}},
+				{Attribute: "plan", Operator: OperatorIn, Values: []string{"pro", "enterprise"}},
+			}, Variant: VariantOn},
+			{Conditions: []Condition{{Attribute: AttributeUser, Operator: OperatorMatches, Values: []string{`@example\.com$`}}}, Variant: VariantOn},
+		},
+	}
+	require.NoError(t, normalize(&f))
+
+	tests := []struct {
+		name   string
+		ec     EvaluationContext
+		value  interface{}
+		reason string
+		rule   int
+	}{
+		{"no match", EvaluationContext{User: "alice"}, false, ReasonDefault, 0},
+		{"first rule wins", EvaluationContext{User: "blocked", Project: "beta-1", Attributes: map[string]string{"plan": "pro"}}, false, ReasonRule, 1},
+		{"all conditions", EvaluationContext{User: "alice", Project: "beta-1", Attributes: map[string]string{"plan": "pro"}}, true, ReasonRule, 2},
+		{"partial conditions", EvaluationContext{User: "alice", Project: "beta-1", Attributes: map[string]string{"plan": "free"}}, false, ReasonDefault, 0},
+		{"regexp", EvaluationContext{User: "bob@example.com"}, true, ReasonRule, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := evaluate(&f, tt.ec)
+			assert.Equal(t, tt.value, result.Value)
+			assert.Equal(t, tt.reason, result.Reason)
+			assert.Equal(t, tt.rule, result.Rule)
+		})
+	}
+
+	// 关闭后一律返回 off_variant
+	f.Enabled = false
+	result := evaluate(&f, EvaluationContext{User: "bob@example.com"})
+	assert.Equal(t, ReasonDisabled, result.Reason)
+	assert.Equal(t, false, result.Value)
+}
+
+// TestCondition_NotIn 测试 not_in 对缺失属性的处理
+func TestCondition_NotIn(t *testing.T) {
+	cond := Condition{Attribute: AttributeProject, Operator: OperatorNotIn, Values: []string{"alpha"}}
+	assert.True(t, cond.matches(""))
+	assert.True(t, cond.matches("beta"))
+	assert.False(t, cond.matches("alpha"))
+
+	cond.Operator = OperatorIn
+	assert.False(t, cond.matches(""))
+}
+
+// TestCondition_Matches 测试正则在 normalize 时编译一次，未编译的条件不匹配
+func TestCondition_Matches(t *testing.T) {
+	cond := Condition{Attribute: AttributeUser, Operator: OperatorMatches, Values: []string{`^admin-`, `@example\.com$`}}
+	assert.False(t, cond.matches("admin-bob"))
+
+	require.NoError(t, cond.compile())
+	require.Len(t, cond.patterns, 2)
+	assert.True(t, cond.matches("admin-bob"))
+	assert.True(t, cond.matches("bob@example.com"))
+	assert.False(t, cond.matches("bob"))
+
+	cond.Operator = OperatorIn
+	require.NoError(t, cond.compile())
+	assert.Nil(t, cond.patterns)
+}
+
+// TestEvaluate_Rollout 测试按主体哈希的确定性百分比灰度
+func TestEvaluate_Rollout(t *testing.T) {
+	f := Flag{
+		Key:     "search.v2",
+		Enabled: true,
+		Rollout: []WeightedVariant{{VariantOn, 20}, {VariantOff, 80}},
+	}
+	require.NoError(t, normalize(&f))
+
+	on := map[string]bool{}
+	for i := 0; i < 2000; i++ {
+		ec := EvaluationContext{User: fmt.Sprintf("user-%d", i)}
+		result := evaluate(&f, ec)
+		assert.Equal(t, ReasonDefault, result.Reason)
+		// 同一主体多次评估结果一致
+		assert.Equal(t, result, evaluate(&f, ec))
+		if result.Value == true {
+			on[ec.User] = true
+		}
+	}
+	assert.InDelta(t, 400, len(on), 80, "about 20%% of subjects should be on")
+
+	// 扩大比例时已开启的主体保持开启
+	f.Rollout = []WeightedVariant{{VariantOn, 50}, {VariantOff, 50}}
+	for user := range on {
+		assert.Equal(t, true, evaluate(&f, EvaluationContext{User: user}).Value)
+	}
+
+	// 没有 user 时按 project 分桶
+	assert.Equal(t,
+		evaluate(&f, EvaluationContext{Project: "alpha"}),
+		evaluate(&f, EvaluationContext{Project: "alpha", Attributes: map[string]string{"plan": "pro"}}))
+}
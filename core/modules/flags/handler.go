@@ -0,0 +1,213 @@
+package flags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"apprun/modules/config"
+	"apprun/pkg/response"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler Feature Flag HTTP 处理器
+type Handler struct {
+	service *Service
+}
+
+// NewHandler 创建处理器实例
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes 注册路由到 chi.Router
+// 注意：此方法应在 /api 路由组内调用，会注册 /flags 子路由
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/flags", func(r chi.Router) {
+		r.Get("/", h.GetFlag)            // GET /api/flags?key=xxx
+		r.Put("/", h.SaveFlag)           // PUT /api/flags
+		r.Delete("/", h.DeleteFlag)      // DELETE /api/flags?key=xxx
+		r.Get("/list", h.ListFlags)      // GET /api/flags/list
+		r.Get("/history", h.ListHistory) // GET /api/flags/history?key=xxx
+		r.Post("/evaluate", h.Evaluate)  // POST /api/flags/evaluate
+	})
+}
+
+// changeContext 从请求中提取变更操作人（X-Actor 头），用于记录 Flag 变更历史
+func (h *Handler) changeContext(r *http.Request) context.Context {
+	return config.WithActor(r.Context(), r.Header.Get(config.ActorHeader))
+}
+
+// GetFlag 获取 Flag 定义
+// @Summary      Get feature flag
+// @Description  Get the definition of a feature flag by key
+// @Tags         flags
+// @Produce      json
+// @Param        key  query  string  true  "Flag key, e.g. checkout.new_flow"
+// @Success      200  {object}  Flag               "Flag definition"
+// @Failure      404  {object}  response.Response  "Flag not found"
+// @Failure      422  {object}  response.Response  "Missing key parameter"
+// @Router       /flags [get]
+func (h *Handler) GetFlag(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		response.ValidationErrorWithRequest(w, r, "key", "missing 'key' query parameter")
+		return
+	}
+
+	flag, ok := h.service.GetFlag(key)
+	if !ok {
+		response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, "feature flag not found: "+key)
+		return
+	}
+
+	response.SuccessWithRequest(w, r, flag)
+}
+
+// SaveFlag 创建或替换 Flag
+// @Summary      Create or replace feature flag
+// @Description  Create a feature flag or replace its whole definition. Flags without variants are boolean (on=true, off=false, default off).
+// @Description  Rules are checked in order, the first rule whose conditions all match serves its variant or rollout;
+// @Description  otherwise the flag-level rollout or default_variant is served. Rollout weights are percentages summing to 100,
+// @Description  subjects are bucketed by hashing the flag key with the user ID (project ID if no user).
+// @Description  The change is recorded in the flag history with the X-Actor header as actor.
+// @Tags         flags
+// @Accept       json
+// @Produce      json
+// @Param        X-Actor  header  string  false  "Who makes the change (recorded in history)"
+// @Param        request  body    Flag    true   "Flag definition (revision, updated_at and updated_by are ignored)"
+// @Success      200  {object}  Flag               "Saved flag"
+// @Failure      400  {object}  response.Response  "Invalid request body"
+// @Failure      422  {object}  response.Response  "Invalid flag definition"
+// @Router       /flags [put]
+func (h *Handler) SaveFlag(w http.ResponseWriter, r *http.Request) {
+	var req Flag
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "invalid request body: "+err.Error())
+		return
+	}
+
+	saved, err := h.service.SaveFlag(h.changeContext(r), req)
+	if err != nil {
+		if errors.Is(err, ErrInvalidFlag) {
+			response.ValidationErrorWithRequest(w, r, "flag", err.Error())
+			return
+		}
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeDatabaseError, "failed to save feature flag: "+err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, saved)
+}
+
+// DeleteFlag 删除 Flag
+// @Summary      Delete feature flag
+// @Description  Delete a feature flag; evaluations then report reason "not_found"
+// @Tags         flags
+// @Produce      json
+// @Param        key      query   string  true   "Flag key"
+// @Param        X-Actor  header  string  false  "Who makes the change (recorded in history)"
+// @Success      200  {object}  response.Response  "Flag deleted"
+// @Failure      404  {object}  response.Response  "Flag not found"
+// @Failure      422  {object}  response.Response  "Missing key parameter"
+// @Router       /flags [delete]
+func (h *Handler) DeleteFlag(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		response.ValidationErrorWithRequest(w, r, "key", "missing 'key' query parameter")
+		return
+	}
+
+	if err := h.service.DeleteFlag(h.changeContext(r), key); err != nil {
+		if IsNotFound(err) {
+			response.ErrorWithRequest(w, r, http.StatusNotFound, response.ErrCodeNotFound, err.Error())
+			return
+		}
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeDatabaseError, "failed to delete feature flag: "+err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, map[string]string{"key": key})
+}
+
+// ListFlags 列出全部 Flag
+// @Summary      List feature flags
+// @Description  List all feature flag definitions sorted by key
+// @Tags         flags
+// @Produce      json
+// @Success      200  {object}  ListFlagsResponse  "Flags"
+// @Router       /flags/list [get]
+func (h *Handler) ListFlags(w http.ResponseWriter, r *http.Request) {
+	flags := h.service.ListFlags()
+	response.SuccessWithRequest(w, r, ListFlagsResponse{
+		Flags: flags,
+		Count: len(flags),
+	})
+}
+
+// ListHistory 列出 Flag 变更历史
+// @Summary      List feature flag history
+// @Description  List changes of a feature flag, newest first, with the full definition before and after each change
+// @Tags         flags
+// @Produce      json
+// @Param        key    query  string  true   "Flag key"
+// @Param        limit  query  int     false  "Maximum number of changes (0 = all)"
+// @Success      200  {object}  ListHistoryResponse  "Flag history"
+// @Failure      422  {object}  response.Response    "Missing key or invalid limit"
+// @Router       /flags/history [get]
+func (h *Handler) ListHistory(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		response.ValidationErrorWithRequest(w, r, "key", "missing 'key' query parameter")
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			response.ValidationErrorWithRequest(w, r, "limit", "'limit' must be a non-negative integer")
+			return
+		}
+		limit = n
+	}
+
+	history, err := h.service.ListHistory(r.Context(), key, limit)
+	if err != nil {
+		response.ErrorWithRequest(w, r, http.StatusInternalServerError, response.ErrCodeDatabaseError, "failed to list feature flag history: "+err.Error())
+		return
+	}
+
+	response.SuccessWithRequest(w, r, ListHistoryResponse{
+		Key:     key,
+		History: history,
+		Count:   len(history),
+	})
+}
+
+// Evaluate 为主体评估 Flag
+// @Summary      Evaluate feature flags
+// @Description  Evaluate flags for a subject (user, project and custom attributes). Unknown flags are returned with reason "not_found" and a null value.
+// @Tags         flags
+// @Accept       json
+// @Produce      json
+// @Param        request  body  EvaluateRequest  true  "Flags and subject to evaluate"
+// @Success      200  {object}  EvaluateResponse   "Evaluations"
+// @Failure      400  {object}  response.Response  "Invalid request body"
+// @Router       /flags/evaluate [post]
+func (h *Handler) Evaluate(w http.ResponseWriter, r *http.Request) {
+	var req EvaluateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.ErrorWithRequest(w, r, http.StatusBadRequest, response.ErrCodeInvalidParam, "invalid request body: "+err.Error())
+		return
+	}
+
+	results := h.service.EvaluateAll(req.Keys, req.Context)
+	response.SuccessWithRequest(w, r, EvaluateResponse{
+		Flags: results,
+		Count: len(results),
+	})
+}
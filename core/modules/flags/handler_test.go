@@ -0,0 +1,100 @@
+package flags
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"apprun/modules/config"
+	"apprun/pkg/response"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeData 解析统一响应并将 data 解码到 target
+func decodeData(t *testing.T, w *httptest.ResponseRecorder, target interface{}) response.Response {
+	t.Helper()
+
+	var apiResp response.Response
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&apiResp))
+
+	if target != nil && apiResp.Data != nil {
+		dataBytes, err := json.Marshal(apiResp.Data)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(dataBytes, target))
+	}
+	return apiResp
+}
+
+// TestHandler_Flags 测试 Flag 管理与评估接口
+func TestHandler_Flags(t *testing.T) {
+	service, _ := newTestService(t)
+	r := chi.NewRouter()
+	NewHandler(service).RegisterRoutes(r)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set(config.ActorHeader, "alice")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	// 保存多变体 Flag：beta 项目命中规则，其余按 50% 灰度
+	body := `{
+		"key": "checkout.button",
+		"enabled": true,
+		"variants": {"blue": "#00f", "green": "#0f0"},
+		"default_variant": "blue",
+		"rules": [{"conditions": [{"attribute": "project", "operator": "in", "values": ["beta"]}], "variant": "green"}],
+		"rollout": [{"variant": "blue", "weight": 50}, {"variant": "green", "weight": 50}]
+	}`
+	w := do(http.MethodPut, "/flags", body)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var saved Flag
+	decodeData(t, w, &saved)
+	assert.Equal(t, 1, saved.Revision)
+	assert.Equal(t, "alice", saved.UpdatedBy)
+	assert.Equal(t, "blue", saved.OffVariant)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, do(http.MethodPut, "/flags", `{"key": "bad", "rollout": [{"variant": "on", "weight": 10}]}`).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPut, "/flags", `{`).Code)
+
+	var got Flag
+	decodeData(t, do(http.MethodGet, "/flags?key=checkout.button", ""), &got)
+	assert.Equal(t, "green", got.Rules[0].Variant)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, "/flags?key=missing", "").Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, do(http.MethodGet, "/flags", "").Code)
+
+	var list ListFlagsResponse
+	decodeData(t, do(http.MethodGet, "/flags/list", ""), &list)
+	assert.Equal(t, 1, list.Count)
+
+	var eval EvaluateResponse
+	w = do(http.MethodPost, "/flags/evaluate", `{"keys": ["checkout.button", "missing"], "context": {"user": "bob", "project": "beta"}}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	decodeData(t, w, &eval)
+	assert.Equal(t, 2, eval.Count)
+	assert.Equal(t, "#0f0", eval.Flags["checkout.button"].Value)
+	assert.Equal(t, ReasonRule, eval.Flags["checkout.button"].Reason)
+	assert.Equal(t, ReasonNotFound, eval.Flags["missing"].Reason)
+
+	// HTTP 与 Go API 的灰度结果一致
+	ec := EvaluationContext{User: "carol", Project: "gamma"}
+	decodeData(t, do(http.MethodPost, "/flags/evaluate", `{"context": {"user": "carol", "project": "gamma"}}`), &eval)
+	assert.Equal(t, service.Evaluate("checkout.button", ec).Variant, eval.Flags["checkout.button"].Variant)
+
+	require.Equal(t, http.StatusOK, do(http.MethodDelete, "/flags?key=checkout.button", "").Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodDelete, "/flags?key=checkout.button", "").Code)
+
+	var history ListHistoryResponse
+	decodeData(t, do(http.MethodGet, "/flags/history?key=checkout.button", ""), &history)
+	require.Equal(t, 2, history.Count)
+	assert.Equal(t, ChangeActionDelete, history.History[0].Action)
+	assert.Equal(t, "alice", history.History[0].Actor)
+	assert.Equal(t, http.StatusUnprocessableEntity, do(http.MethodGet, "/flags/history?key=x&limit=-1", "").Code)
+}
@@ -0,0 +1,269 @@
+package flags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"apprun/ent"
+	"apprun/ent/featureflag"
+	"apprun/ent/featureflaghistory"
+	"apprun/modules/config"
+	"apprun/pkg/database"
+	"apprun/pkg/logger"
+)
+
+// Repository 实现 Store 接口，提供数据库访问层
+// Flag 定义以 JSON 存储；历史记录保存变更前后的完整定义
+type Repository struct {
+	db     database.Client
+	client *ent.Client
+}
+
+// NewRepository 创建 Flag 仓储实例
+// 写操作通过 database.Client.Tx 在事务中执行
+func NewRepository(db database.Client) *Repository {
+	return &Repository{
+		db:     db,
+		client: db.GetEntClient(),
+	}
+}
+
+// ListFlags 列出全部 Flag（按键排序）
+func (r *Repository) ListFlags(ctx context.Context) ([]Flag, error) {
+	items, err := r.client.FeatureFlag.
+		Query().
+		Order(ent.Asc(featureflag.FieldKey)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+
+	result := make([]Flag, 0, len(items))
+	for _, item := range items {
+		f, err := toFlag(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *f)
+	}
+	return result, nil
+}
+
+// GetFlag 根据键获取 Flag
+func (r *Repository) GetFlag(ctx context.Context, key string) (*Flag, error) {
+	item, err := r.client.FeatureFlag.
+		Query().
+		Where(featureflag.KeyEQ(key)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrFlagNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to query feature flag: %w", err)
+	}
+	return toFlag(item)
+}
+
+// SaveFlag 创建或更新 Flag，并在同一事务中记录变更历史
+func (r *Repository) SaveFlag(ctx context.Context, flag Flag) (*Flag, error) {
+	var saved *Flag
+	err := r.db.Tx(ctx, func(tx *ent.Tx) error {
+		item, err := tx.FeatureFlag.
+			Query().
+			Where(featureflag.KeyEQ(flag.Key)).
+			Only(ctx)
+
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to check feature flag existence: %w", err)
+		}
+
+		flag.Revision = 1
+		if item != nil {
+			flag.Revision = item.Revision + 1
+		}
+		flag.UpdatedAt = time.Now()
+		flag.UpdatedBy = config.ActorFromContext(ctx)
+
+		definition, err := json.Marshal(flag)
+		if err != nil {
+			return fmt.Errorf("failed to encode feature flag: %w", err)
+		}
+		newDefinition := string(definition)
+
+		var oldDefinition *string
+		if item != nil {
+			oldDefinition = &item.Definition
+
+			// 带修订号条件更新，防止并发事务覆盖
+			updated, err := tx.FeatureFlag.
+				Update().
+				Where(featureflag.ID(item.ID), featureflag.RevisionEQ(item.Revision)).
+				SetDefinition(newDefinition).
+				SetRevision(flag.Revision).
+				SetUpdatedAt(flag.UpdatedAt).
+				SetUpdatedBy(flag.UpdatedBy).
+				Save(ctx)
+
+			if err != nil {
+				return fmt.Errorf("failed to update feature flag: %w", err)
+			}
+			if updated == 0 {
+				return fmt.Errorf("feature flag '%s' was modified concurrently", flag.Key)
+			}
+		} else {
+			_, err = tx.FeatureFlag.
+				Create().
+				SetKey(flag.Key).
+				SetDefinition(newDefinition).
+				SetRevision(flag.Revision).
+				SetUpdatedAt(flag.UpdatedAt).
+				SetUpdatedBy(flag.UpdatedBy).
+				Save(ctx)
+
+			if err != nil {
+				if ent.IsConstraintError(err) {
+					return fmt.Errorf("feature flag '%s' was created concurrently", flag.Key)
+				}
+				return fmt.Errorf("failed to create feature flag: %w", err)
+			}
+		}
+
+		saved = &flag
+		r.publishOnCommit(tx, flag.Key)
+		return r.recordChange(ctx, tx, flag.Key, oldDefinition, &newDefinition, ChangeActionSet)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// DeleteFlag 删除 Flag，并在同一事务中记录变更历史
+func (r *Repository) DeleteFlag(ctx context.Context, key string) error {
+	return r.db.Tx(ctx, func(tx *ent.Tx) error {
+		item, err := tx.FeatureFlag.
+			Query().
+			Where(featureflag.KeyEQ(key)).
+			Only(ctx)
+
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return fmt.Errorf("%w: %s", ErrFlagNotFound, key)
+			}
+			return fmt.Errorf("failed to query feature flag: %w", err)
+		}
+
+		if err := tx.FeatureFlag.DeleteOne(item).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete feature flag: %w", err)
+		}
+
+		r.publishOnCommit(tx, key)
+		return r.recordChange(ctx, tx, key, &item.Definition, nil, ChangeActionDelete)
+	})
+}
+
+// ListHistory 列出 Flag 的变更历史（按修订号倒序）
+func (r *Repository) ListHistory(ctx context.Context, key string, limit int) ([]FlagChange, error) {
+	query := r.client.FeatureFlagHistory.
+		Query().
+		Where(featureflaghistory.KeyEQ(key)).
+		Order(ent.Desc(featureflaghistory.FieldID))
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flag history: %w", err)
+	}
+
+	result := make([]FlagChange, 0, len(items))
+	for _, item := range items {
+		change := FlagChange{
+			Revision:  item.ID,
+			Key:       item.Key,
+			Action:    string(item.Action),
+			Actor:     item.Actor,
+			RequestID: item.RequestID,
+			CreatedAt: item.CreatedAt,
+		}
+		if change.Old, err = decodeDefinition(item.OldDefinition); err != nil {
+			return nil, err
+		}
+		if change.New, err = decodeDefinition(item.NewDefinition); err != nil {
+			return nil, err
+		}
+		result = append(result, change)
+	}
+	return result, nil
+}
+
+// recordChange 在事务内追加一条变更历史，操作人与请求 ID 取自 ctx
+func (r *Repository) recordChange(ctx context.Context, tx *ent.Tx, key string, oldDefinition, newDefinition *string, action string) error {
+	_, err := tx.FeatureFlagHistory.
+		Create().
+		SetKey(key).
+		SetNillableOldDefinition(oldDefinition).
+		SetNillableNewDefinition(newDefinition).
+		SetAction(featureflaghistory.Action(action)).
+		SetActor(config.ActorFromContext(ctx)).
+		SetRequestID(config.RequestIDFromContext(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to record feature flag history: %w", err)
+	}
+	return nil
+}
+
+// publishOnCommit 在事务成功提交后通过 NOTIFY 发布变更的 Flag 键（见 ChangeChannel）
+// 发布失败只记录日志：其他实例的定时重新加载会补上遗漏的通知
+func (r *Repository) publishOnCommit(tx *ent.Tx, key string) {
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+
+			if err := r.db.Notify(ctx, ChangeChannel, key); err != nil && !errors.Is(err, database.ErrNotifyUnsupported) {
+				logger.Warn("failed to publish feature flag change",
+					logger.Field{Key: "key", Value: key},
+					logger.Field{Key: "error", Value: err})
+			}
+			return nil
+		})
+	})
+}
+
+// toFlag 解码存储的定义，修订号与修改信息以列为准
+func toFlag(item *ent.FeatureFlag) (*Flag, error) {
+	f, err := decodeDefinition(&item.Definition)
+	if err != nil {
+		return nil, err
+	}
+	f.Key = item.Key
+	f.Revision = item.Revision
+	f.UpdatedAt = item.UpdatedAt
+	f.UpdatedBy = item.UpdatedBy
+	return f, nil
+}
+
+// decodeDefinition 解码 JSON 定义（nil 表示不存在）
+func decodeDefinition(definition *string) (*Flag, error) {
+	if definition == nil {
+		return nil, nil
+	}
+
+	var f Flag
+	if err := json.Unmarshal([]byte(*definition), &f); err != nil {
+		return nil, fmt.Errorf("failed to decode feature flag definition: %w", err)
+	}
+	return &f, nil
+}
@@ -0,0 +1,203 @@
+package flags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"apprun/modules/config"
+	"apprun/pkg/database"
+	"apprun/pkg/logger"
+)
+
+// Service Feature Flag 服务
+// Flag 定义缓存在内存中，评估不访问数据库；写操作经 Store 持久化并记录历史后替换缓存
+type Service struct {
+	store   Store
+	writeMu sync.Mutex                       // 串行化写操作，保证缓存与存储一致
+	flags   atomic.Pointer[map[string]*Flag] // 当前 Flag 定义（只替换，不修改）
+}
+
+// NewService 创建 Flag 服务实例，需调用 Load 加载已有 Flag
+func NewService(store Store) *Service {
+	s := &Service{store: store}
+	s.flags.Store(&map[string]*Flag{})
+	return s
+}
+
+// Load 从存储加载全部 Flag 并替换缓存
+// 存储中无法通过校验的 Flag（如手工修改的定义）被跳过并记录日志
+func (s *Service) Load(ctx context.Context) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	stored, err := s.store.ListFlags(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load feature flags: %w", err)
+	}
+
+	flags := make(map[string]*Flag, len(stored))
+	for i := range stored {
+		if err := normalize(&stored[i]); err != nil {
+			logger.Warn("skipping invalid feature flag",
+				logger.Field{Key: "key", Value: stored[i].Key},
+				logger.Field{Key: "error", Value: err})
+			continue
+		}
+		flags[stored[i].Key] = &stored[i]
+	}
+	s.flags.Store(&flags)
+	return nil
+}
+
+// SyncChanges 接收其他实例通过 ChangeChannel 发布的变更通知并重新加载全部 Flag
+// 每隔 interval 重新加载一次，监听连接重连后也会立即重新加载，补上断线期间遗漏的通知
+// listener 由调用方通过 database.Client.Listen(ChangeChannel) 创建，在 ctx 结束时关闭；
+// 为 nil 时只做定时重新加载（数据库不支持 LISTEN/NOTIFY）
+func (s *Service) SyncChanges(ctx context.Context, listener database.Listener, interval time.Duration) {
+	if interval <= 0 {
+		interval = config.DefaultSyncInterval
+	}
+	go s.runSync(ctx, listener, interval)
+}
+
+// runSync 事件循环：通知、重连与定时对账都归结为一次 Load
+func (s *Service) runSync(ctx context.Context, listener database.Listener, interval time.Duration) {
+	var notifications <-chan database.Notification // nil：不接收通知，只定时重新加载
+	if listener != nil {
+		notifications = listener.Notifications()
+		defer listener.Close()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-notifications:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+		}
+
+		if err := s.Load(ctx); err != nil {
+			logger.Error("feature flag sync failed", logger.Field{Key: "error", Value: err})
+		}
+	}
+}
+
+// ListFlags 返回全部 Flag（按键排序）
+func (s *Service) ListFlags() []Flag {
+	flags := *s.flags.Load()
+
+	result := make([]Flag, 0, len(flags))
+	for _, f := range flags {
+		result = append(result, *f)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// GetFlag 返回 Flag 定义，调用方不得修改其中的 map 与切片
+func (s *Service) GetFlag(key string) (Flag, bool) {
+	f, ok := (*s.flags.Load())[key]
+	if !ok {
+		return Flag{}, false
+	}
+	return *f, true
+}
+
+// SaveFlag 校验并保存 Flag（创建或整体替换），变更人取自 ctx（config.WithActor）
+func (s *Service) SaveFlag(ctx context.Context, flag Flag) (*Flag, error) {
+	if err := normalize(&flag); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFlag, err)
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	saved, err := s.store.SaveFlag(ctx, flag)
+	if err != nil {
+		return nil, err
+	}
+
+	s.replace(func(flags map[string]*Flag) { flags[saved.Key] = saved })
+	return saved, nil
+}
+
+// DeleteFlag 删除 Flag，不存在时返回 ErrFlagNotFound
+func (s *Service) DeleteFlag(ctx context.Context, key string) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if err := s.store.DeleteFlag(ctx, key); err != nil {
+		return err
+	}
+
+	s.replace(func(flags map[string]*Flag) { delete(flags, key) })
+	return nil
+}
+
+// replace 复制当前缓存、应用修改后整体替换（调用方需持有 writeMu）
+func (s *Service) replace(apply func(flags map[string]*Flag)) {
+	current := *s.flags.Load()
+	flags := make(map[string]*Flag, len(current)+1)
+	for k, v := range current {
+		flags[k] = v
+	}
+	apply(flags)
+	s.flags.Store(&flags)
+}
+
+// ListHistory 列出 Flag 的变更历史（按修订号倒序）
+func (s *Service) ListHistory(ctx context.Context, key string, limit int) ([]FlagChange, error) {
+	return s.store.ListHistory(ctx, key, limit)
+}
+
+// Evaluate 为主体评估 Flag；Flag 不存在时 Reason 为 not_found，Value 为 nil
+func (s *Service) Evaluate(key string, ec EvaluationContext) Evaluation {
+	f, ok := (*s.flags.Load())[key]
+	if !ok {
+		return Evaluation{Key: key, Reason: ReasonNotFound}
+	}
+	return evaluate(f, ec)
+}
+
+// EvaluateAll 为主体评估多个 Flag，keys 为空时评估全部 Flag
+func (s *Service) EvaluateAll(keys []string, ec EvaluationContext) map[string]Evaluation {
+	flags := *s.flags.Load()
+	if len(keys) == 0 {
+		keys = make([]string, 0, len(flags))
+		for key := range flags {
+			keys = append(keys, key)
+		}
+	}
+
+	result := make(map[string]Evaluation, len(keys))
+	for _, key := range keys {
+		if f, ok := flags[key]; ok {
+			result[key] = evaluate(f, ec)
+		} else {
+			result[key] = Evaluation{Key: key, Reason: ReasonNotFound}
+		}
+	}
+	return result
+}
+
+// Enabled 评估布尔 Flag：仅当返回值为 true 时为 true（不存在或非布尔值视为 false）
+func (s *Service) Enabled(key string, ec EvaluationContext) bool {
+	on, _ := s.Evaluate(key, ec).Value.(bool)
+	return on
+}
+
+// IsNotFound 判断错误是否表示 Flag 不存在
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrFlagNotFound)
+}
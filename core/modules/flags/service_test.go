@@ -0,0 +1,145 @@
+package flags
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"apprun/modules/config"
+	"apprun/pkg/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestService 创建使用模拟存储的 Flag 服务
+func newTestService(t *testing.T) (*Service, *mockStore) {
+	t.Helper()
+
+	store := newMockStore()
+	service := NewService(store)
+	require.NoError(t, service.Load(context.Background()))
+	return service, store
+}
+
+// TestService_SaveAndEvaluate 测试保存、评估与变更历史
+func TestService_SaveAndEvaluate(t *testing.T) {
+	service, store := newTestService(t)
+	ctx := config.WithActor(context.Background(), "alice")
+	ec := EvaluationContext{User: "bob", Project: "alpha"}
+
+	assert.Equal(t, ReasonNotFound, service.Evaluate("checkout.new_flow", ec).Reason)
+	assert.False(t, service.Enabled("checkout.new_flow", ec))
+
+	saved, err := service.SaveFlag(ctx, Flag{Key: "checkout.new_flow", Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, saved.Revision)
+	assert.Equal(t, "alice", saved.UpdatedBy)
+	assert.False(t, service.Enabled("checkout.new_flow", ec))
+
+	_, err = service.SaveFlag(config.WithActor(context.Background(), "carol"), Flag{
+		Key:     "checkout.new_flow",
+		Enabled: true,
+		Rules:   []Rule{{Conditions: []Condition{{Attribute: AttributeProject, Operator: OperatorIn, Values: []string{"alpha"}}}, Variant: VariantOn}},
+	})
+	require.NoError(t, err)
+	assert.True(t, service.Enabled("checkout.new_flow", ec))
+	assert.False(t, service.Enabled("checkout.new_flow", EvaluationContext{Project: "beta"}))
+
+	flag, ok := service.GetFlag("checkout.new_flow")
+	require.True(t, ok)
+	assert.Equal(t, 2, flag.Revision)
+	assert.Equal(t, "carol", flag.UpdatedBy)
+
+	// 非法定义不写入存储
+	_, err = service.SaveFlag(ctx, Flag{Key: "checkout.new_flow", DefaultVariant: "maybe"})
+	assert.ErrorIs(t, err, ErrInvalidFlag)
+	assert.Len(t, store.history, 2)
+
+	history, err := service.ListHistory(ctx, "checkout.new_flow", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "carol", history[0].Actor)
+	assert.Equal(t, 1, history[0].Old.Revision)
+	assert.Len(t, history[0].New.Rules, 1)
+	assert.Nil(t, history[1].Old)
+	assert.Equal(t, "alice", history[1].Actor)
+
+	// 删除后评估为 not_found
+	require.NoError(t, service.DeleteFlag(ctx, "checkout.new_flow"))
+	assert.Equal(t, ReasonNotFound, service.Evaluate("checkout.new_flow", ec).Reason)
+	assert.True(t, IsNotFound(service.DeleteFlag(ctx, "checkout.new_flow")))
+
+	history, err = service.ListHistory(ctx, "checkout.new_flow", 1)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, ChangeActionDelete, history[0].Action)
+	assert.Nil(t, history[0].New)
+}
+
+// TestService_LoadAndEvaluateAll 测试从存储加载与批量评估
+func TestService_LoadAndEvaluateAll(t *testing.T) {
+	store := newMockStore()
+	ctx := context.Background()
+	_, err := store.SaveFlag(ctx, Flag{Key: "a", Enabled: true, Variants: map[string]interface{}{"v": 1}, DefaultVariant: "v", OffVariant: "v"})
+	require.NoError(t, err)
+	_, err = store.SaveFlag(ctx, Flag{Key: "b", Variants: map[string]interface{}{VariantOn: true, VariantOff: false}, DefaultVariant: VariantOn, OffVariant: VariantOff})
+	require.NoError(t, err)
+
+	service := NewService(store)
+	assert.Empty(t, service.ListFlags())
+	require.NoError(t, service.Load(ctx))
+
+	flags := service.ListFlags()
+	require.Len(t, flags, 2)
+	assert.Equal(t, "a", flags[0].Key)
+
+	all := service.EvaluateAll(nil, EvaluationContext{})
+	require.Len(t, all, 2)
+	assert.Equal(t, float64(1), toFloat(all["a"].Value))
+	assert.Equal(t, ReasonDisabled, all["b"].Reason)
+
+	some := service.EvaluateAll([]string{"a", "missing"}, EvaluationContext{})
+	require.Len(t, some, 2)
+	assert.Equal(t, ReasonNotFound, some["missing"].Reason)
+	assert.Nil(t, some["missing"].Value)
+}
+
+// TestService_SyncChanges 测试其他实例写入的 Flag：收到通知或定时重新加载后生效
+func TestService_SyncChanges(t *testing.T) {
+	service, store := newTestService(t)
+	other := NewService(store) // 共享存储的另一个实例
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener := newFakeListener()
+	service.SyncChanges(ctx, listener, time.Hour)
+
+	_, err := other.SaveFlag(ctx, Flag{Key: "checkout.new_flow", Enabled: true, Rollout: []WeightedVariant{{VariantOn, 100}}})
+	require.NoError(t, err)
+	assert.False(t, service.Enabled("checkout.new_flow", EvaluationContext{User: "bob"}))
+
+	listener.ch <- database.Notification{Channel: ChangeChannel, Payload: "checkout.new_flow"}
+	require.Eventually(t, func() bool {
+		return service.Enabled("checkout.new_flow", EvaluationContext{User: "bob"})
+	}, time.Second, 10*time.Millisecond)
+
+	// 没有通知时按周期重新加载
+	polling := NewService(store)
+	polling.SyncChanges(ctx, nil, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		_, ok := polling.GetFlag("checkout.new_flow")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+// toFloat 将整数或浮点数统一为 float64 便于比较
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return -1
+}
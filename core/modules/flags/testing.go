@@ -0,0 +1,125 @@
+package flags
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"apprun/modules/config"
+	"apprun/pkg/database"
+)
+
+// mockStore 模拟 Flag 存储（测试辅助，共享给所有测试文件）
+type mockStore struct {
+	mu      sync.Mutex
+	flags   map[string]Flag
+	history []FlagChange
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{flags: make(map[string]Flag)}
+}
+
+func (m *mockStore) ListFlags(ctx context.Context) ([]Flag, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]Flag, 0, len(m.flags))
+	for _, f := range m.flags {
+		result = append(result, f)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result, nil
+}
+
+func (m *mockStore) GetFlag(ctx context.Context, key string) (*Flag, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, ok := m.flags[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrFlagNotFound, key)
+	}
+	return &f, nil
+}
+
+func (m *mockStore) SaveFlag(ctx context.Context, flag Flag) (*Flag, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var old *Flag
+	flag.Revision = 1
+	if current, ok := m.flags[flag.Key]; ok {
+		old = &current
+		flag.Revision = current.Revision + 1
+	}
+	flag.UpdatedAt = time.Now()
+	flag.UpdatedBy = config.ActorFromContext(ctx)
+
+	m.flags[flag.Key] = flag
+	m.recordChange(ctx, flag.Key, old, &flag, ChangeActionSet)
+	return &flag, nil
+}
+
+func (m *mockStore) DeleteFlag(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.flags[key]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrFlagNotFound, key)
+	}
+	delete(m.flags, key)
+	m.recordChange(ctx, key, &current, nil, ChangeActionDelete)
+	return nil
+}
+
+func (m *mockStore) ListHistory(ctx context.Context, key string, limit int) ([]FlagChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []FlagChange
+	for i := len(m.history) - 1; i >= 0; i-- {
+		if m.history[i].Key != key {
+			continue
+		}
+		result = append(result, m.history[i])
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// recordChange 追加一条历史记录（调用方需持有 m.mu）
+func (m *mockStore) recordChange(ctx context.Context, key string, old, new *Flag, action string) {
+	m.history = append(m.history, FlagChange{
+		Revision:  len(m.history) + 1,
+		Key:       key,
+		Old:       old,
+		New:       new,
+		Action:    action,
+		Actor:     config.ActorFromContext(ctx),
+		RequestID: config.RequestIDFromContext(ctx),
+		CreatedAt: time.Now(),
+	})
+}
+
+// fakeListener 模拟 database.Listener（测试辅助）
+type fakeListener struct {
+	ch chan database.Notification
+}
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{ch: make(chan database.Notification, 8)}
+}
+
+func (l *fakeListener) Notifications() <-chan database.Notification {
+	return l.ch
+}
+
+func (l *fakeListener) Close() error {
+	return nil
+}
@@ -0,0 +1,166 @@
+package flags
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+)
+
+// Store 定义 Flag 持久化接口
+// 实现反腐层模式，隔离 Ent 模型；写操作需在同一事务中记录变更历史
+type Store interface {
+	// ListFlags 列出全部 Flag（按键排序）
+	ListFlags(ctx context.Context) ([]Flag, error)
+
+	// GetFlag 根据键获取 Flag，不存在时返回 ErrFlagNotFound
+	GetFlag(ctx context.Context, key string) (*Flag, error)
+
+	// SaveFlag 创建或更新 Flag，返回写入后的 Flag（含修订号、修改人与时间）
+	SaveFlag(ctx context.Context, flag Flag) (*Flag, error)
+
+	// DeleteFlag 删除 Flag，不存在时返回 ErrFlagNotFound
+	DeleteFlag(ctx context.Context, key string) error
+
+	// ListHistory 列出 Flag 的变更历史（按修订号倒序，limit <= 0 表示不限制）
+	ListHistory(ctx context.Context, key string, limit int) ([]FlagChange, error)
+}
+
+// ChangeChannel is the Postgres NOTIFY channel announcing committed flag changes
+// (payload: the flag key); instances reload their flag cache when notified
+const ChangeChannel = "apprun_flag_changes"
+
+// Flag 服务错误
+var (
+	ErrFlagNotFound = errors.New("feature flag not found") // Flag 不存在
+	ErrInvalidFlag  = errors.New("invalid feature flag")   // Flag 定义校验失败
+)
+
+// Built-in variants of boolean flags (flags defined without variants)
+const (
+	VariantOn  = "on"
+	VariantOff = "off"
+)
+
+// Condition operators
+const (
+	OperatorIn         = "in"
+	OperatorNotIn      = "not_in"
+	OperatorStartsWith = "starts_with"
+	OperatorEndsWith   = "ends_with"
+	OperatorContains   = "contains"
+	OperatorMatches    = "matches" // regular expression
+)
+
+// Built-in subject attributes usable in conditions
+const (
+	AttributeUser    = "user"
+	AttributeProject = "project"
+)
+
+// Evaluation reasons
+const (
+	ReasonDisabled = "disabled"  // flag is switched off, off_variant served
+	ReasonRule     = "rule"      // a targeting rule matched
+	ReasonDefault  = "default"   // no rule matched, default_variant or rollout served
+	ReasonNotFound = "not_found" // flag does not exist
+)
+
+// Change actions recorded in flag history
+const (
+	ChangeActionSet    = "set"
+	ChangeActionDelete = "delete"
+)
+
+// Flag Feature Flag 定义
+// 未定义变体时为布尔 Flag：变体 on=true、off=false，默认变体为 off
+type Flag struct {
+	Key            string                 `json:"key" example:"checkout.new_flow"`                   // Flag key
+	Description    string                 `json:"description,omitempty" example:"New checkout flow"` // Human readable description
+	Enabled        bool                   `json:"enabled" example:"true"`                            // When false every subject gets off_variant
+	Variants       map[string]interface{} `json:"variants,omitempty"`                                // Variant name to JSON value
+	DefaultVariant string                 `json:"default_variant,omitempty" example:"off"`           // Served when no rule matches and there is no rollout
+	OffVariant     string                 `json:"off_variant,omitempty" example:"off"`               // Served when the flag is disabled
+	Rules          []Rule                 `json:"rules,omitempty"`                                   // Targeting rules, first match wins
+	Rollout        []WeightedVariant      `json:"rollout,omitempty"`                                 // Percentage rollout when no rule matches
+	Revision       int                    `json:"revision" example:"3"`                              // Stored revision (read-only)
+	UpdatedAt      time.Time              `json:"updated_at" example:"2025-12-31T10:00:00+08:00"`    // Last change time (read-only)
+	UpdatedBy      string                 `json:"updated_by" example:"admin"`                        // Last change actor (read-only)
+}
+
+// Rule 定向规则：全部条件满足时命中，返回 Variant 或按 Rollout 灰度
+type Rule struct {
+	Description string            `json:"description,omitempty" example:"Beta projects"`
+	Conditions  []Condition       `json:"conditions"`                     // All conditions must match
+	Variant     string            `json:"variant,omitempty" example:"on"` // Variant served on match (exclusive with rollout)
+	Rollout     []WeightedVariant `json:"rollout,omitempty"`              // Percentage rollout on match (exclusive with variant)
+}
+
+// Condition 对主体属性的匹配条件
+type Condition struct {
+	Attribute string   `json:"attribute" example:"project"` // "user", "project" or a custom attribute name
+	Operator  string   `json:"operator" example:"in"`       // in, not_in, starts_with, ends_with, contains, matches
+	Values    []string `json:"values" example:"alpha,beta"` // Values compared against the attribute
+
+	patterns []*regexp.Regexp // Compiled values of a matches condition (set by normalize)
+}
+
+// WeightedVariant 灰度分配：Weight 为百分比，同一组权重之和必须为 100
+type WeightedVariant struct {
+	Variant string `json:"variant" example:"on"`
+	Weight  int    `json:"weight" example:"20"`
+}
+
+// EvaluationContext 评估主体：灰度按 User（为空时按 Project）哈希，保证同一主体结果稳定
+type EvaluationContext struct {
+	User       string            `json:"user,omitempty" example:"bob"`
+	Project    string            `json:"project,omitempty" example:"alpha"`
+	Attributes map[string]string `json:"attributes,omitempty"` // Custom attributes, e.g. {"plan": "pro"}
+}
+
+// Evaluation 单个 Flag 的评估结果
+type Evaluation struct {
+	Key     string      `json:"key" example:"checkout.new_flow"`
+	Value   interface{} `json:"value" swaggertype:"object"`     // Value of the served variant (null if not found)
+	Variant string      `json:"variant,omitempty" example:"on"` // Served variant
+	Reason  string      `json:"reason" example:"rule"`          // disabled, rule, default or not_found
+	Rule    int         `json:"rule,omitempty" example:"1"`     // 1-based index of the matched rule (reason "rule")
+}
+
+// FlagChange Flag 变更历史记录
+// Old/New 为 nil 表示变更前/后该 Flag 不存在
+type FlagChange struct {
+	Revision  int       `json:"revision" example:"7"`
+	Key       string    `json:"key" example:"checkout.new_flow"`
+	Old       *Flag     `json:"old"`
+	New       *Flag     `json:"new"`
+	Action    string    `json:"action" example:"set"` // set or delete
+	Actor     string    `json:"actor" example:"admin"`
+	RequestID string    `json:"request_id,omitempty" example:"host/abc-000001"`
+	CreatedAt time.Time `json:"created_at" example:"2025-12-31T10:00:00+08:00"`
+}
+
+// ListFlagsResponse GET /api/flags/list 响应
+type ListFlagsResponse struct {
+	Flags []Flag `json:"flags"`
+	Count int    `json:"count" example:"2"`
+}
+
+// ListHistoryResponse GET /api/flags/history 响应
+type ListHistoryResponse struct {
+	Key     string       `json:"key" example:"checkout.new_flow"`
+	History []FlagChange `json:"history"` // Changes, newest first
+	Count   int          `json:"count" example:"2"`
+}
+
+// EvaluateRequest POST /api/flags/evaluate 请求体
+type EvaluateRequest struct {
+	Keys    []string          `json:"keys,omitempty" example:"checkout.new_flow"` // Flags to evaluate (all flags if empty)
+	Context EvaluationContext `json:"context"`                                    // Subject to evaluate for
+}
+
+// EvaluateResponse POST /api/flags/evaluate 响应
+type EvaluateResponse struct {
+	Flags map[string]Evaluation `json:"flags"` // Flag key to evaluation
+	Count int                   `json:"count" example:"1"`
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 
 	"apprun/ent"
 )
@@ -20,11 +21,21 @@ type Client interface {
 
 	// GetEntClient returns the underlying Ent client (use sparingly)
 	GetEntClient() *ent.Client
+
+	// Notify publishes payload on a LISTEN/NOTIFY channel (postgres only)
+	Notify(ctx context.Context, channel, payload string) error
+
+	// Listen opens a dedicated connection listening on channel (postgres only)
+	// The connection is re-established automatically after it is lost
+	Listen(channel string) (Listener, error)
 }
 
 // entClient is the concrete implementation of Client interface
 type entClient struct {
 	client *ent.Client
+	db     *sql.DB // same pool as client, used for statements Ent does not model
+	driver string
+	dsn    string // connection string for dedicated listener connections
 }
 
 // Close closes the database connection
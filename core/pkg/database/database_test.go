@@ -66,5 +66,19 @@ func TestConnect_InvalidConfig(t *testing.T) {
 	assert.Error(t, err)
 	// Error can be either from connection or schema creation
 	assert.True(t, err != nil)
+}
+
+func TestOpen_InvalidConfig(t *testing.T) {
+	cfg := &Config{
+		Driver:   "postgres",
+		Host:     "invalid-host-that-does-not-exist",
+		Port:     5432,
+		User:     "postgres",
+		Password: "password",
+		DBName:   "testdb",
+	}
+
+	_, err := Open(context.Background(), cfg)
+	assert.ErrorContains(t, err, "failed to connect to database")
 } // Note: TestConnect_Success and TestClient_Ping require a real database connection
 // These should be run as integration tests with a test database
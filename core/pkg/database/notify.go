@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ErrNotifyUnsupported is returned by Notify and Listen for drivers without LISTEN/NOTIFY
+var ErrNotifyUnsupported = errors.New("LISTEN/NOTIFY requires the postgres driver")
+
+// Reconnect backoff of listener connections
+const (
+	listenerMinReconnect = 1 * time.Second
+	listenerMaxReconnect = 30 * time.Second
+)
+
+// Notification is a message received on a LISTEN channel
+// A notification with an empty Channel is delivered after the listener
+// reconnects: messages published while it was disconnected are lost,
+// so receivers should resynchronize from the database
+type Notification struct {
+	Channel string
+	Payload string
+}
+
+// Listener delivers the notifications of the channel it listens on
+type Listener interface {
+	// Notifications returns the delivery channel, closed after Close
+	Notifications() <-chan Notification
+
+	// Close stops listening and closes the dedicated connection
+	Close() error
+}
+
+// Notify publishes payload on channel
+// Listeners receive it once the statement is committed
+func (c *entClient) Notify(ctx context.Context, channel, payload string) error {
+	if c.driver != "postgres" {
+		return ErrNotifyUnsupported
+	}
+
+	if _, err := c.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", channel, payload); err != nil {
+		return fmt.Errorf("failed to notify %s: %w", channel, err)
+	}
+	return nil
+}
+
+// Listen opens a dedicated connection listening on channel
+func (c *entClient) Listen(channel string) (Listener, error) {
+	if c.driver != "postgres" {
+		return nil, ErrNotifyUnsupported
+	}
+
+	l := pq.NewListener(c.dsn, listenerMinReconnect, listenerMaxReconnect, nil)
+	if err := l.Listen(channel); err != nil {
+		l.Close()
+		return nil, fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	listener := &pqListener{
+		listener:      l,
+		notifications: make(chan Notification),
+		done:          make(chan struct{}),
+	}
+	go listener.forward()
+	return listener, nil
+}
+
+// pqListener adapts pq.Listener to Listener
+type pqListener struct {
+	listener      *pq.Listener
+	notifications chan Notification
+	done          chan struct{}
+	closeOnce     sync.Once
+}
+
+func (l *pqListener) Notifications() <-chan Notification {
+	return l.notifications
+}
+
+func (l *pqListener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.done)
+		err = l.listener.Close()
+	})
+	return err
+}
+
+// forward converts pq notifications until the listener is closed
+// pq sends nil after a reconnect, which becomes a Notification with an empty Channel
+func (l *pqListener) forward() {
+	defer close(l.notifications)
+
+	for n := range l.listener.Notify {
+		var notification Notification
+		if n != nil {
+			notification = Notification{Channel: n.Channel, Payload: n.Extra}
+		}
+
+		select {
+		case l.notifications <- notification:
+		case <-l.done:
+			// Drain so pq's dispatcher is not blocked while shutting down
+			for range l.listener.Notify {
+			}
+			return
+		}
+	}
+}
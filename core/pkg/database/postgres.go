@@ -5,13 +5,49 @@ import (
 	"fmt"
 
 	"apprun/ent"
+	"apprun/ent/migrate"
 
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 )
 
 // Connect establishes a database connection using the provided configuration
 // It also runs schema migration automatically
 func Connect(ctx context.Context, cfg *Config) (Client, error) {
+	c, err := open(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run schema migration
+	// Indexes removed from the schema are dropped, e.g. the former unique index
+	// on configitems.key, now unique per (key, scope)
+	if err := c.client.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return c, nil
+}
+
+// Open establishes a database connection without migrating the schema
+// Used by tools that work on a database managed by the server, e.g. apprunctl --offline
+func Open(ctx context.Context, cfg *Config) (Client, error) {
+	c, err := open(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.db.PingContext(ctx); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return c, nil
+}
+
+// open creates the client; the connection is established lazily by the first query
+func open(cfg *Config) (*entClient, error) {
 	if cfg == nil {
 		cfg = DefaultConfig()
 	}
@@ -20,17 +56,12 @@ func Connect(ctx context.Context, cfg *Config) (Client, error) {
 	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
 		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName)
 
-	// Open connection
-	client, err := ent.Open(cfg.Driver, dsn)
+	// Open connection (the sql.DB is kept for NOTIFY, which Ent does not expose)
+	drv, err := entsql.Open(cfg.Driver, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
+	client := ent.NewClient(ent.Driver(drv))
 
-	// Run schema migration
-	if err := client.Schema.Create(ctx); err != nil {
-		client.Close()
-		return nil, fmt.Errorf("failed to create schema: %w", err)
-	}
-
-	return &entClient{client: client}, nil
+	return &entClient{client: client, db: drv.DB(), driver: cfg.Driver, dsn: dsn}, nil
 }
@@ -11,6 +11,7 @@ import (
 )
 
 // LoadConfigToEnv loads server and database configuration from default.yaml
+// (merged with default.<APP_ENV>.yaml when APP_ENV is set)
 // and sets them as environment variables (only if not already set).
 // This allows configuration files to provide defaults while respecting
 // existing environment variables.
@@ -22,8 +23,13 @@ import (
 //
 // Priority order (highest to lowest):
 // 1. Existing environment variables (runtime export, docker -e, .env file)
-// 2. Configuration file (default.yaml)
+// 2. Configuration file (default.<APP_ENV>.yaml, then default.yaml)
 // 3. Code defaults (in DefaultConfig() functions)
+//
+// Deprecated: the server loads server and database config with
+// config.Bootstrap.LoadInfraConfig, which reads the same files and env vars
+// through the config center loader and validates them. Exporting file values
+// to the environment would let them override conf_d and database layers.
 func LoadConfigToEnv(configDir string) error {
 	configFile := filepath.Join(configDir, "default.yaml")
 
@@ -39,6 +45,17 @@ func LoadConfigToEnv(configDir string) error {
 		return fmt.Errorf("failed to read config file %s: %w", configFile, err)
 	}
 
+	// Merge the profile file (default.<APP_ENV>.yaml) on top, if present
+	if profile := strings.TrimSpace(os.Getenv("APP_ENV")); profile != "" {
+		profileFile := filepath.Join(configDir, "default."+profile+".yaml")
+		if _, err := os.Stat(profileFile); err == nil {
+			viper.SetConfigFile(profileFile)
+			if err := viper.MergeInConfig(); err != nil {
+				return fmt.Errorf("failed to read config file %s: %w", profileFile, err)
+			}
+		}
+	}
+
 	// Load server configuration dynamically
 	// Converts: server.http_port → SERVER_HTTP_PORT
 	if err := loadSectionToEnv("server", viper.Sub("server")); err != nil {
@@ -96,6 +96,33 @@ database:
 	clearTestEnvVars()
 }
 
+func TestLoadConfigToEnv_Profile(t *testing.T) {
+	tempDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tempDir, "default.yaml"), []byte(`
+database:
+  host: localhost
+  port: 5432
+`), 0644)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tempDir, "default.staging.yaml"), []byte(`
+database:
+  host: staging-db
+`), 0644)
+	assert.NoError(t, err)
+
+	clearTestEnvVars()
+	t.Setenv("APP_ENV", "staging")
+
+	err = LoadConfigToEnv(tempDir)
+	assert.NoError(t, err)
+
+	// Profile file overrides default.yaml, other keys are kept
+	assert.Equal(t, "staging-db", os.Getenv("DATABASE_HOST"))
+	assert.Equal(t, "5432", os.Getenv("DATABASE_PORT"))
+
+	clearTestEnvVars()
+}
+
 func TestLoadConfigToEnv_FileNotExists(t *testing.T) {
 	// Use non-existent directory
 	err := LoadConfigToEnv("/non/existent/dir")
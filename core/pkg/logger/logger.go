@@ -4,6 +4,7 @@ package logger
 
 import (
 	"context"
+	"sync/atomic"
 )
 
 // Logger defines the unified logging interface
@@ -70,45 +71,58 @@ type OutputConfig struct {
 	// - "stdout": standard output
 	// - "stderr": standard error
 	// - "file:/path/to/file.log": file path
-	Targets []string `yaml:"targets" default:"stdout" db:"true" validate:"min=1,dive,oneof=stdout stderr file"`
+	Targets []string `yaml:"targets" default:"stdout" db:"true" validate:"min=1,dive,oneof=stdout stderr|startswith=file:"`
 }
 
-// Global logger instance
-var defaultLogger Logger = &NopLogger{}
+// loggerRef boxes the global logger so it can be swapped atomically
+type loggerRef struct {
+	Logger
+}
+
+// Global logger instance, replaced at runtime (e.g. on logger config reload)
+// while other goroutines are logging
+var defaultLogger atomic.Pointer[loggerRef]
+
+func init() {
+	defaultLogger.Store(&loggerRef{Logger: &NopLogger{}})
+}
 
 // SetLogger sets the global logger instance
+// It is safe to call concurrently with logging. The previous logger is not
+// closed: goroutines may still be writing through it, so the caller decides
+// when it can be released
 func SetLogger(l Logger) {
 	if l != nil {
-		defaultLogger = l
+		defaultLogger.Store(&loggerRef{Logger: l})
 	}
 }
 
 // L returns the current global logger instance
 func L() Logger {
-	return defaultLogger
+	return defaultLogger.Load().Logger
 }
 
 // Debug logs a debug-level message using the global logger
 func Debug(msg string, fields ...Field) {
-	defaultLogger.Debug(msg, fields...)
+	L().Debug(msg, fields...)
 }
 
 // Info logs an info-level message using the global logger
 func Info(msg string, fields ...Field) {
-	defaultLogger.Info(msg, fields...)
+	L().Info(msg, fields...)
 }
 
 // Warn logs a warning-level message using the global logger
 func Warn(msg string, fields ...Field) {
-	defaultLogger.Warn(msg, fields...)
+	L().Warn(msg, fields...)
 }
 
 // Error logs an error-level message using the global logger
 func Error(msg string, fields ...Field) {
-	defaultLogger.Error(msg, fields...)
+	L().Error(msg, fields...)
 }
 
 // Fatal logs a fatal-level message using the global logger and exits
 func Fatal(msg string, fields ...Field) {
-	defaultLogger.Fatal(msg, fields...)
+	L().Fatal(msg, fields...)
 }
@@ -2,6 +2,7 @@ package logger
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/go-chi/chi/v5/middleware"
@@ -128,3 +129,31 @@ func TestWithContext_RequestID(t *testing.T) {
 		t.Error("WithContext should return non-nil logger")
 	}
 }
+
+// TestSetLogger_Concurrent tests swapping the global logger while other goroutines log (run with -race)
+func TestSetLogger_Concurrent(t *testing.T) {
+	defer SetLogger(&NopLogger{})
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					Info("concurrent", Field{Key: "n", Value: i})
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 100; i++ {
+		SetLogger(&NopLogger{})
+	}
+	close(done)
+	wg.Wait()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
@@ -17,6 +18,55 @@ type zapLogger struct {
 	closers []func() error
 }
 
+// sharedFile is a log file opened by one or more loggers
+type sharedFile struct {
+	file *os.File
+	refs int
+}
+
+// openFiles shares log file handles between loggers by path: a logger rebuilt
+// on config reload keeps writing to the handle the previous logger still uses,
+// and the file is only closed when the last logger using it is closed
+var openFiles = struct {
+	sync.Mutex
+	byPath map[string]*sharedFile
+}{byPath: make(map[string]*sharedFile)}
+
+// acquireFile opens path for appending, or shares the handle already open,
+// and returns a release function that closes it after its last user
+func acquireFile(path string) (*os.File, func() error, error) {
+	openFiles.Lock()
+	defer openFiles.Unlock()
+
+	shared, ok := openFiles.byPath[path]
+	if !ok {
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, nil, err
+		}
+		shared = &sharedFile{file: file}
+		openFiles.byPath[path] = shared
+	}
+	shared.refs++
+
+	var once sync.Once
+	release := func() error {
+		var err error
+		once.Do(func() {
+			openFiles.Lock()
+			defer openFiles.Unlock()
+
+			shared.refs--
+			if shared.refs == 0 {
+				delete(openFiles.byPath, path)
+				err = shared.file.Close()
+			}
+		})
+		return err
+	}
+	return shared.file, release, nil
+}
+
 // validateConfig validates logger configuration
 func validateConfig(cfg Config) error {
 	// Validate level - all levels are accepted, invalid ones degrade to Info
@@ -123,12 +173,15 @@ func parseOutputTargets(targets []string) ([]zapcore.WriteSyncer, []func() error
 			syncers = append(syncers, zapcore.AddSync(os.Stderr))
 		case strings.HasPrefix(target, "file:"):
 			filePath := strings.TrimPrefix(target, "file:")
-			file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+			file, release, err := acquireFile(filePath)
 			if err != nil {
+				for _, closer := range closers {
+					_ = closer()
+				}
 				return nil, nil, fmt.Errorf("failed to open log file %s: %w", filePath, err)
 			}
 			syncers = append(syncers, zapcore.AddSync(file))
-			closers = append(closers, file.Close)
+			closers = append(closers, release)
 		default:
 			return nil, nil, fmt.Errorf("unsupported output target: %s", target)
 		}
@@ -197,6 +250,7 @@ func (z *zapLogger) WithContext(ctx context.Context) Logger {
 }
 
 // Close closes the logger and releases all resources
+// Log files shared with other loggers stay open until their last user is closed
 func (z *zapLogger) Close() error {
 	// Sync zap logger first (ignore common errors for stdout/stderr)
 	_ = z.logger.Sync()
@@ -341,3 +341,42 @@ func TestNewZapLogger_PartialMultiTargetFailure(t *testing.T) {
 		t.Errorf("Expected file open error, got: %v", err)
 	}
 }
+
+// TestZapLogger_SharedFile tests that loggers writing to the same file share the handle:
+// closing the replaced logger keeps the file open for the new one
+func TestZapLogger_SharedFile(t *testing.T) {
+	tmpFile := t.TempDir() + "/shared.log"
+	cfg := Config{Level: LevelInfo, Output: OutputConfig{Targets: []string{"file:" + tmpFile}}}
+
+	oldLog, err := NewZapLogger(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	cfg.Level = LevelDebug
+	newLog, err := NewZapLogger(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	if err := oldLog.Close(); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+	// Closing twice must not release the handle held by the new logger
+	_ = oldLog.Close()
+
+	newLog.Debug("after reload")
+	if err := newLog.Close(); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+
+	data, err := os.ReadFile(tmpFile)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if !strings.Contains(string(data), "after reload") {
+		t.Errorf("Expected message written after the old logger was closed, got: %s", data)
+	}
+	if len(openFiles.byPath) != 0 {
+		t.Errorf("Expected all log files to be closed, still open: %v", openFiles.byPath)
+	}
+}
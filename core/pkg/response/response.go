@@ -88,6 +88,16 @@ func Error(w http.ResponseWriter, code int, errCode, message string) {
 }
 
 func ErrorWithRequest(w http.ResponseWriter, r *http.Request, code int, errCode, message string) {
+	ErrorWithDetailsAndRequest(w, r, code, errCode, message, nil)
+}
+
+func ErrorWithDetails(w http.ResponseWriter, code int, errCode, message string, details interface{}) {
+	ErrorWithDetailsAndRequest(w, nil, code, errCode, message, details)
+}
+
+// ErrorWithDetailsAndRequest sends an error response carrying structured details
+// (e.g. the current state of a resource on a 409 conflict)
+func ErrorWithDetailsAndRequest(w http.ResponseWriter, r *http.Request, code int, errCode, message string, details interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
 
@@ -97,6 +107,7 @@ func ErrorWithRequest(w http.ResponseWriter, r *http.Request, code int, errCode,
 		Error: &ErrorInfo{
 			Code:    errCode,
 			Message: message,
+			Details: details,
 		},
 	}
 	if r != nil {
@@ -165,6 +176,33 @@ func CreatedWithRequest(w http.ResponseWriter, r *http.Request, data interface{}
 	}
 }
 
+func Accepted(w http.ResponseWriter, data interface{}, location string) {
+	AcceptedWithRequest(w, nil, data, location)
+}
+
+func AcceptedWithRequest(w http.ResponseWriter, r *http.Request, data interface{}, location string) {
+	w.Header().Set("Content-Type", "application/json")
+	if location != "" {
+		w.Header().Set("Location", location)
+	}
+	w.WriteHeader(http.StatusAccepted)
+
+	resp := Response{
+		Success: true,
+		Code:    202,
+		Data:    data,
+	}
+	if r != nil {
+		resp.RequestID = getRequestID(r.Context())
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Error("failed to encode accepted response",
+			logger.Field{Key: "error", Value: err},
+			logger.Field{Key: "location", Value: location})
+	}
+}
+
 func NoContent(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusNoContent)
 }
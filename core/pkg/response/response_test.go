@@ -110,6 +110,37 @@ func TestError(t *testing.T) {
 	}
 }
 
+func TestErrorWithDetails(t *testing.T) {
+	w := httptest.NewRecorder()
+	ErrorWithDetails(w, 409, ErrCodeConflict, "Revision mismatch", map[string]interface{}{"current_revision": 3})
+
+	if w.Code != 409 {
+		t.Errorf("ErrorWithDetails() status code = %v, want %v", w.Code, 409)
+	}
+
+	var resp Response
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Error == nil {
+		t.Fatal("ErrorWithDetails() should have error info")
+	}
+
+	if resp.Error.Code != ErrCodeConflict {
+		t.Errorf("ErrorWithDetails() error code = %v, want %v", resp.Error.Code, ErrCodeConflict)
+	}
+
+	details, ok := resp.Error.Details.(map[string]interface{})
+	if !ok {
+		t.Fatalf("ErrorWithDetails() details should be an object, got %T", resp.Error.Details)
+	}
+
+	if details["current_revision"] != float64(3) {
+		t.Errorf("ErrorWithDetails() current_revision = %v, want 3", details["current_revision"])
+	}
+}
+
 func TestList(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -259,6 +290,26 @@ func TestCreated(t *testing.T) {
 	}
 }
 
+func TestAccepted(t *testing.T) {
+	w := httptest.NewRecorder()
+	Accepted(w, map[string]int{"id": 7}, "/api/config/changes/7")
+
+	if w.Code != http.StatusAccepted {
+		t.Errorf("Accepted() status code = %v, want %v", w.Code, http.StatusAccepted)
+	}
+	if location := w.Header().Get("Location"); location != "/api/config/changes/7" {
+		t.Errorf("Accepted() Location header = %v, want %v", location, "/api/config/changes/7")
+	}
+
+	var resp Response
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if !resp.Success || resp.Code != http.StatusAccepted {
+		t.Errorf("Accepted() response = %+v, want success with code 202", resp)
+	}
+}
+
 func TestNoContent(t *testing.T) {
 	w := httptest.NewRecorder()
 	NoContent(w)
@@ -14,8 +14,9 @@ import (
 )
 
 // Config holds HTTP/HTTPS server configuration
-// This is infrastructure configuration, NOT managed by config center
-// Values should be provided via environment variables at startup
+// This is infrastructure configuration, NOT stored in the config center database;
+// at startup it is loaded and validated by the config loader in pre-DB mode
+// (config.Bootstrap.LoadInfraConfig) from the "server" section
 type Config struct {
 	HTTPPort    string `yaml:"http_port" validate:"required,min=1,max=5" default:"8080" db:"false"`
 	HTTPSPort   string `yaml:"https_port" validate:"required,min=1,max=5" default:"8443" db:"false"`
@@ -29,10 +30,8 @@ type Config struct {
 	EnableHTTPWithHTTPS bool `yaml:"enable_http_with_https" default:"true" db:"false"`
 }
 
-// DefaultConfig returns default server configuration
-// Configuration is loaded from environment variables with SERVER_ prefix
+// DefaultConfig returns server configuration from environment variables only
 // Environment variable naming: SERVER_HTTP_PORT, SERVER_HTTPS_PORT, etc.
-// These env vars are set by env.LoadConfigToEnv() from default.yaml
 func DefaultConfig() *Config {
 	return &Config{
 		HTTPPort:            env.Get("SERVER_HTTP_PORT", "8080"),
@@ -4,14 +4,15 @@ import (
 	"net/http"
 
 	configModule "apprun/modules/config"
+	flagsModule "apprun/modules/flags"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
 
 // SetupRoutes 设置所有路由
-// configService 参数可选，如果提供则注册配置 API 路由
-func SetupRoutes(configService *configModule.Service) *chi.Mux {
+// configService、flagService 参数可选，如果提供则注册配置 / Feature Flag API 路由
+func SetupRoutes(configService *configModule.Service, flagService *flagsModule.Service) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Use go-chi middlewares
@@ -39,6 +40,11 @@ func SetupRoutes(configService *configModule.Service) *chi.Mux {
 			configHandler := configModule.NewHandler(configService)
 			configHandler.RegisterRoutes(r)
 		}
+
+		// feature flag routes (如果提供了 Flag 服务)
+		if flagService != nil {
+			flagsModule.NewHandler(flagService).RegisterRoutes(r)
+		}
 	})
 
 	// Swagger 文档路由（挂载到 /api/docs/）